@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adhocore/gronx"
+	"github.com/jlucaspains/github-charts/models"
+	"gopkg.in/yaml.v3"
+)
+
+// configReloadInterval is how often the configuration file is checked for
+// changes.
+const configReloadInterval = 10 * time.Second
+
+// configSections maps the settings of each section of the configuration file
+// to the environment variable they replace.
+var configSections = map[string]map[string]string{
+	"data_pull": {
+		"cron":               "DATA_PULL_JOB_CRON",
+		"concurrency":        "DATA_PULL_CONCURRENCY",
+		"timeout":            "DATA_PULL_TIMEOUT",
+		"full_sync_interval": "DATA_PULL_FULL_SYNC_INTERVAL",
+	},
+	"server": {
+		"host_port":         "WEB_HOST_PORT",
+		"allowed_origin":    "ALLOWED_ORIGIN",
+		"tls_cert_file":     "TLS_CERT_FILE",
+		"tls_cert_key_file": "TLS_CERT_KEY_FILE",
+		"webhook_secret":    "GH_WEBHOOK_SECRET",
+		"admin_token":       "ADMIN_TOKEN",
+		"token_key_file":    "PROJECT_TOKEN_KEY_FILE",
+	},
+	"github": {
+		"api_url":        "GH_API_URL",
+		"graphql_url":    "GH_GRAPHQL_URL",
+		"ca_bundle_file": "GH_CA_BUNDLE_FILE",
+		"proxy_url":      "GH_PROXY_URL",
+	},
+}
+
+type settingRule struct {
+	isValid func(value string) bool
+	message string
+}
+
+// settingRules check the settings that would otherwise only fail once read.
+var settingRules = map[string]settingRule{
+	"DATA_PULL_JOB_CRON": {
+		isValid: gronx.New().IsValid,
+		message: "should be a valid cron schedule, e.g. 0 * * * *",
+	},
+	"DATA_PULL_CONCURRENCY": {
+		isValid: func(value string) bool {
+			parsed, err := strconv.Atoi(value)
+			return err == nil && parsed > 0
+		},
+		message: "should be a number greater than 0",
+	},
+	"DATA_PULL_TIMEOUT": {
+		isValid: func(value string) bool {
+			parsed, err := time.ParseDuration(value)
+			return err == nil && parsed > 0
+		},
+		message: "should be a positive duration, e.g. 10m",
+	},
+	"DATA_PULL_FULL_SYNC_INTERVAL": {
+		isValid: func(value string) bool {
+			parsed, err := time.ParseDuration(value)
+			return err == nil && parsed >= 0
+		},
+		message: "should be a duration, e.g. 24h, or 0 to always pull every item",
+	},
+}
+
+// settings are keyed by the environment variable they replace. Settings
+// missing from the configuration file are read from the environment.
+type settings map[string]string
+
+// fileSettings are the settings of the configuration file loaded at startup.
+var fileSettings = settings{}
+
+func (s settings) get(name string) string {
+	value, _ := s.lookup(name)
+	return value
+}
+
+func (s settings) lookup(name string) (string, bool) {
+	if value, ok := s[name]; ok {
+		return value, true
+	}
+
+	return os.LookupEnv(name)
+}
+
+// defaultConnection returns the connection settings shared by every project
+// that does not configure its own.
+func (s settings) defaultConnection() models.ConnectionConfig {
+	return models.ConnectionConfig{
+		ApiUrl:       s.get("GH_API_URL"),
+		GraphqlUrl:   s.get("GH_GRAPHQL_URL"),
+		CaBundleFile: s.get("GH_CA_BUNDLE_FILE"),
+		ProxyUrl:     s.get("GH_PROXY_URL"),
+	}
+}
+
+// fileConfig is the content of a YAML or JSON configuration file.
+type fileConfig struct {
+	settings settings
+	projects []models.JobConfigItem
+}
+
+// getProjects returns the configured projects with the default connection
+// settings applied.
+func (c *fileConfig) getProjects() []models.JobConfigItem {
+	defaultConnection := c.settings.defaultConnection()
+
+	result := []models.JobConfigItem{}
+	for _, project := range c.projects {
+		project.Connection = project.Connection.WithDefaults(defaultConnection)
+		result = append(result, project)
+	}
+
+	return result
+}
+
+func loadConfigFile(path string) (*fileConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseConfigFile(content)
+}
+
+// parseConfigFile reads a YAML configuration file, or a JSON one as JSON is
+// valid YAML. Every invalid setting is reported with its line.
+func parseConfigFile(content []byte) (*fileConfig, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("invalid configuration file: %w", err)
+	}
+
+	result := &fileConfig{settings: settings{}}
+	if len(document.Content) == 0 {
+		return result, nil
+	}
+
+	errors := []string{}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		errors = append(errors, lineError(root, "configuration should be a mapping"))
+	}
+
+	for _, item := range mappingItems(root) {
+		key, value := item[0], item[1]
+		section, ok := configSections[key.Value]
+
+		switch {
+		case key.Value == "projects":
+			errors = append(errors, result.parseProjects(value)...)
+		case ok:
+			errors = append(errors, result.parseSection(key.Value, section, value)...)
+		default:
+			errors = append(errors, lineError(key, fmt.Sprintf("unknown section %q", key.Value)))
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, fmt.Errorf("invalid configuration file: %s", strings.Join(errors, ", "))
+	}
+
+	return result, nil
+}
+
+func (c *fileConfig) parseSection(name string, section map[string]string, node *yaml.Node) []string {
+	if node.Kind != yaml.MappingNode {
+		return []string{lineError(node, fmt.Sprintf("%s should be a mapping", name))}
+	}
+
+	errors := []string{}
+	for _, item := range mappingItems(node) {
+		key, value := item[0], item[1]
+		setting := fmt.Sprintf("%s.%s", name, key.Value)
+		variable, ok := section[key.Value]
+
+		if !ok {
+			errors = append(errors, lineError(key, fmt.Sprintf("unknown setting %q", setting)))
+			continue
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			errors = append(errors, lineError(value, fmt.Sprintf("%s should be a single value", setting)))
+			continue
+		}
+
+		if rule, ok := settingRules[variable]; ok && !rule.isValid(value.Value) {
+			errors = append(errors, lineError(value, fmt.Sprintf("%s %s", setting, rule.message)))
+			continue
+		}
+
+		c.settings[variable] = value.Value
+	}
+
+	return errors
+}
+
+func (c *fileConfig) parseProjects(node *yaml.Node) []string {
+	if node.Kind != yaml.SequenceNode {
+		return []string{lineError(node, "projects should be a list")}
+	}
+
+	errors := []string{}
+	for _, item := range node.Content {
+		if item.Kind != yaml.MappingNode {
+			errors = append(errors, lineError(item, "project should be a mapping"))
+			continue
+		}
+
+		project := models.JobConfigItem{}
+		valid := true
+		for _, pair := range mappingItems(item) {
+			key, value := pair[0], pair[1]
+			if value.Kind != yaml.ScalarNode {
+				errors = append(errors, lineError(value, fmt.Sprintf("project setting %q should be a single value", key.Value)))
+				valid = false
+				continue
+			}
+
+			if !setProjectConfigValue(&project, key.Value, value.Value) {
+				errors = append(errors, lineError(key, fmt.Sprintf("unknown project setting %q", key.Value)))
+				valid = false
+			}
+		}
+
+		if !valid {
+			continue
+		}
+
+		if err := project.Validate(); err != nil {
+			errors = append(errors, lineError(item, err.Error()))
+			continue
+		}
+
+		if slices.ContainsFunc(c.projects, func(other models.JobConfigItem) bool {
+			return other.GetUniqueName() == project.GetUniqueName()
+		}) {
+			errors = append(errors, lineError(item, fmt.Sprintf("project %s is configured more than once", project.GetUniqueName())))
+			continue
+		}
+
+		c.projects = append(c.projects, project)
+	}
+
+	return errors
+}
+
+// mappingItems returns the key and value nodes of a mapping node.
+func mappingItems(node *yaml.Node) [][2]*yaml.Node {
+	result := [][2]*yaml.Node{}
+	if node.Kind != yaml.MappingNode {
+		return result
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		result = append(result, [2]*yaml.Node{node.Content[i], node.Content[i+1]})
+	}
+
+	return result
+}
+
+func lineError(node *yaml.Node, message string) string {
+	return fmt.Sprintf("line %d: %s", node.Line, message)
+}
+
+// projectSetter replaces the projects being pulled.
+type projectSetter interface {
+	SetProjects(projects []models.JobConfigItem) error
+}
+
+// configWatcher reloads the projects of the configuration file when the file
+// changes. Other settings are only read at startup.
+type configWatcher struct {
+	path    string
+	content []byte
+	target  projectSetter
+}
+
+func newConfigWatcher(path string, target projectSetter) *configWatcher {
+	content, _ := os.ReadFile(path)
+
+	return &configWatcher{
+		path:    path,
+		content: content,
+		target:  target,
+	}
+}
+
+func (w *configWatcher) watch() {
+	for range time.Tick(configReloadInterval) {
+		w.reload()
+	}
+}
+
+// reload applies the projects of the configuration file when its content
+// changed. An invalid file is logged and the current projects are kept.
+func (w *configWatcher) reload() {
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		slog.Error("Error reading configuration file", "file", w.path, "error", err)
+		return
+	}
+
+	if bytes.Equal(content, w.content) {
+		return
+	}
+
+	w.content = content
+
+	config, err := parseConfigFile(content)
+	if err != nil {
+		slog.Error("Invalid configuration file, keeping the current projects", "file", w.path, "error", err)
+		return
+	}
+
+	projects := config.getProjects()
+	if err := w.target.SetProjects(projects); err != nil {
+		slog.Error("Error applying configuration file, keeping the current projects", "file", w.path, "error", err)
+		return
+	}
+
+	slog.Info("Configuration file reloaded", "file", w.path, "projects", len(projects))
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `data_pull:
+  cron: "0 * * * *"
+  concurrency: 2
+server:
+  host_port: ":9000"
+github:
+  api_url: https://github.example.com/api/v3
+projects:
+  - org_name: org
+    project: 1
+    token: abc
+    effort_field: Story Points
+  - repo_owner: me
+    repo_name: repo
+    project: 2
+    token: def
+    api_url: https://other.example.com/api/v3
+`
+
+type mockProjectSetter struct {
+	projects [][]models.JobConfigItem
+}
+
+func (m *mockProjectSetter) SetProjects(projects []models.JobConfigItem) error {
+	m.projects = append(m.projects, projects)
+	return nil
+}
+
+func TestParseConfigFile(t *testing.T) {
+	config, err := parseConfigFile([]byte(testConfigFile))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "0 * * * *", config.settings["DATA_PULL_JOB_CRON"])
+	assert.Equal(t, "2", config.settings["DATA_PULL_CONCURRENCY"])
+	assert.Equal(t, ":9000", config.settings["WEB_HOST_PORT"])
+
+	projects := config.getProjects()
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "org", projects[0].OrgName)
+	assert.Equal(t, "1", projects[0].Project)
+	assert.Equal(t, "Story Points", projects[0].Fields.Effort)
+	assert.Equal(t, "https://github.example.com/api/v3", projects[0].Connection.ApiUrl)
+	assert.Equal(t, "repo", projects[1].RepoName)
+	assert.Equal(t, "https://other.example.com/api/v3", projects[1].Connection.ApiUrl)
+}
+
+func TestParseConfigFileJSON(t *testing.T) {
+	config, err := parseConfigFile([]byte(`{
+  "data_pull": {"cron": "*/30 * * * *", "timeout": "5m"},
+  "projects": [{"org_name": "org", "project": 1, "token": "abc"}]
+}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "*/30 * * * *", config.settings["DATA_PULL_JOB_CRON"])
+	assert.Equal(t, "5m", config.settings["DATA_PULL_TIMEOUT"])
+	assert.Len(t, config.projects, 1)
+	assert.Equal(t, "org", config.projects[0].OrgName)
+}
+
+func TestParseConfigFileInvalid(t *testing.T) {
+	_, err := parseConfigFile([]byte(`data_pull:
+  cron: every hour
+  retries: 3
+server: ":9000"
+projects:
+  - org_name: org
+    project: 1
+  - org_name: org
+    project: 2
+    token: abc
+    labels: [bug]
+  - org_name: org
+    project: 3
+    token: abc
+  - org_name: org
+    project: 3
+    token: def
+other: true
+`))
+
+	assert.EqualError(t, err, "invalid configuration file: "+
+		"line 2: data_pull.cron should be a valid cron schedule, e.g. 0 * * * *, "+
+		"line 3: unknown setting \"data_pull.retries\", "+
+		"line 4: server should be a mapping, "+
+		"line 6: invalid configuration: token or GitHub App credentials are required, "+
+		"line 11: project setting \"labels\" should be a single value, "+
+		"line 15: project org/3 is configured more than once, "+
+		"line 18: unknown section \"other\"")
+}
+
+func TestParseConfigFileSyntaxError(t *testing.T) {
+	_, err := parseConfigFile([]byte("projects:\n  - org_name: org\n   project: 1\n"))
+
+	assert.ErrorContains(t, err, "invalid configuration file: yaml: line 1:")
+}
+
+func TestParseConfigFileEmpty(t *testing.T) {
+	config, err := parseConfigFile([]byte(""))
+
+	assert.Nil(t, err)
+	assert.Empty(t, config.projects)
+}
+
+func TestSettingsFallBackToEnvironment(t *testing.T) {
+	t.Setenv("WEB_HOST_PORT", ":8080")
+	t.Setenv("GH_PROXY_URL", "http://proxy:3128")
+
+	config := settings{"WEB_HOST_PORT": ":9000"}
+
+	assert.Equal(t, ":9000", config.get("WEB_HOST_PORT"))
+	assert.Equal(t, "http://proxy:3128", config.get("GH_PROXY_URL"))
+	_, ok := config.lookup("TLS_CERT_FILE")
+	assert.False(t, ok)
+}
+
+func TestConfigWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(testConfigFile), 0o600))
+
+	setter := &mockProjectSetter{}
+	watcher := newConfigWatcher(path, setter)
+
+	watcher.reload()
+	assert.Empty(t, setter.projects)
+
+	assert.Nil(t, os.WriteFile(path, []byte("projects:\n  - org_name: org\n    project: 3\n    token: abc\n"), 0o600))
+	watcher.reload()
+
+	assert.Len(t, setter.projects, 1)
+	assert.Len(t, setter.projects[0], 1)
+	assert.Equal(t, "3", setter.projects[0][0].Project)
+}
+
+func TestConfigWatcherKeepsProjectsWhenInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(testConfigFile), 0o600))
+
+	setter := &mockProjectSetter{}
+	watcher := newConfigWatcher(path, setter)
+
+	assert.Nil(t, os.WriteFile(path, []byte("projects:\n  - project: 3\n"), 0o600))
+	watcher.reload()
+
+	assert.Empty(t, setter.projects)
+}
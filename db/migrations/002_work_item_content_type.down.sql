@@ -0,0 +1 @@
+ALTER TABLE work_item_history DROP COLUMN IF EXISTS content_type;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE work_item_history ADD COLUMN content_type varchar(50) NOT NULL DEFAULT 'Issue';
\ No newline at end of file
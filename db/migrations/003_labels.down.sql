@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS work_item_label;
+DROP TABLE IF EXISTS label;
\ No newline at end of file
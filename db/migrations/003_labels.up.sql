@@ -0,0 +1,11 @@
+CREATE TABLE label (
+  id            SERIAL PRIMARY KEY,
+  name          varchar(255)    NOT NULL,
+  UNIQUE(name)
+);
+
+CREATE TABLE work_item_label (
+  work_item_history_id  INT  NOT NULL REFERENCES work_item_history (id) ON DELETE CASCADE,
+  label_id              INT  NOT NULL REFERENCES label (id),
+  PRIMARY KEY(work_item_history_id, label_id)
+);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS work_item_status_change;
+DROP TABLE IF EXISTS work_item;
\ No newline at end of file
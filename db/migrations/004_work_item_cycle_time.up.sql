@@ -0,0 +1,23 @@
+CREATE TABLE work_item (
+  id                SERIAL PRIMARY KEY,
+  gh_id             varchar(255)    NOT NULL,
+  name              varchar(255)    NOT NULL,
+  created_at        timestamp       NULL,
+  closed_at         timestamp       NULL,
+  project_id        INT  NOT NULL REFERENCES project (id),
+  UNIQUE(gh_id)
+);
+
+CREATE TABLE work_item_status_change (
+  id                SERIAL PRIMARY KEY,
+  gh_id             varchar(255)    NOT NULL,
+  status            varchar(255)    NOT NULL,
+  entered_date      date            NOT NULL,
+  UNIQUE(gh_id, status)
+);
+
+INSERT INTO work_item_status_change (gh_id, status, entered_date)
+SELECT gh_id, status, min(change_date)
+  FROM work_item_history
+ WHERE status IS NOT NULL
+ GROUP BY gh_id, status;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE work_item_history ADD COLUMN inferred boolean NOT NULL DEFAULT false;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sync_run;
\ No newline at end of file
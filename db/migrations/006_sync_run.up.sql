@@ -0,0 +1,15 @@
+CREATE TABLE sync_run (
+  id                SERIAL PRIMARY KEY,
+  sync_id           varchar(255)    NULL,
+  project_name      varchar(255)    NOT NULL,
+  project_id        INT  NULL REFERENCES project (id),
+  trigger           varchar(50)     NOT NULL,
+  status            varchar(50)     NOT NULL,
+  started_at        timestamp       NOT NULL,
+  finished_at       timestamp       NOT NULL,
+  item_count        integer         NOT NULL DEFAULT 0,
+  graphql_calls     integer         NOT NULL DEFAULT 0,
+  error             text            NULL
+);
+
+CREATE INDEX sync_run_project_id_started_at ON sync_run (project_id, started_at DESC);
\ No newline at end of file
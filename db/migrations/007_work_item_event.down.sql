@@ -0,0 +1 @@
+DROP TABLE IF EXISTS work_item_event;
\ No newline at end of file
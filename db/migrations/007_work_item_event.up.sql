@@ -0,0 +1,12 @@
+CREATE TABLE work_item_event (
+  id                SERIAL PRIMARY KEY,
+  gh_id             varchar(255)    NOT NULL,
+  name              varchar(255)    NOT NULL,
+  event_type        varchar(50)     NOT NULL,
+  event_date        date            NOT NULL,
+  effort            integer         NULL,
+  content_type      varchar(50)     NOT NULL DEFAULT 'Issue',
+  iteration_id      INT  NULL REFERENCES iteration (id),
+  project_id        INT  NOT NULL REFERENCES project (id),
+  UNIQUE(gh_id, event_type, event_date)
+);
\ No newline at end of file
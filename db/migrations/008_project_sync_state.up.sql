@@ -0,0 +1,5 @@
+CREATE TABLE project_sync_state (
+  project_name      varchar(255)    PRIMARY KEY,
+  items_updated_at  timestamp       NOT NULL,
+  full_synced_at    timestamp       NOT NULL
+);
\ No newline at end of file
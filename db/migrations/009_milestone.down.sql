@@ -0,0 +1,3 @@
+ALTER TABLE work_item_history DROP COLUMN IF EXISTS milestone_id;
+
+DROP TABLE IF EXISTS milestone;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE milestone (
+  id                SERIAL PRIMARY KEY,
+  gh_id             varchar(255)    NOT NULL,
+  name              varchar(255)    NOT NULL,
+  state             varchar(50)     NOT NULL,
+  start_date        date,
+  due_date          date,
+  project_id        INT  NOT NULL REFERENCES project (id),
+  UNIQUE(gh_id, project_id)
+);
+
+ALTER TABLE work_item_history ADD COLUMN milestone_id INT NULL REFERENCES milestone (id);
\ No newline at end of file
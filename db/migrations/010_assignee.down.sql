@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS work_item_assignee;
+DROP TABLE IF EXISTS assignee;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE assignee (
+  id            SERIAL PRIMARY KEY,
+  login         varchar(255)    NOT NULL,
+  UNIQUE(login)
+);
+
+CREATE TABLE work_item_assignee (
+  work_item_history_id  INT  NOT NULL REFERENCES work_item_history (id) ON DELETE CASCADE,
+  assignee_id           INT  NOT NULL REFERENCES assignee (id),
+  PRIMARY KEY(work_item_history_id, assignee_id)
+);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE work_item_history DROP COLUMN IF EXISTS parent_gh_id;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE work_item_history ADD COLUMN parent_gh_id varchar(255) NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS registered_project;
\ No newline at end of file
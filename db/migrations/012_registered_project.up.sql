@@ -0,0 +1,8 @@
+CREATE TABLE registered_project (
+  id               serial          PRIMARY KEY,
+  name             varchar(255)    NOT NULL UNIQUE,
+  settings         jsonb           NOT NULL,
+  encrypted_token  bytea           NULL,
+  created_at       timestamp       NOT NULL DEFAULT NOW(),
+  updated_at       timestamp       NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE project DROP COLUMN IF EXISTS time_zone;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE project ADD COLUMN time_zone varchar(64) NOT NULL DEFAULT 'UTC';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS work_item_intraday;
\ No newline at end of file
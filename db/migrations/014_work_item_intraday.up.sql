@@ -0,0 +1,16 @@
+CREATE TABLE work_item_intraday (
+  id                SERIAL PRIMARY KEY,
+  captured_at       timestamp       NOT NULL,
+  gh_id             varchar(255)    NOT NULL,
+  status            varchar(255)    NULL,
+  priority          integer NULL,
+  remaining_hours   integer NULL,
+  effort            integer NULL,
+  iteration_id      INT  NULL REFERENCES iteration (id),
+  project_id        INT  NOT NULL REFERENCES project (id),
+  content_type      varchar(50)     NOT NULL,
+  labels            text[]          NOT NULL DEFAULT '{}',
+  UNIQUE(captured_at, gh_id)
+);
+
+CREATE INDEX work_item_intraday_project_id_captured_at ON work_item_intraday (project_id, captured_at);
\ No newline at end of file
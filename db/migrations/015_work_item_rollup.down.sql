@@ -0,0 +1,9 @@
+DROP FUNCTION IF EXISTS rollup_remaining_hours(work_item_history);
+DROP FUNCTION IF EXISTS rollup_effort(work_item_history);
+DROP FUNCTION IF EXISTS work_item_rollup_count(work_item_history);
+DROP FUNCTION IF EXISTS work_item_has_children(work_item_history);
+DROP INDEX IF EXISTS work_item_history_change_date_root_gh_id;
+DROP INDEX IF EXISTS work_item_history_change_date_parent_gh_id;
+ALTER TABLE work_item_history DROP COLUMN IF EXISTS rolled_up;
+ALTER TABLE work_item_history DROP COLUMN IF EXISTS root_gh_id;
+ALTER TABLE project DROP COLUMN IF EXISTS rollup;
\ No newline at end of file
@@ -0,0 +1,61 @@
+ALTER TABLE project ADD COLUMN rollup varchar(16) NOT NULL DEFAULT '';
+
+ALTER TABLE work_item_history ADD COLUMN root_gh_id varchar(255) NULL;
+
+-- snapshots saved before rollups were applied on read already hold the
+-- counted effort and remaining hours
+ALTER TABLE work_item_history ADD COLUMN rolled_up boolean NOT NULL DEFAULT true;
+ALTER TABLE work_item_history ALTER COLUMN rolled_up SET DEFAULT false;
+
+CREATE INDEX work_item_history_change_date_parent_gh_id ON work_item_history (change_date, parent_gh_id);
+CREATE INDEX work_item_history_change_date_root_gh_id ON work_item_history (change_date, root_gh_id);
+
+CREATE FUNCTION work_item_has_children(item work_item_history) RETURNS boolean
+LANGUAGE sql STABLE AS $$
+  SELECT EXISTS (SELECT 1
+                   FROM work_item_history child
+                  WHERE child.change_date = item.change_date
+                    AND child.parent_gh_id = item.gh_id)
+$$;
+
+-- work_item_rollup_count tells how a snapshot counts under the rollup mode of
+-- its project: with its own values, with the values of the items without
+-- sub-issues below it, or not at all
+CREATE FUNCTION work_item_rollup_count(item work_item_history) RETURNS text
+LANGUAGE sql STABLE AS $$
+  SELECT CASE
+           WHEN item.rolled_up THEN 'own'
+           WHEN project.rollup = 'leaf' AND work_item_has_children(item) THEN 'none'
+           WHEN project.rollup IN ('parent', 'derived') AND item.parent_gh_id IS NOT NULL THEN 'none'
+           WHEN project.rollup = 'derived' AND work_item_has_children(item) THEN 'leaves'
+           ELSE 'own'
+         END
+    FROM project
+   WHERE project.id = item.project_id
+$$;
+
+CREATE FUNCTION rollup_effort(item work_item_history) RETURNS integer
+LANGUAGE sql STABLE AS $$
+  SELECT CASE work_item_rollup_count(item)
+           WHEN 'none' THEN 0
+           WHEN 'leaves' THEN (SELECT coalesce(sum(leaf.effort), 0)::integer
+                                 FROM work_item_history leaf
+                                WHERE leaf.change_date = item.change_date
+                                  AND leaf.root_gh_id = item.gh_id
+                                  AND NOT work_item_has_children(leaf))
+           ELSE item.effort
+         END
+$$;
+
+CREATE FUNCTION rollup_remaining_hours(item work_item_history) RETURNS integer
+LANGUAGE sql STABLE AS $$
+  SELECT CASE work_item_rollup_count(item)
+           WHEN 'none' THEN 0
+           WHEN 'leaves' THEN (SELECT coalesce(sum(leaf.remaining_hours), 0)::integer
+                                 FROM work_item_history leaf
+                                WHERE leaf.change_date = item.change_date
+                                  AND leaf.root_gh_id = item.gh_id
+                                  AND NOT work_item_has_children(leaf))
+           ELSE item.remaining_hours
+         END
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS work_item_has_label(integer, text[]);
\ No newline at end of file
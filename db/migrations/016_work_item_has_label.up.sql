@@ -0,0 +1,10 @@
+-- work_item_has_label tells whether a snapshot has any of the labels, the
+-- charts filter their items with it
+CREATE FUNCTION work_item_has_label(history_id integer, labels text[]) RETURNS boolean
+LANGUAGE sql STABLE AS $$
+  SELECT EXISTS (SELECT 1
+                   FROM work_item_label
+                        JOIN label ON label.id = work_item_label.label_id
+                  WHERE work_item_label.work_item_history_id = history_id
+                    AND label.name = ANY(labels))
+$$;
\ No newline at end of file
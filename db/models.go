@@ -8,6 +8,11 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Assignee struct {
+	ID    int32
+	Login string
+}
+
 type Iteration struct {
 	ID        int32
 	GhID      string
@@ -17,12 +22,84 @@ type Iteration struct {
 	ProjectID int32
 }
 
-type Project struct {
+type Label struct {
 	ID   int32
-	GhID string
 	Name string
 }
 
+type Milestone struct {
+	ID        int32
+	GhID      string
+	Name      string
+	State     string
+	StartDate pgtype.Date
+	DueDate   pgtype.Date
+	ProjectID int32
+}
+
+type Project struct {
+	ID       int32
+	GhID     string
+	Name     string
+	TimeZone string
+	Rollup   string
+}
+
+type ProjectSyncState struct {
+	ProjectName    string
+	ItemsUpdatedAt pgtype.Timestamp
+	FullSyncedAt   pgtype.Timestamp
+}
+
+type RegisteredProject struct {
+	ID             int32
+	Name           string
+	Settings       []byte
+	EncryptedToken []byte
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type SyncRun struct {
+	ID           int32
+	SyncID       pgtype.Text
+	ProjectName  string
+	ProjectID    pgtype.Int4
+	Trigger      string
+	Status       string
+	StartedAt    pgtype.Timestamp
+	FinishedAt   pgtype.Timestamp
+	ItemCount    int32
+	GraphqlCalls int32
+	Error        pgtype.Text
+}
+
+type WorkItem struct {
+	ID        int32
+	GhID      string
+	Name      string
+	CreatedAt pgtype.Timestamp
+	ClosedAt  pgtype.Timestamp
+	ProjectID int32
+}
+
+type WorkItemAssignee struct {
+	WorkItemHistoryID int32
+	AssigneeID        int32
+}
+
+type WorkItemEvent struct {
+	ID          int32
+	GhID        string
+	Name        string
+	EventType   string
+	EventDate   pgtype.Date
+	Effort      pgtype.Int4
+	ContentType string
+	IterationID pgtype.Int4
+	ProjectID   int32
+}
+
 type WorkItemHistory struct {
 	ID             int32
 	ChangeDate     pgtype.Date
@@ -34,9 +111,41 @@ type WorkItemHistory struct {
 	Effort         pgtype.Int4
 	IterationID    pgtype.Int4
 	ProjectID      int32
+	ContentType    string
+	Inferred       bool
+	MilestoneID    pgtype.Int4
+	ParentGhID     pgtype.Text
+	RootGhID       pgtype.Text
+	RolledUp       bool
+}
+
+type WorkItemIntraday struct {
+	ID             int32
+	CapturedAt     pgtype.Timestamp
+	GhID           string
+	Status         pgtype.Text
+	Priority       pgtype.Int4
+	RemainingHours pgtype.Int4
+	Effort         pgtype.Int4
+	IterationID    pgtype.Int4
+	ProjectID      int32
+	ContentType    string
+	Labels         []string
+}
+
+type WorkItemLabel struct {
+	WorkItemHistoryID int32
+	LabelID           int32
 }
 
 type WorkItemStatus struct {
 	ID   int16
 	Name string
 }
+
+type WorkItemStatusChange struct {
+	ID          int32
+	GhID        string
+	Status      string
+	EnteredDate pgtype.Date
+}
@@ -1,15 +1,58 @@
 package db
 
-import "context"
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 type Querier interface {
-	GetIterationBurndown(ctx context.Context, id int32) ([]GetIterationBurndownRow, error)
+	CarryForwardWorkItems(ctx context.Context, arg CarryForwardWorkItemsParams) (int64, error)
+	DeleteRegisteredProject(ctx context.Context, id int32) (int64, error)
+	DeleteWorkItemAssignees(ctx context.Context, workItemHistoryID int32) error
+	DeleteWorkItemLabels(ctx context.Context, workItemHistoryID int32) error
+	DeleteWorkItemSnapshot(ctx context.Context, arg DeleteWorkItemSnapshotParams) error
+	FillWorkItemHistoryGaps(ctx context.Context, arg FillWorkItemHistoryGapsParams) ([]pgtype.Date, error)
+	GetCycleTimeItems(ctx context.Context, arg GetCycleTimeItemsParams) ([]GetCycleTimeItemsRow, error)
+	GetIterationBurndown(ctx context.Context, arg GetIterationBurndownParams) ([]GetIterationBurndownRow, error)
+	GetIterationIntradayBurndown(ctx context.Context, arg GetIterationIntradayBurndownParams) ([]GetIterationIntradayBurndownRow, error)
+	GetIterationScopeRemoved(ctx context.Context, arg GetIterationScopeRemovedParams) ([]GetIterationScopeRemovedRow, error)
+	GetIterationWorkload(ctx context.Context, arg GetIterationWorkloadParams) ([]GetIterationWorkloadRow, error)
 	GetIterations(ctx context.Context, projectID int32) ([]Iteration, error)
+	GetMilestoneBurndown(ctx context.Context, arg GetMilestoneBurndownParams) ([]GetMilestoneBurndownRow, error)
+	GetMilestones(ctx context.Context, projectID int32) ([]Milestone, error)
+	GetPreviousWorkItems(ctx context.Context, arg GetPreviousWorkItemsParams) ([]GetPreviousWorkItemsRow, error)
 	GetProjectBurnup(ctx context.Context, arg GetProjectBurnupParams) ([]GetProjectBurnupRow, error)
+	GetProjectFirstChangeDate(ctx context.Context, ghID string) (pgtype.Date, error)
+	GetProjectIntradayBurnup(ctx context.Context, arg GetProjectIntradayBurnupParams) ([]GetProjectIntradayBurnupRow, error)
+	GetProjectPriorityBreakdown(ctx context.Context, arg GetProjectPriorityBreakdownParams) ([]GetProjectPriorityBreakdownRow, error)
+	GetProjectScopeRemoved(ctx context.Context, arg GetProjectScopeRemovedParams) ([]GetProjectScopeRemovedRow, error)
+	GetProjectSyncRuns(ctx context.Context, arg GetProjectSyncRunsParams) ([]SyncRun, error)
+	GetProjectSyncState(ctx context.Context, projectName string) (ProjectSyncState, error)
+	GetProjectTimeZone(ctx context.Context, id int32) (string, error)
 	GetProjects(ctx context.Context) ([]Project, error)
+	GetRegisteredProject(ctx context.Context, id int32) (RegisteredProject, error)
+	GetRegisteredProjects(ctx context.Context) ([]RegisteredProject, error)
+	GetSyncRuns(ctx context.Context, rowLimit int32) ([]SyncRun, error)
+	GetSyncedProjectIds(ctx context.Context, projectNames []string) ([]GetSyncedProjectIdsRow, error)
+	GetTrackedProjects(ctx context.Context) ([]GetTrackedProjectsRow, error)
+	GetWorkItemTree(ctx context.Context, projectID int32) ([]GetWorkItemTreeRow, error)
 	GetWorkItemsForIteration(ctx context.Context, name string) ([]GetWorkItemsForIterationRow, error)
+	InsertRegisteredProject(ctx context.Context, arg InsertRegisteredProjectParams) (RegisteredProject, error)
+	InsertSyncRun(ctx context.Context, arg InsertSyncRunParams) (SyncRun, error)
+	InsertWorkItemAssignee(ctx context.Context, arg InsertWorkItemAssigneeParams) error
+	InsertWorkItemEvent(ctx context.Context, arg InsertWorkItemEventParams) error
+	InsertWorkItemLabel(ctx context.Context, arg InsertWorkItemLabelParams) error
+	SaveIntradaySnapshot(ctx context.Context, arg SaveIntradaySnapshotParams) error
+	UpdateRegisteredProject(ctx context.Context, arg UpdateRegisteredProjectParams) (RegisteredProject, error)
+	UpsertAssignee(ctx context.Context, login string) (Assignee, error)
 	UpsertIteration(ctx context.Context, arg UpsertIterationParams) (Iteration, error)
+	UpsertLabel(ctx context.Context, name string) (Label, error)
+	UpsertMilestone(ctx context.Context, arg UpsertMilestoneParams) (Milestone, error)
 	UpsertProject(ctx context.Context, arg UpsertProjectParams) (Project, error)
+	UpsertProjectSyncState(ctx context.Context, arg UpsertProjectSyncStateParams) error
 	UpsertWorkItem(ctx context.Context, arg UpsertWorkItemParams) (WorkItemHistory, error)
+	UpsertWorkItemDates(ctx context.Context, arg UpsertWorkItemDatesParams) error
 	UpsertWorkItemStatus(ctx context.Context, name string) (WorkItemStatus, error)
+	UpsertWorkItemStatusChange(ctx context.Context, arg UpsertWorkItemStatusChangeParams) error
 }
@@ -5,8 +5,8 @@ JOIN iteration on work_item.iteration_id = iteration.id
 WHERE iteration.name = $1;
 
 -- name: UpsertWorkItem :one
-INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 ON CONFLICT(change_date, gh_id) 
 DO UPDATE SET
   "name" = EXCLUDED.name,
@@ -15,15 +15,26 @@ DO UPDATE SET
   remaining_hours = EXCLUDED.remaining_hours,
   effort = EXCLUDED.effort,
   iteration_id = EXCLUDED.iteration_id,
-  project_id = EXCLUDED.project_id
+  project_id = EXCLUDED.project_id,
+  content_type = EXCLUDED.content_type,
+  inferred = EXCLUDED.inferred,
+  milestone_id = EXCLUDED.milestone_id,
+  parent_gh_id = EXCLUDED.parent_gh_id,
+  root_gh_id = EXCLUDED.root_gh_id,
+  rolled_up = EXCLUDED.rolled_up
+-- inferred snapshots never replace snapshots pulled from GitHub
+WHERE work_item_history.inferred OR NOT EXCLUDED.inferred
 RETURNING *;
 
 -- name: UpsertProject :one
-INSERT INTO project (gh_id, name)
-VALUES ($1, $2)
+INSERT INTO project (gh_id, name, time_zone, rollup)
+VALUES (sqlc.arg(gh_id), sqlc.arg(name), coalesce(sqlc.narg(time_zone)::text, 'UTC'), coalesce(sqlc.narg(rollup)::text, ''))
 ON CONFLICT(gh_id) 
 DO UPDATE SET
-  "name" = EXCLUDED.name
+  "name" = EXCLUDED.name,
+  -- webhook project events do not know the time zone and rollup of the project
+  time_zone = coalesce(sqlc.narg(time_zone)::text, project.time_zone),
+  rollup = coalesce(sqlc.narg(rollup)::text, project.rollup)
 RETURNING *;
 
 -- name: UpsertIteration :one
@@ -36,6 +47,17 @@ DO UPDATE SET
   end_date = EXCLUDED.end_date
 RETURNING *;
 
+-- name: UpsertMilestone :one
+INSERT INTO milestone (gh_id, name, state, start_date, due_date, project_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT(gh_id, project_id)
+DO UPDATE SET
+  "name" = EXCLUDED.name,
+  "state" = EXCLUDED.state,
+  start_date = EXCLUDED.start_date,
+  due_date = EXCLUDED.due_date
+RETURNING *;
+
 -- name: UpsertWorkItemStatus :one
 INSERT INTO work_item_status (name)
 VALUES ($1)
@@ -44,24 +66,215 @@ DO UPDATE SET
   "name" = EXCLUDED.name
 RETURNING *;
 
+-- name: UpsertLabel :one
+INSERT INTO label (name)
+VALUES ($1)
+ON CONFLICT(name) 
+DO UPDATE SET
+  "name" = EXCLUDED.name
+RETURNING *;
+
+-- name: DeleteWorkItemLabels :exec
+DELETE FROM work_item_label
+WHERE work_item_history_id = $1;
+
+-- name: InsertWorkItemLabel :exec
+INSERT INTO work_item_label (work_item_history_id, label_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UpsertAssignee :one
+INSERT INTO assignee (login)
+VALUES ($1)
+ON CONFLICT(login) 
+DO UPDATE SET
+  "login" = EXCLUDED.login
+RETURNING *;
+
+-- name: DeleteWorkItemAssignees :exec
+DELETE FROM work_item_assignee
+WHERE work_item_history_id = $1;
+
+-- name: InsertWorkItemAssignee :exec
+INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UpsertWorkItemDates :exec
+INSERT INTO work_item (gh_id, name, created_at, closed_at, project_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT(gh_id) 
+DO UPDATE SET
+  "name" = EXCLUDED.name,
+  created_at = EXCLUDED.created_at,
+  closed_at = EXCLUDED.closed_at,
+  project_id = EXCLUDED.project_id;
+
+-- name: UpsertWorkItemStatusChange :exec
+INSERT INTO work_item_status_change (gh_id, status, entered_date)
+VALUES ($1, $2, $3)
+ON CONFLICT(gh_id, status) 
+DO UPDATE SET
+  entered_date = LEAST(work_item_status_change.entered_date, EXCLUDED.entered_date);
+
+-- name: GetProjectFirstChangeDate :one
+SELECT min(change_date)::date
+FROM work_item_history
+JOIN project ON project.id = work_item_history.project_id
+WHERE project.gh_id = $1
+  AND NOT work_item_history.inferred;
+
+-- name: InsertSyncRun :one
+INSERT INTO sync_run (sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error)
+VALUES (sqlc.narg(sync_id), sqlc.arg(project_name), sqlc.narg(project_id), sqlc.arg(trigger), sqlc.arg(status), sqlc.arg(started_at), sqlc.arg(finished_at), sqlc.arg(item_count), sqlc.arg(graphql_calls), sqlc.narg(error))
+RETURNING *;
+
+-- name: GetSyncRuns :many
+SELECT id, sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error
+FROM sync_run
+ORDER BY started_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetProjectSyncRuns :many
+SELECT id, sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error
+FROM sync_run
+WHERE project_id = sqlc.arg(project_id)
+ORDER BY started_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetSyncedProjectIds :many
+SELECT DISTINCT ON (project_name) project_name, project_id::int AS project_id
+FROM sync_run
+WHERE project_id IS NOT NULL
+  AND project_name = ANY(sqlc.arg(project_names)::text[])
+ORDER BY project_name, started_at DESC;
+
+-- name: GetTrackedProjects :many
+SELECT DISTINCT ON (project.id) project.id, project.gh_id, sync_run.project_name
+FROM project
+JOIN sync_run ON sync_run.project_id = project.id
+ORDER BY project.id, sync_run.started_at DESC;
+
+-- name: GetPreviousWorkItems :many
+SELECT DISTINCT ON (work_item_history.gh_id) work_item_history.gh_id
+     , work_item_history.name
+     , rollup_effort(work_item_history) as effort
+     , work_item_history.iteration_id
+     , iteration.gh_id as iteration_gh_id
+     , work_item_history.content_type
+  FROM work_item_history
+       JOIN project on project.id = work_item_history.project_id
+       LEFT JOIN iteration on iteration.id = work_item_history.iteration_id
+ WHERE project.gh_id = sqlc.arg(project_gh_id)
+   AND NOT work_item_history.inferred
+   AND work_item_history.change_date <= sqlc.arg(change_date)
+   -- the last pulled day before change_date and any pull earlier on change_date
+   AND work_item_history.change_date >= coalesce((SELECT max(previous.change_date)
+                                                    FROM work_item_history previous
+                                                   WHERE previous.project_id = project.id
+                                                     AND previous.change_date < sqlc.arg(change_date)
+                                                     AND NOT previous.inferred), sqlc.arg(change_date))
+ORDER BY work_item_history.gh_id, work_item_history.change_date DESC;
+
+-- name: InsertWorkItemEvent :exec
+INSERT INTO work_item_event (gh_id, name, event_type, event_date, effort, content_type, iteration_id, project_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT(gh_id, event_type, event_date) DO NOTHING;
+
+-- name: DeleteWorkItemSnapshot :exec
+DELETE FROM work_item_history
+WHERE gh_id = $1
+  AND change_date = $2;
+
+-- name: GetProjectScopeRemoved :many
+WITH removed AS (
+ SELECT work_item_event.event_date, work_item_event.effort
+   FROM work_item_event
+        -- labels are filtered by the last snapshot before the item was removed
+        LEFT JOIN lateral (SELECT work_item_history.id, work_item_history.priority
+                             FROM work_item_history
+                            WHERE work_item_history.gh_id = work_item_event.gh_id
+                              AND work_item_history.change_date < work_item_event.event_date
+                            ORDER BY work_item_history.change_date DESC
+                            LIMIT 1) last_snapshot on true
+  WHERE work_item_event.project_id = sqlc.arg(project_id)::int
+    AND work_item_event.event_type IN ('removed', 'archived')
+    AND work_item_event.event_date >= sqlc.arg(start_date)::timestamp
+    AND (sqlc.narg(types)::text[] IS NULL OR work_item_event.content_type = ANY(sqlc.narg(types)::text[]))
+    AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(last_snapshot.id, sqlc.narg(include_labels)::text[]))
+    AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(last_snapshot.id, sqlc.narg(exclude_labels)::text[]))
+    AND (sqlc.narg(priorities)::int[] IS NULL OR last_snapshot.priority = ANY(sqlc.narg(priorities)::int[])))
+
+SELECT project_day
+     , coalesce(sum(removed.effort), 0)::decimal as qty
+  FROM (SELECT date_trunc('day', dd):: date as project_day
+          FROM generate_series
+                  ( sqlc.arg(start_date)::timestamp
+                  , (now() AT TIME ZONE coalesce(sqlc.narg(time_zone)::text, (SELECT time_zone FROM project WHERE project.id = sqlc.arg(project_id)::int)))
+                  , '1 day'::interval) dd) dates
+       LEFT JOIN removed on removed.event_date <= dates.project_day
+ GROUP BY dates.project_day
+ORDER BY dates.project_day;
+
+-- name: GetIterationScopeRemoved :many
+WITH removed AS (
+ SELECT work_item_event.event_date, work_item_event.effort
+   FROM work_item_event
+        -- labels are filtered by the last snapshot before the item was removed
+        LEFT JOIN lateral (SELECT work_item_history.id, work_item_history.priority
+                             FROM work_item_history
+                            WHERE work_item_history.gh_id = work_item_event.gh_id
+                              AND work_item_history.change_date < work_item_event.event_date
+                            ORDER BY work_item_history.change_date DESC
+                            LIMIT 1) last_snapshot on true
+  WHERE work_item_event.iteration_id = sqlc.arg(iteration_id)::int
+    AND (sqlc.narg(types)::text[] IS NULL OR work_item_event.content_type = ANY(sqlc.narg(types)::text[]))
+    AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(last_snapshot.id, sqlc.narg(include_labels)::text[]))
+    AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(last_snapshot.id, sqlc.narg(exclude_labels)::text[]))
+    AND (sqlc.narg(priorities)::int[] IS NULL OR last_snapshot.priority = ANY(sqlc.narg(priorities)::int[])))
+
+SELECT iteration_day
+     , coalesce(sum(removed.effort), 0)::decimal as qty
+  FROM iteration
+       JOIN lateral (SELECT date_trunc('day', dd):: date as iteration_day
+                       FROM generate_series
+                               ( iteration.start_date::timestamp
+                               , iteration.end_date::timestamp
+                               , '1 day'::interval) dd
+                      WHERE EXTRACT(ISODOW FROM dd) not IN (6, 7)) dates on true
+       LEFT JOIN removed on removed.event_date <= dates.iteration_day
+ WHERE iteration.id = sqlc.arg(iteration_id)::int
+ GROUP BY iteration_day
+ORDER BY iteration_day;
+
 -- name: GetIterations :many
 SELECT id, gh_id, name, start_date, end_date, project_id 
 FROM iteration WHERE project_id = $1;
 
 -- name: GetProjects :many
-SELECT id, gh_id, name
+SELECT id, gh_id, name, time_zone, rollup
 FROM project;
 
+-- name: GetProjectTimeZone :one
+SELECT time_zone
+FROM project
+WHERE id = $1;
+
 -- name: GetIterationBurndown :many
 WITH starting_effort AS (
- SELECT sum(effort) AS effort
+ SELECT sum(rollup_effort(work_item_history)) AS effort
  FROM work_item_history
- WHERE change_date = (SELECT min(change_date) FROM work_item_history WHERE iteration_id = 1)
-   AND iteration_id = $1)
+ WHERE change_date = (SELECT min(change_date) FROM work_item_history WHERE iteration_id = sqlc.arg(iteration_id)::int)
+   AND iteration_id = sqlc.arg(iteration_id)::int
+   AND (sqlc.narg(types)::text[] IS NULL OR content_type = ANY(sqlc.narg(types)::text[]))
+   AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+   AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+   AND (sqlc.narg(priorities)::int[] IS NULL OR priority = ANY(sqlc.narg(priorities)::int[])))
 
 SELECT iteration_day
-     , cast(sum(case when status <> 'Done' then work_item_history.effort else 0 end) as decimal) as remaining
+     , cast(sum(case when status <> 'Done' then rollup_effort(work_item_history) else 0 end) as decimal) as remaining
      , cast(seffort.effort::decimal - (seffort.effort::decimal / total_days.total * row_number() over (order by iteration_day)) as decimal) as ideal
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
   FROM iteration
        JOIN lateral (SELECT date_trunc('day', dd):: date as iteration_day
                        FROM generate_series
@@ -77,23 +290,339 @@ SELECT iteration_day
                       WHERE EXTRACT(ISODOW FROM dd) not IN (6, 7)) total_days on true
         JOIN lateral (SELECT effort from starting_effort) seffort on true
        LEFT JOIN work_item_history on work_item_history.change_date = dates.iteration_day
- WHERE iteration.id = $1
+                                  AND work_item_history.iteration_id = iteration.id
+                                  AND (sqlc.narg(types)::text[] IS NULL OR work_item_history.content_type = ANY(sqlc.narg(types)::text[]))
+                                  AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+                                  AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+                                  AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_history.priority = ANY(sqlc.narg(priorities)::int[]))
+ WHERE iteration.id = sqlc.arg(iteration_id)::int
  GROUP BY iteration_day, total_days.total, seffort.effort
 ORDER BY iteration_day;
 
+-- name: GetIterationIntradayBurndown :many
+WITH iteration_zone AS (
+  SELECT iteration.project_id
+       , iteration.start_date
+       , iteration.end_date
+       , coalesce(sqlc.narg(time_zone)::text, project.time_zone) as time_zone
+    FROM iteration
+         JOIN project ON project.id = iteration.project_id
+   WHERE iteration.id = sqlc.arg(iteration_id)::int
+), snapshots AS (
+  -- captured_at is UTC while the iteration dates are local to the project so
+  -- snapshots are compared and bucketed by their local hour, the last snapshot
+  -- of each hour stands for the hour
+  SELECT DISTINCT ON (date_trunc('hour', local.captured_at)) work_item_intraday.captured_at
+       , date_trunc('hour', local.captured_at)::timestamp as iteration_hour
+    FROM iteration_zone
+         JOIN work_item_intraday ON work_item_intraday.project_id = iteration_zone.project_id
+         JOIN lateral (SELECT work_item_intraday.captured_at AT TIME ZONE 'UTC' AT TIME ZONE iteration_zone.time_zone as captured_at) local on true
+   WHERE local.captured_at >= iteration_zone.start_date::timestamp
+     AND local.captured_at < (iteration_zone.end_date + 1)::timestamp
+   ORDER BY date_trunc('hour', local.captured_at), work_item_intraday.captured_at DESC
+), hours AS (
+  SELECT snapshots.iteration_hour
+       , coalesce(sum(case when work_item_intraday.status <> 'Done' then work_item_intraday.effort else 0 end), 0)::decimal as remaining
+    FROM snapshots
+         LEFT JOIN work_item_intraday on work_item_intraday.captured_at = snapshots.captured_at
+                                     AND work_item_intraday.iteration_id = sqlc.arg(iteration_id)::int
+                                     AND (sqlc.narg(types)::text[] IS NULL OR work_item_intraday.content_type = ANY(sqlc.narg(types)::text[]))
+                                     AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_intraday.labels && sqlc.narg(include_labels)::text[])
+                                     AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT (work_item_intraday.labels && sqlc.narg(exclude_labels)::text[]))
+                                     AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_intraday.priority = ANY(sqlc.narg(priorities)::int[]))
+   GROUP BY snapshots.iteration_hour, snapshots.captured_at
+)
+-- the ideal line goes from the first remaining effort to zero at the end of
+-- the last day of the iteration
+SELECT hours.iteration_hour
+     , hours.remaining
+     , cast(first_value(hours.remaining) over (order by hours.iteration_hour)
+            * greatest(0, 1 - extract(epoch from hours.iteration_hour - iteration_zone.start_date::timestamp)
+                            / extract(epoch from (iteration_zone.end_date + 1)::timestamp - iteration_zone.start_date::timestamp)) as decimal) as ideal
+  FROM hours
+       JOIN iteration_zone on true
+ORDER BY hours.iteration_hour;
 
 -- name: GetProjectBurnup :many
 SELECT statuses.name as status
      , project_day
-     , sum(work_item_history.effort)::decimal as qty
+     , sum(rollup_effort(work_item_history))::decimal as qty
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
   FROM work_item_status statuses
        JOIN lateral (SELECT date_trunc('day', dd):: date as project_day
                        FROM generate_series
-                               ( $2::timestamp 
-                               , now()::timestamp
+                               ( sqlc.arg(start_date)::timestamp 
+                               , (now() AT TIME ZONE coalesce(sqlc.narg(time_zone)::text, (SELECT time_zone FROM project WHERE project.id = sqlc.arg(project_id)::int)))
                                , '1 day'::interval) dd) dates on true
         LEFT JOIN work_item_history on work_item_history.change_date = dates.project_day and work_item_history.status = statuses.name
-        LEFT JOIN iteration on work_item_history.iteration_id = iteration.id
- WHERE (iteration.project_id = $1 or iteration.project_id is null)
+                                   and (sqlc.narg(types)::text[] IS NULL OR work_item_history.content_type = ANY(sqlc.narg(types)::text[]))
+                                   and (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+                                   and (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+                                   and (sqlc.narg(priorities)::int[] IS NULL OR work_item_history.priority = ANY(sqlc.narg(priorities)::int[]))
+                                   and work_item_history.project_id = sqlc.arg(project_id)::int
  GROUP BY statuses.name, dates.project_day
-ORDER BY statuses.name, dates.project_day;
\ No newline at end of file
+ORDER BY statuses.name, dates.project_day;
+
+-- name: GetProjectIntradayBurnup :many
+WITH snapshots AS (
+  -- captured_at is UTC, snapshots are bucketed by their hour in the time zone
+  -- of the project and the last snapshot of each hour stands for the hour
+  SELECT DISTINCT ON (date_trunc('hour', local.captured_at)) work_item_intraday.captured_at
+       , date_trunc('hour', local.captured_at)::timestamp as project_hour
+    FROM work_item_intraday
+         JOIN lateral (SELECT work_item_intraday.captured_at AT TIME ZONE 'UTC'
+                                AT TIME ZONE coalesce(sqlc.narg(time_zone)::text, (SELECT time_zone FROM project WHERE project.id = sqlc.arg(project_id)::int)) as captured_at) local on true
+   WHERE work_item_intraday.project_id = sqlc.arg(project_id)::int
+     AND work_item_intraday.captured_at >= sqlc.arg(start_date)::timestamp
+   ORDER BY date_trunc('hour', local.captured_at), work_item_intraday.captured_at DESC
+)
+SELECT work_item_intraday.status::text as status
+     , snapshots.project_hour
+     , sum(work_item_intraday.effort)::decimal as qty
+  FROM snapshots
+       JOIN work_item_intraday on work_item_intraday.project_id = sqlc.arg(project_id)::int
+                              AND work_item_intraday.captured_at = snapshots.captured_at
+ WHERE work_item_intraday.status IS NOT NULL
+   AND (sqlc.narg(types)::text[] IS NULL OR work_item_intraday.content_type = ANY(sqlc.narg(types)::text[]))
+   AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_intraday.labels && sqlc.narg(include_labels)::text[])
+   AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT (work_item_intraday.labels && sqlc.narg(exclude_labels)::text[]))
+   AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_intraday.priority = ANY(sqlc.narg(priorities)::int[]))
+ GROUP BY work_item_intraday.status, snapshots.project_hour, snapshots.captured_at
+ORDER BY work_item_intraday.status, snapshots.project_hour;
+
+-- name: GetCycleTimeItems :many
+SELECT work_item.gh_id
+     , work_item.name
+     , work_item.created_at
+     , work_item.closed_at
+     , (SELECT min(entered_date)
+          FROM work_item_status_change
+         WHERE work_item_status_change.gh_id = work_item.gh_id
+           AND work_item_status_change.status = ANY(sqlc.arg(start_statuses)::text[]))::date as cycle_start_date
+  FROM work_item
+ WHERE work_item.project_id = sqlc.arg(project_id)::int
+   AND work_item.closed_at >= sqlc.arg(start_date)::timestamp
+   AND work_item.closed_at < sqlc.arg(end_date)::timestamp
+ORDER BY work_item.closed_at;
+
+-- name: GetProjectSyncState :one
+SELECT project_name, items_updated_at, full_synced_at
+FROM project_sync_state
+WHERE project_name = $1;
+
+-- name: UpsertProjectSyncState :exec
+INSERT INTO project_sync_state (project_name, items_updated_at, full_synced_at)
+VALUES ($1, $2, $3)
+ON CONFLICT(project_name)
+DO UPDATE SET
+  items_updated_at = EXCLUDED.items_updated_at,
+  full_synced_at = EXCLUDED.full_synced_at;
+
+-- name: CarryForwardWorkItems :one
+WITH previous AS (
+  SELECT DISTINCT ON (gh_id) id, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM work_item_history
+   WHERE project_id = sqlc.arg(project_id)
+     AND gh_id = ANY(sqlc.arg(gh_ids)::text[])
+     AND change_date < sqlc.arg(change_date)
+     AND NOT inferred
+   ORDER BY gh_id, change_date DESC
+), carried AS (
+  INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id, rolled_up)
+  SELECT sqlc.arg(change_date), gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, false, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM previous
+  ON CONFLICT(change_date, gh_id) DO NOTHING
+  RETURNING id, gh_id
+), carried_labels AS (
+  INSERT INTO work_item_label (work_item_history_id, label_id)
+  SELECT carried.id, work_item_label.label_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id
+         JOIN work_item_label ON work_item_label.work_item_history_id = previous.id
+), carried_assignees AS (
+  INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+  SELECT carried.id, work_item_assignee.assignee_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id
+         JOIN work_item_assignee ON work_item_assignee.work_item_history_id = previous.id
+)
+-- items without an earlier snapshot have nothing to carry
+SELECT count(*) FROM previous;
+
+-- name: FillWorkItemHistoryGaps :many
+WITH project_days AS (
+  SELECT date_trunc('day', dd)::date AS day
+    FROM generate_series
+            ( (SELECT min(change_date) FROM work_item_history WHERE project_id = sqlc.arg(project_id)::int)::timestamp
+            , sqlc.arg(end_date)::timestamp
+            , '1 day'::interval) dd
+), gaps AS (
+  SELECT project_days.day
+       , (SELECT max(change_date) FROM work_item_history WHERE project_id = sqlc.arg(project_id)::int AND change_date < project_days.day) AS source_day
+    FROM project_days
+   WHERE NOT EXISTS (SELECT 1 FROM work_item_history WHERE project_id = sqlc.arg(project_id)::int AND change_date = project_days.day)
+), previous AS (
+  SELECT gaps.day, work_item_history.id, work_item_history.gh_id, work_item_history.name, work_item_history.status, work_item_history.priority, work_item_history.remaining_hours, work_item_history.effort, work_item_history.iteration_id, work_item_history.project_id, work_item_history.content_type, work_item_history.milestone_id, work_item_history.parent_gh_id, work_item_history.root_gh_id, work_item_history.rolled_up
+    FROM gaps
+         JOIN work_item_history ON work_item_history.project_id = sqlc.arg(project_id)::int
+                               AND work_item_history.change_date = gaps.source_day
+), carried AS (
+  INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id, rolled_up)
+  SELECT day, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, true, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM previous
+  ON CONFLICT(change_date, gh_id) DO NOTHING
+  RETURNING id, gh_id, change_date
+), carried_labels AS (
+  INSERT INTO work_item_label (work_item_history_id, label_id)
+  SELECT carried.id, work_item_label.label_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id AND previous.day = carried.change_date
+         JOIN work_item_label ON work_item_label.work_item_history_id = previous.id
+), carried_assignees AS (
+  INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+  SELECT carried.id, work_item_assignee.assignee_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id AND previous.day = carried.change_date
+         JOIN work_item_assignee ON work_item_assignee.work_item_history_id = previous.id
+)
+SELECT DISTINCT change_date::date
+  FROM carried
+ORDER BY change_date;
+
+-- name: SaveIntradaySnapshot :exec
+INSERT INTO work_item_intraday (captured_at, gh_id, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, labels)
+-- intraday snapshots do not keep the hierarchy so they hold the counted values
+SELECT sqlc.arg(captured_at), work_item_history.gh_id, work_item_history.status, work_item_history.priority, rollup_remaining_hours(work_item_history), rollup_effort(work_item_history), work_item_history.iteration_id, work_item_history.project_id, work_item_history.content_type
+     , coalesce((SELECT array_agg(label.name ORDER BY label.name) FROM work_item_label JOIN label ON label.id = work_item_label.label_id WHERE work_item_label.work_item_history_id = work_item_history.id), '{}')
+  FROM work_item_history
+ WHERE work_item_history.project_id = sqlc.arg(project_id)
+   AND work_item_history.change_date = sqlc.arg(change_date)
+   AND work_item_history.gh_id = ANY(sqlc.arg(gh_ids)::text[])
+ON CONFLICT(captured_at, gh_id) DO NOTHING;
+
+-- name: GetMilestones :many
+SELECT id, gh_id, name, state, start_date, due_date, project_id
+FROM milestone
+WHERE project_id = $1
+ORDER BY due_date NULLS LAST, name;
+
+-- name: GetMilestoneBurndown :many
+WITH milestone_days AS (
+  SELECT date_trunc('day', dd)::date AS milestone_day
+    FROM milestone
+         JOIN lateral generate_series
+                 ( milestone.start_date::timestamp
+                 , coalesce(milestone.due_date, (now() AT TIME ZONE coalesce(sqlc.narg(time_zone)::text, (SELECT time_zone FROM project WHERE project.id = milestone.project_id)))::date)::timestamp
+                 , '1 day'::interval) dd on true
+   WHERE milestone.id = sqlc.arg(milestone_id)::int
+     AND milestone.project_id = sqlc.arg(project_id)::int
+     AND EXTRACT(ISODOW FROM dd) not IN (6, 7)
+), milestone_items AS (
+  SELECT work_item_history.change_date, work_item_history.status, rollup_effort(work_item_history) as effort, work_item_history.inferred
+    FROM work_item_history
+   WHERE work_item_history.milestone_id = sqlc.arg(milestone_id)::int
+     AND (sqlc.narg(types)::text[] IS NULL OR work_item_history.content_type = ANY(sqlc.narg(types)::text[]))
+     AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+     AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+     AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_history.priority = ANY(sqlc.narg(priorities)::int[]))
+), starting_effort AS (
+  SELECT coalesce(sum(effort), 0) AS effort
+    FROM milestone_items
+   WHERE change_date = (SELECT min(change_date) FROM milestone_items WHERE change_date >= (SELECT min(milestone_day) FROM milestone_days))
+), total_days AS (
+  SELECT count(*)::decimal AS total
+    FROM milestone_days
+)
+SELECT milestone_day
+     , cast(coalesce(sum(case when milestone_items.status <> 'Done' then milestone_items.effort else 0 end), 0) as decimal) as remaining
+     , cast(starting_effort.effort::decimal - (starting_effort.effort::decimal / total_days.total * row_number() over (order by milestone_day)) as decimal) as ideal
+     , coalesce(bool_or(milestone_items.inferred), false)::boolean as inferred
+  FROM milestone_days
+       CROSS JOIN starting_effort
+       CROSS JOIN total_days
+       LEFT JOIN milestone_items on milestone_items.change_date = milestone_days.milestone_day
+ GROUP BY milestone_day, total_days.total, starting_effort.effort
+ORDER BY milestone_day;
+
+-- name: GetProjectPriorityBreakdown :many
+SELECT work_item_history.change_date as project_day
+     , work_item_history.priority
+     , coalesce(sum(case when work_item_history.status <> 'Done' then rollup_effort(work_item_history) else 0 end), 0)::decimal as remaining
+     , coalesce(sum(case when work_item_history.status = 'Done' then rollup_effort(work_item_history) else 0 end), 0)::decimal as done
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
+  FROM work_item_history
+ WHERE work_item_history.project_id = sqlc.arg(project_id)::int
+   AND work_item_history.change_date >= sqlc.arg(start_date)::timestamp
+   AND (sqlc.narg(types)::text[] IS NULL OR work_item_history.content_type = ANY(sqlc.narg(types)::text[]))
+   AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+   AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+   AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_history.priority = ANY(sqlc.narg(priorities)::int[]))
+ GROUP BY work_item_history.change_date, work_item_history.priority
+ORDER BY work_item_history.change_date, work_item_history.priority NULLS LAST;
+
+-- name: GetIterationWorkload :many
+WITH iteration_items AS (
+  SELECT work_item_history.id
+       , work_item_history.status
+       , work_item_history.inferred
+       , rollup_effort(work_item_history) as effort
+       , rollup_remaining_hours(work_item_history) as remaining_hours
+    FROM work_item_history
+   WHERE work_item_history.iteration_id = sqlc.arg(iteration_id)::int
+     -- the last snapshot taken while the iteration was running
+     AND work_item_history.change_date = (SELECT max(change_date)
+                                            FROM work_item_history latest
+                                                 JOIN iteration ON iteration.id = latest.iteration_id
+                                           WHERE latest.iteration_id = sqlc.arg(iteration_id)::int
+                                             AND latest.change_date <= iteration.end_date)
+     AND (sqlc.narg(types)::text[] IS NULL OR work_item_history.content_type = ANY(sqlc.narg(types)::text[]))
+     AND (sqlc.narg(include_labels)::text[] IS NULL OR work_item_has_label(work_item_history.id, sqlc.narg(include_labels)::text[]))
+     AND (sqlc.narg(exclude_labels)::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, sqlc.narg(exclude_labels)::text[]))
+     AND (sqlc.narg(priorities)::int[] IS NULL OR work_item_history.priority = ANY(sqlc.narg(priorities)::int[]))
+)
+SELECT assignee.login as assignee
+     , coalesce(sum(iteration_items.effort), 0)::decimal as committed
+     , coalesce(sum(case when iteration_items.status = 'Done' then iteration_items.effort else 0 end), 0)::decimal as completed
+     , coalesce(sum(case when iteration_items.status <> 'Done' then iteration_items.remaining_hours else 0 end), 0)::decimal as remaining_hours
+     , coalesce(bool_or(iteration_items.inferred), false)::boolean as inferred
+  FROM iteration_items
+       LEFT JOIN work_item_assignee ON work_item_assignee.work_item_history_id = iteration_items.id
+       LEFT JOIN assignee ON assignee.id = work_item_assignee.assignee_id
+ GROUP BY assignee.login
+ORDER BY assignee.login NULLS LAST;
+
+-- name: GetWorkItemTree :many
+SELECT work_item_history.gh_id, work_item_history.name, status, effort, remaining_hours, parent_gh_id, inferred, rolled_up, project.rollup
+FROM work_item_history
+JOIN project ON project.id = work_item_history.project_id
+WHERE work_item_history.project_id = sqlc.arg(project_id)::int
+  AND change_date = (SELECT max(change_date) FROM work_item_history WHERE project_id = sqlc.arg(project_id)::int)
+ORDER BY work_item_history.name;
+
+-- name: GetRegisteredProjects :many
+SELECT id, name, settings, encrypted_token, created_at, updated_at
+FROM registered_project
+ORDER BY id;
+
+-- name: GetRegisteredProject :one
+SELECT id, name, settings, encrypted_token, created_at, updated_at
+FROM registered_project
+WHERE id = $1;
+
+-- name: InsertRegisteredProject :one
+INSERT INTO registered_project (name, settings, encrypted_token)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UpdateRegisteredProject :one
+UPDATE registered_project
+SET name = $2,
+    settings = $3,
+    encrypted_token = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteRegisteredProject :execrows
+DELETE FROM registered_project
+WHERE id = $1;
@@ -11,52 +11,1220 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const carryForwardWorkItems = `-- name: CarryForwardWorkItems :one
+WITH previous AS (
+  SELECT DISTINCT ON (gh_id) id, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM work_item_history
+   WHERE project_id = $1
+     AND gh_id = ANY($2::text[])
+     AND change_date < $3
+     AND NOT inferred
+   ORDER BY gh_id, change_date DESC
+), carried AS (
+  INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id, rolled_up)
+  SELECT $3, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, false, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM previous
+  ON CONFLICT(change_date, gh_id) DO NOTHING
+  RETURNING id, gh_id
+), carried_labels AS (
+  INSERT INTO work_item_label (work_item_history_id, label_id)
+  SELECT carried.id, work_item_label.label_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id
+         JOIN work_item_label ON work_item_label.work_item_history_id = previous.id
+), carried_assignees AS (
+  INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+  SELECT carried.id, work_item_assignee.assignee_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id
+         JOIN work_item_assignee ON work_item_assignee.work_item_history_id = previous.id
+)
+-- items without an earlier snapshot have nothing to carry
+SELECT count(*) FROM previous
+`
+
+type CarryForwardWorkItemsParams struct {
+	ProjectID  int32
+	GhIds      []string
+	ChangeDate pgtype.Date
+}
+
+func (q *Queries) CarryForwardWorkItems(ctx context.Context, arg CarryForwardWorkItemsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, carryForwardWorkItems, arg.ProjectID, arg.GhIds, arg.ChangeDate)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteRegisteredProject = `-- name: DeleteRegisteredProject :execrows
+DELETE FROM registered_project
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRegisteredProject(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRegisteredProject, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWorkItemAssignees = `-- name: DeleteWorkItemAssignees :exec
+DELETE FROM work_item_assignee
+WHERE work_item_history_id = $1
+`
+
+func (q *Queries) DeleteWorkItemAssignees(ctx context.Context, workItemHistoryID int32) error {
+	_, err := q.db.Exec(ctx, deleteWorkItemAssignees, workItemHistoryID)
+	return err
+}
+
+const deleteWorkItemLabels = `-- name: DeleteWorkItemLabels :exec
+DELETE FROM work_item_label
+WHERE work_item_history_id = $1
+`
+
+func (q *Queries) DeleteWorkItemLabels(ctx context.Context, workItemHistoryID int32) error {
+	_, err := q.db.Exec(ctx, deleteWorkItemLabels, workItemHistoryID)
+	return err
+}
+
+const deleteWorkItemSnapshot = `-- name: DeleteWorkItemSnapshot :exec
+DELETE FROM work_item_history
+WHERE gh_id = $1
+  AND change_date = $2
+`
+
+type DeleteWorkItemSnapshotParams struct {
+	GhID       string
+	ChangeDate pgtype.Date
+}
+
+func (q *Queries) DeleteWorkItemSnapshot(ctx context.Context, arg DeleteWorkItemSnapshotParams) error {
+	_, err := q.db.Exec(ctx, deleteWorkItemSnapshot, arg.GhID, arg.ChangeDate)
+	return err
+}
+
+const fillWorkItemHistoryGaps = `-- name: FillWorkItemHistoryGaps :many
+WITH project_days AS (
+  SELECT date_trunc('day', dd)::date AS day
+    FROM generate_series
+            ( (SELECT min(change_date) FROM work_item_history WHERE project_id = $1::int)::timestamp
+            , $2::timestamp
+            , '1 day'::interval) dd
+), gaps AS (
+  SELECT project_days.day
+       , (SELECT max(change_date) FROM work_item_history WHERE project_id = $1::int AND change_date < project_days.day) AS source_day
+    FROM project_days
+   WHERE NOT EXISTS (SELECT 1 FROM work_item_history WHERE project_id = $1::int AND change_date = project_days.day)
+), previous AS (
+  SELECT gaps.day, work_item_history.id, work_item_history.gh_id, work_item_history.name, work_item_history.status, work_item_history.priority, work_item_history.remaining_hours, work_item_history.effort, work_item_history.iteration_id, work_item_history.project_id, work_item_history.content_type, work_item_history.milestone_id, work_item_history.parent_gh_id, work_item_history.root_gh_id, work_item_history.rolled_up
+    FROM gaps
+         JOIN work_item_history ON work_item_history.project_id = $1::int
+                               AND work_item_history.change_date = gaps.source_day
+), carried AS (
+  INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id, rolled_up)
+  SELECT day, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, true, milestone_id, parent_gh_id, root_gh_id, rolled_up
+    FROM previous
+  ON CONFLICT(change_date, gh_id) DO NOTHING
+  RETURNING id, gh_id, change_date
+), carried_labels AS (
+  INSERT INTO work_item_label (work_item_history_id, label_id)
+  SELECT carried.id, work_item_label.label_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id AND previous.day = carried.change_date
+         JOIN work_item_label ON work_item_label.work_item_history_id = previous.id
+), carried_assignees AS (
+  INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+  SELECT carried.id, work_item_assignee.assignee_id
+    FROM carried
+         JOIN previous ON previous.gh_id = carried.gh_id AND previous.day = carried.change_date
+         JOIN work_item_assignee ON work_item_assignee.work_item_history_id = previous.id
+)
+SELECT DISTINCT change_date::date
+  FROM carried
+ORDER BY change_date
+`
+
+type FillWorkItemHistoryGapsParams struct {
+	ProjectID int32
+	EndDate   pgtype.Timestamp
+}
+
+func (q *Queries) FillWorkItemHistoryGaps(ctx context.Context, arg FillWorkItemHistoryGapsParams) ([]pgtype.Date, error) {
+	rows, err := q.db.Query(ctx, fillWorkItemHistoryGaps, arg.ProjectID, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.Date
+	for rows.Next() {
+		var change_date pgtype.Date
+		if err := rows.Scan(&change_date); err != nil {
+			return nil, err
+		}
+		items = append(items, change_date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCycleTimeItems = `-- name: GetCycleTimeItems :many
+SELECT work_item.gh_id
+     , work_item.name
+     , work_item.created_at
+     , work_item.closed_at
+     , (SELECT min(entered_date)
+          FROM work_item_status_change
+         WHERE work_item_status_change.gh_id = work_item.gh_id
+           AND work_item_status_change.status = ANY($1::text[]))::date as cycle_start_date
+  FROM work_item
+ WHERE work_item.project_id = $2::int
+   AND work_item.closed_at >= $3::timestamp
+   AND work_item.closed_at < $4::timestamp
+ORDER BY work_item.closed_at
+`
+
+type GetCycleTimeItemsParams struct {
+	StartStatuses []string
+	ProjectID     int32
+	StartDate     pgtype.Timestamp
+	EndDate       pgtype.Timestamp
+}
+
+type GetCycleTimeItemsRow struct {
+	GhID           string
+	Name           string
+	CreatedAt      pgtype.Timestamp
+	ClosedAt       pgtype.Timestamp
+	CycleStartDate pgtype.Date
+}
+
+func (q *Queries) GetCycleTimeItems(ctx context.Context, arg GetCycleTimeItemsParams) ([]GetCycleTimeItemsRow, error) {
+	rows, err := q.db.Query(ctx, getCycleTimeItems,
+		arg.StartStatuses,
+		arg.ProjectID,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCycleTimeItemsRow
+	for rows.Next() {
+		var i GetCycleTimeItemsRow
+		if err := rows.Scan(
+			&i.GhID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.ClosedAt,
+			&i.CycleStartDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getIterationBurndown = `-- name: GetIterationBurndown :many
-with starting_effort as (
- select sum(effort) as effort
- from work_item_history
- where change_date = (select min(change_date) from work_item_history where iteration_id = 1)
-   and iteration_id = $1)
-
-select iteration_day
-     , cast(sum(case when status <> 'Done' then work_item_history.effort else 0 end) as decimal) as remaining
+WITH starting_effort AS (
+ SELECT sum(rollup_effort(work_item_history)) AS effort
+ FROM work_item_history
+ WHERE change_date = (SELECT min(change_date) FROM work_item_history WHERE iteration_id = $1::int)
+   AND iteration_id = $1::int
+   AND ($2::text[] IS NULL OR content_type = ANY($2::text[]))
+   AND ($3::text[] IS NULL OR work_item_has_label(work_item_history.id, $3::text[]))
+   AND ($4::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $4::text[]))
+   AND ($5::int[] IS NULL OR priority = ANY($5::int[])))
+
+SELECT iteration_day
+     , cast(sum(case when status <> 'Done' then rollup_effort(work_item_history) else 0 end) as decimal) as remaining
      , cast(seffort.effort::decimal - (seffort.effort::decimal / total_days.total * row_number() over (order by iteration_day)) as decimal) as ideal
-  from iteration
-       join lateral (SELECT date_trunc('day', dd):: date as iteration_day
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
+  FROM iteration
+       JOIN lateral (SELECT date_trunc('day', dd):: date as iteration_day
                        FROM generate_series
                                ( iteration.start_date::timestamp 
                                , iteration.end_date::timestamp
                                , '1 day'::interval) dd
-                      where EXTRACT(ISODOW FROM dd) not IN (6, 7)) dates on true
-       join lateral (SELECT count(*)::decimal as total
+                      WHERE EXTRACT(ISODOW FROM dd) not IN (6, 7)) dates on true
+       JOIN lateral (SELECT count(*)::decimal as total
                        FROM generate_series
                                ( iteration.start_date::timestamp 
                                , iteration.end_date::timestamp
                                , '1 day'::interval) dd
-                      where EXTRACT(ISODOW FROM dd) not IN (6, 7)) total_days on true
-        join lateral (select effort from starting_effort) seffort on true
-       left join work_item_history on work_item_history.change_date = dates.iteration_day
- where iteration.id = $1
- group by iteration_day, total_days.total, seffort.effort
-order by iteration_day
+                      WHERE EXTRACT(ISODOW FROM dd) not IN (6, 7)) total_days on true
+        JOIN lateral (SELECT effort from starting_effort) seffort on true
+       LEFT JOIN work_item_history on work_item_history.change_date = dates.iteration_day
+                                  AND work_item_history.iteration_id = iteration.id
+                                  AND ($2::text[] IS NULL OR work_item_history.content_type = ANY($2::text[]))
+                                  AND ($3::text[] IS NULL OR work_item_has_label(work_item_history.id, $3::text[]))
+                                  AND ($4::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $4::text[]))
+                                  AND ($5::int[] IS NULL OR work_item_history.priority = ANY($5::int[]))
+ WHERE iteration.id = $1::int
+ GROUP BY iteration_day, total_days.total, seffort.effort
+ORDER BY iteration_day
+`
+
+type GetIterationBurndownParams struct {
+	IterationID   int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetIterationBurndownRow struct {
+	IterationDay pgtype.Date
+	Remaining    pgtype.Numeric
+	Ideal        pgtype.Numeric
+	Inferred     bool
+}
+
+func (q *Queries) GetIterationBurndown(ctx context.Context, arg GetIterationBurndownParams) ([]GetIterationBurndownRow, error) {
+	rows, err := q.db.Query(ctx, getIterationBurndown,
+		arg.IterationID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIterationBurndownRow
+	for rows.Next() {
+		var i GetIterationBurndownRow
+		if err := rows.Scan(
+			&i.IterationDay,
+			&i.Remaining,
+			&i.Ideal,
+			&i.Inferred,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIterationIntradayBurndown = `-- name: GetIterationIntradayBurndown :many
+WITH iteration_zone AS (
+  SELECT iteration.project_id
+       , iteration.start_date
+       , iteration.end_date
+       , coalesce($1::text, project.time_zone) as time_zone
+    FROM iteration
+         JOIN project ON project.id = iteration.project_id
+   WHERE iteration.id = $2::int
+), snapshots AS (
+  -- captured_at is UTC while the iteration dates are local to the project so
+  -- snapshots are compared and bucketed by their local hour, the last snapshot
+  -- of each hour stands for the hour
+  SELECT DISTINCT ON (date_trunc('hour', local.captured_at)) work_item_intraday.captured_at
+       , date_trunc('hour', local.captured_at)::timestamp as iteration_hour
+    FROM iteration_zone
+         JOIN work_item_intraday ON work_item_intraday.project_id = iteration_zone.project_id
+         JOIN lateral (SELECT work_item_intraday.captured_at AT TIME ZONE 'UTC' AT TIME ZONE iteration_zone.time_zone as captured_at) local on true
+   WHERE local.captured_at >= iteration_zone.start_date::timestamp
+     AND local.captured_at < (iteration_zone.end_date + 1)::timestamp
+   ORDER BY date_trunc('hour', local.captured_at), work_item_intraday.captured_at DESC
+), hours AS (
+  SELECT snapshots.iteration_hour
+       , coalesce(sum(case when work_item_intraday.status <> 'Done' then work_item_intraday.effort else 0 end), 0)::decimal as remaining
+    FROM snapshots
+         LEFT JOIN work_item_intraday on work_item_intraday.captured_at = snapshots.captured_at
+                                     AND work_item_intraday.iteration_id = $2::int
+                                     AND ($3::text[] IS NULL OR work_item_intraday.content_type = ANY($3::text[]))
+                                     AND ($4::text[] IS NULL OR work_item_intraday.labels && $4::text[])
+                                     AND ($5::text[] IS NULL OR NOT (work_item_intraday.labels && $5::text[]))
+                                     AND ($6::int[] IS NULL OR work_item_intraday.priority = ANY($6::int[]))
+   GROUP BY snapshots.iteration_hour, snapshots.captured_at
+)
+-- the ideal line goes from the first remaining effort to zero at the end of
+-- the last day of the iteration
+SELECT hours.iteration_hour
+     , hours.remaining
+     , cast(first_value(hours.remaining) over (order by hours.iteration_hour)
+            * greatest(0, 1 - extract(epoch from hours.iteration_hour - iteration_zone.start_date::timestamp)
+                            / extract(epoch from (iteration_zone.end_date + 1)::timestamp - iteration_zone.start_date::timestamp)) as decimal) as ideal
+  FROM hours
+       JOIN iteration_zone on true
+ORDER BY hours.iteration_hour
+`
+
+type GetIterationIntradayBurndownParams struct {
+	TimeZone      pgtype.Text
+	IterationID   int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetIterationIntradayBurndownRow struct {
+	IterationHour pgtype.Timestamp
+	Remaining     pgtype.Numeric
+	Ideal         pgtype.Numeric
+}
+
+func (q *Queries) GetIterationIntradayBurndown(ctx context.Context, arg GetIterationIntradayBurndownParams) ([]GetIterationIntradayBurndownRow, error) {
+	rows, err := q.db.Query(ctx, getIterationIntradayBurndown,
+		arg.TimeZone,
+		arg.IterationID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIterationIntradayBurndownRow
+	for rows.Next() {
+		var i GetIterationIntradayBurndownRow
+		if err := rows.Scan(&i.IterationHour, &i.Remaining, &i.Ideal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIterationScopeRemoved = `-- name: GetIterationScopeRemoved :many
+WITH removed AS (
+ SELECT work_item_event.event_date, work_item_event.effort
+   FROM work_item_event
+        -- labels are filtered by the last snapshot before the item was removed
+        LEFT JOIN lateral (SELECT work_item_history.id, work_item_history.priority
+                             FROM work_item_history
+                            WHERE work_item_history.gh_id = work_item_event.gh_id
+                              AND work_item_history.change_date < work_item_event.event_date
+                            ORDER BY work_item_history.change_date DESC
+                            LIMIT 1) last_snapshot on true
+  WHERE work_item_event.iteration_id = $1::int
+    AND ($2::text[] IS NULL OR work_item_event.content_type = ANY($2::text[]))
+    AND ($3::text[] IS NULL OR work_item_has_label(last_snapshot.id, $3::text[]))
+    AND ($4::text[] IS NULL OR NOT work_item_has_label(last_snapshot.id, $4::text[]))
+    AND ($5::int[] IS NULL OR last_snapshot.priority = ANY($5::int[])))
+
+SELECT iteration_day
+     , coalesce(sum(removed.effort), 0)::decimal as qty
+  FROM iteration
+       JOIN lateral (SELECT date_trunc('day', dd):: date as iteration_day
+                       FROM generate_series
+                               ( iteration.start_date::timestamp
+                               , iteration.end_date::timestamp
+                               , '1 day'::interval) dd
+                      WHERE EXTRACT(ISODOW FROM dd) not IN (6, 7)) dates on true
+       LEFT JOIN removed on removed.event_date <= dates.iteration_day
+ WHERE iteration.id = $1::int
+ GROUP BY iteration_day
+ORDER BY iteration_day
+`
+
+type GetIterationScopeRemovedParams struct {
+	IterationID   int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetIterationScopeRemovedRow struct {
+	IterationDay pgtype.Date
+	Qty          pgtype.Numeric
+}
+
+func (q *Queries) GetIterationScopeRemoved(ctx context.Context, arg GetIterationScopeRemovedParams) ([]GetIterationScopeRemovedRow, error) {
+	rows, err := q.db.Query(ctx, getIterationScopeRemoved,
+		arg.IterationID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIterationScopeRemovedRow
+	for rows.Next() {
+		var i GetIterationScopeRemovedRow
+		if err := rows.Scan(
+			&i.IterationDay,
+			&i.Qty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIterationWorkload = `-- name: GetIterationWorkload :many
+WITH iteration_items AS (
+  SELECT work_item_history.id
+       , work_item_history.status
+       , work_item_history.inferred
+       , rollup_effort(work_item_history) as effort
+       , rollup_remaining_hours(work_item_history) as remaining_hours
+    FROM work_item_history
+   WHERE work_item_history.iteration_id = $1::int
+     -- the last snapshot taken while the iteration was running
+     AND work_item_history.change_date = (SELECT max(change_date)
+                                            FROM work_item_history latest
+                                                 JOIN iteration ON iteration.id = latest.iteration_id
+                                           WHERE latest.iteration_id = $1::int
+                                             AND latest.change_date <= iteration.end_date)
+     AND ($2::text[] IS NULL OR work_item_history.content_type = ANY($2::text[]))
+     AND ($3::text[] IS NULL OR work_item_has_label(work_item_history.id, $3::text[]))
+     AND ($4::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $4::text[]))
+     AND ($5::int[] IS NULL OR work_item_history.priority = ANY($5::int[]))
+)
+SELECT assignee.login as assignee
+     , coalesce(sum(iteration_items.effort), 0)::decimal as committed
+     , coalesce(sum(case when iteration_items.status = 'Done' then iteration_items.effort else 0 end), 0)::decimal as completed
+     , coalesce(sum(case when iteration_items.status <> 'Done' then iteration_items.remaining_hours else 0 end), 0)::decimal as remaining_hours
+     , coalesce(bool_or(iteration_items.inferred), false)::boolean as inferred
+  FROM iteration_items
+       LEFT JOIN work_item_assignee ON work_item_assignee.work_item_history_id = iteration_items.id
+       LEFT JOIN assignee ON assignee.id = work_item_assignee.assignee_id
+ GROUP BY assignee.login
+ORDER BY assignee.login NULLS LAST
+`
+
+type GetIterationWorkloadParams struct {
+	IterationID   int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetIterationWorkloadRow struct {
+	Assignee       pgtype.Text
+	Committed      pgtype.Numeric
+	Completed      pgtype.Numeric
+	RemainingHours pgtype.Numeric
+	Inferred       bool
+}
+
+func (q *Queries) GetIterationWorkload(ctx context.Context, arg GetIterationWorkloadParams) ([]GetIterationWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, getIterationWorkload,
+		arg.IterationID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIterationWorkloadRow
+	for rows.Next() {
+		var i GetIterationWorkloadRow
+		if err := rows.Scan(
+			&i.Assignee,
+			&i.Committed,
+			&i.Completed,
+			&i.RemainingHours,
+			&i.Inferred,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIterations = `-- name: GetIterations :many
+SELECT id, gh_id, name, start_date, end_date, project_id FROM iteration where project_id = $1
+`
+
+func (q *Queries) GetIterations(ctx context.Context, projectID int32) ([]Iteration, error) {
+	rows, err := q.db.Query(ctx, getIterations, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Iteration
+	for rows.Next() {
+		var i Iteration
+		if err := rows.Scan(
+			&i.ID,
+			&i.GhID,
+			&i.Name,
+			&i.StartDate,
+			&i.EndDate,
+			&i.ProjectID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMilestoneBurndown = `-- name: GetMilestoneBurndown :many
+WITH milestone_days AS (
+  SELECT date_trunc('day', dd)::date AS milestone_day
+    FROM milestone
+         JOIN lateral generate_series
+                 ( milestone.start_date::timestamp
+                 , coalesce(milestone.due_date, (now() AT TIME ZONE coalesce($1::text, (SELECT time_zone FROM project WHERE project.id = milestone.project_id)))::date)::timestamp
+                 , '1 day'::interval) dd on true
+   WHERE milestone.id = $2::int
+     AND milestone.project_id = $3::int
+     AND EXTRACT(ISODOW FROM dd) not IN (6, 7)
+), milestone_items AS (
+  SELECT work_item_history.change_date, work_item_history.status, rollup_effort(work_item_history) as effort, work_item_history.inferred
+    FROM work_item_history
+   WHERE work_item_history.milestone_id = $2::int
+     AND ($4::text[] IS NULL OR work_item_history.content_type = ANY($4::text[]))
+     AND ($5::text[] IS NULL OR work_item_has_label(work_item_history.id, $5::text[]))
+     AND ($6::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $6::text[]))
+     AND ($7::int[] IS NULL OR work_item_history.priority = ANY($7::int[]))
+), starting_effort AS (
+  SELECT coalesce(sum(effort), 0) AS effort
+    FROM milestone_items
+   WHERE change_date = (SELECT min(change_date) FROM milestone_items WHERE change_date >= (SELECT min(milestone_day) FROM milestone_days))
+), total_days AS (
+  SELECT count(*)::decimal AS total
+    FROM milestone_days
+)
+SELECT milestone_day
+     , cast(coalesce(sum(case when milestone_items.status <> 'Done' then milestone_items.effort else 0 end), 0) as decimal) as remaining
+     , cast(starting_effort.effort::decimal - (starting_effort.effort::decimal / total_days.total * row_number() over (order by milestone_day)) as decimal) as ideal
+     , coalesce(bool_or(milestone_items.inferred), false)::boolean as inferred
+  FROM milestone_days
+       CROSS JOIN starting_effort
+       CROSS JOIN total_days
+       LEFT JOIN milestone_items on milestone_items.change_date = milestone_days.milestone_day
+ GROUP BY milestone_day, total_days.total, starting_effort.effort
+ORDER BY milestone_day
+`
+
+type GetMilestoneBurndownParams struct {
+	TimeZone      pgtype.Text
+	MilestoneID   int32
+	ProjectID     int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetMilestoneBurndownRow struct {
+	MilestoneDay pgtype.Date
+	Remaining    pgtype.Numeric
+	Ideal        pgtype.Numeric
+	Inferred     bool
+}
+
+func (q *Queries) GetMilestoneBurndown(ctx context.Context, arg GetMilestoneBurndownParams) ([]GetMilestoneBurndownRow, error) {
+	rows, err := q.db.Query(ctx, getMilestoneBurndown,
+		arg.TimeZone,
+		arg.MilestoneID,
+		arg.ProjectID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMilestoneBurndownRow
+	for rows.Next() {
+		var i GetMilestoneBurndownRow
+		if err := rows.Scan(
+			&i.MilestoneDay,
+			&i.Remaining,
+			&i.Ideal,
+			&i.Inferred,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMilestones = `-- name: GetMilestones :many
+SELECT id, gh_id, name, state, start_date, due_date, project_id
+FROM milestone
+WHERE project_id = $1
+ORDER BY due_date NULLS LAST, name
+`
+
+func (q *Queries) GetMilestones(ctx context.Context, projectID int32) ([]Milestone, error) {
+	rows, err := q.db.Query(ctx, getMilestones, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Milestone
+	for rows.Next() {
+		var i Milestone
+		if err := rows.Scan(
+			&i.ID,
+			&i.GhID,
+			&i.Name,
+			&i.State,
+			&i.StartDate,
+			&i.DueDate,
+			&i.ProjectID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPreviousWorkItems = `-- name: GetPreviousWorkItems :many
+SELECT DISTINCT ON (work_item_history.gh_id) work_item_history.gh_id
+     , work_item_history.name
+     , rollup_effort(work_item_history) as effort
+     , work_item_history.iteration_id
+     , iteration.gh_id as iteration_gh_id
+     , work_item_history.content_type
+  FROM work_item_history
+       JOIN project on project.id = work_item_history.project_id
+       LEFT JOIN iteration on iteration.id = work_item_history.iteration_id
+ WHERE project.gh_id = $1
+   AND NOT work_item_history.inferred
+   AND work_item_history.change_date <= $2
+   -- the last pulled day before change_date and any pull earlier on change_date
+   AND work_item_history.change_date >= coalesce((SELECT max(previous.change_date)
+                                                    FROM work_item_history previous
+                                                   WHERE previous.project_id = project.id
+                                                     AND previous.change_date < $2
+                                                     AND NOT previous.inferred), $2)
+ORDER BY work_item_history.gh_id, work_item_history.change_date DESC
+`
+
+type GetPreviousWorkItemsParams struct {
+	ProjectGhID string
+	ChangeDate  pgtype.Date
+}
+
+type GetPreviousWorkItemsRow struct {
+	GhID          string
+	Name          string
+	Effort        pgtype.Int4
+	IterationID   pgtype.Int4
+	IterationGhID pgtype.Text
+	ContentType   string
+}
+
+func (q *Queries) GetPreviousWorkItems(ctx context.Context, arg GetPreviousWorkItemsParams) ([]GetPreviousWorkItemsRow, error) {
+	rows, err := q.db.Query(ctx, getPreviousWorkItems, arg.ProjectGhID, arg.ChangeDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPreviousWorkItemsRow
+	for rows.Next() {
+		var i GetPreviousWorkItemsRow
+		if err := rows.Scan(
+			&i.GhID,
+			&i.Name,
+			&i.Effort,
+			&i.IterationID,
+			&i.IterationGhID,
+			&i.ContentType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectBurnup = `-- name: GetProjectBurnup :many
+SELECT statuses.name as status
+     , project_day
+     , sum(rollup_effort(work_item_history))::decimal as qty
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
+  FROM work_item_status statuses
+       JOIN lateral (SELECT date_trunc('day', dd):: date as project_day
+                       FROM generate_series
+                               ( $1::timestamp 
+                               , (now() AT TIME ZONE coalesce($2::text, (SELECT time_zone FROM project WHERE project.id = $3::int)))
+                               , '1 day'::interval) dd) dates on true
+        LEFT JOIN work_item_history on work_item_history.change_date = dates.project_day and work_item_history.status = statuses.name
+                                   and ($4::text[] IS NULL OR work_item_history.content_type = ANY($4::text[]))
+                                   and ($5::text[] IS NULL OR work_item_has_label(work_item_history.id, $5::text[]))
+                                   and ($6::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $6::text[]))
+                                   and ($7::int[] IS NULL OR work_item_history.priority = ANY($7::int[]))
+                                   and work_item_history.project_id = $3::int
+ GROUP BY statuses.name, dates.project_day
+ORDER BY statuses.name, dates.project_day
+`
+
+type GetProjectBurnupParams struct {
+	StartDate     pgtype.Timestamp
+	TimeZone      pgtype.Text
+	ProjectID     int32
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetProjectBurnupRow struct {
+	Status     string
+	ProjectDay pgtype.Date
+	Qty        pgtype.Numeric
+	Inferred   bool
+}
+
+func (q *Queries) GetProjectBurnup(ctx context.Context, arg GetProjectBurnupParams) ([]GetProjectBurnupRow, error) {
+	rows, err := q.db.Query(ctx, getProjectBurnup,
+		arg.StartDate,
+		arg.TimeZone,
+		arg.ProjectID,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProjectBurnupRow
+	for rows.Next() {
+		var i GetProjectBurnupRow
+		if err := rows.Scan(
+			&i.Status,
+			&i.ProjectDay,
+			&i.Qty,
+			&i.Inferred,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectFirstChangeDate = `-- name: GetProjectFirstChangeDate :one
+SELECT min(change_date)::date
+FROM work_item_history
+JOIN project ON project.id = work_item_history.project_id
+WHERE project.gh_id = $1
+  AND NOT work_item_history.inferred
+`
+
+func (q *Queries) GetProjectFirstChangeDate(ctx context.Context, ghID string) (pgtype.Date, error) {
+	row := q.db.QueryRow(ctx, getProjectFirstChangeDate, ghID)
+	var column_1 pgtype.Date
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getProjectIntradayBurnup = `-- name: GetProjectIntradayBurnup :many
+WITH snapshots AS (
+  -- captured_at is UTC, snapshots are bucketed by their hour in the time zone
+  -- of the project and the last snapshot of each hour stands for the hour
+  SELECT DISTINCT ON (date_trunc('hour', local.captured_at)) work_item_intraday.captured_at
+       , date_trunc('hour', local.captured_at)::timestamp as project_hour
+    FROM work_item_intraday
+         JOIN lateral (SELECT work_item_intraday.captured_at AT TIME ZONE 'UTC'
+                                AT TIME ZONE coalesce($1::text, (SELECT time_zone FROM project WHERE project.id = $2::int)) as captured_at) local on true
+   WHERE work_item_intraday.project_id = $2::int
+     AND work_item_intraday.captured_at >= $3::timestamp
+   ORDER BY date_trunc('hour', local.captured_at), work_item_intraday.captured_at DESC
+)
+SELECT work_item_intraday.status::text as status
+     , snapshots.project_hour
+     , sum(work_item_intraday.effort)::decimal as qty
+  FROM snapshots
+       JOIN work_item_intraday on work_item_intraday.project_id = $2::int
+                              AND work_item_intraday.captured_at = snapshots.captured_at
+ WHERE work_item_intraday.status IS NOT NULL
+   AND ($4::text[] IS NULL OR work_item_intraday.content_type = ANY($4::text[]))
+   AND ($5::text[] IS NULL OR work_item_intraday.labels && $5::text[])
+   AND ($6::text[] IS NULL OR NOT (work_item_intraday.labels && $6::text[]))
+   AND ($7::int[] IS NULL OR work_item_intraday.priority = ANY($7::int[]))
+ GROUP BY work_item_intraday.status, snapshots.project_hour, snapshots.captured_at
+ORDER BY work_item_intraday.status, snapshots.project_hour
+`
+
+type GetProjectIntradayBurnupParams struct {
+	TimeZone      pgtype.Text
+	ProjectID     int32
+	StartDate     pgtype.Timestamp
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetProjectIntradayBurnupRow struct {
+	Status      string
+	ProjectHour pgtype.Timestamp
+	Qty         pgtype.Numeric
+}
+
+func (q *Queries) GetProjectIntradayBurnup(ctx context.Context, arg GetProjectIntradayBurnupParams) ([]GetProjectIntradayBurnupRow, error) {
+	rows, err := q.db.Query(ctx, getProjectIntradayBurnup,
+		arg.TimeZone,
+		arg.ProjectID,
+		arg.StartDate,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProjectIntradayBurnupRow
+	for rows.Next() {
+		var i GetProjectIntradayBurnupRow
+		if err := rows.Scan(&i.Status, &i.ProjectHour, &i.Qty); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectPriorityBreakdown = `-- name: GetProjectPriorityBreakdown :many
+SELECT work_item_history.change_date as project_day
+     , work_item_history.priority
+     , coalesce(sum(case when work_item_history.status <> 'Done' then rollup_effort(work_item_history) else 0 end), 0)::decimal as remaining
+     , coalesce(sum(case when work_item_history.status = 'Done' then rollup_effort(work_item_history) else 0 end), 0)::decimal as done
+     , coalesce(bool_or(work_item_history.inferred), false)::boolean as inferred
+  FROM work_item_history
+ WHERE work_item_history.project_id = $1::int
+   AND work_item_history.change_date >= $2::timestamp
+   AND ($3::text[] IS NULL OR work_item_history.content_type = ANY($3::text[]))
+   AND ($4::text[] IS NULL OR work_item_has_label(work_item_history.id, $4::text[]))
+   AND ($5::text[] IS NULL OR NOT work_item_has_label(work_item_history.id, $5::text[]))
+   AND ($6::int[] IS NULL OR work_item_history.priority = ANY($6::int[]))
+ GROUP BY work_item_history.change_date, work_item_history.priority
+ORDER BY work_item_history.change_date, work_item_history.priority NULLS LAST
+`
+
+type GetProjectPriorityBreakdownParams struct {
+	ProjectID     int32
+	StartDate     pgtype.Timestamp
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+}
+
+type GetProjectPriorityBreakdownRow struct {
+	ProjectDay pgtype.Date
+	Priority   pgtype.Int4
+	Remaining  pgtype.Numeric
+	Done       pgtype.Numeric
+	Inferred   bool
+}
+
+func (q *Queries) GetProjectPriorityBreakdown(ctx context.Context, arg GetProjectPriorityBreakdownParams) ([]GetProjectPriorityBreakdownRow, error) {
+	rows, err := q.db.Query(ctx, getProjectPriorityBreakdown,
+		arg.ProjectID,
+		arg.StartDate,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProjectPriorityBreakdownRow
+	for rows.Next() {
+		var i GetProjectPriorityBreakdownRow
+		if err := rows.Scan(
+			&i.ProjectDay,
+			&i.Priority,
+			&i.Remaining,
+			&i.Done,
+			&i.Inferred,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectScopeRemoved = `-- name: GetProjectScopeRemoved :many
+WITH removed AS (
+ SELECT work_item_event.event_date, work_item_event.effort
+   FROM work_item_event
+        -- labels are filtered by the last snapshot before the item was removed
+        LEFT JOIN lateral (SELECT work_item_history.id, work_item_history.priority
+                             FROM work_item_history
+                            WHERE work_item_history.gh_id = work_item_event.gh_id
+                              AND work_item_history.change_date < work_item_event.event_date
+                            ORDER BY work_item_history.change_date DESC
+                            LIMIT 1) last_snapshot on true
+  WHERE work_item_event.project_id = $1::int
+    AND work_item_event.event_type IN ('removed', 'archived')
+    AND work_item_event.event_date >= $2::timestamp
+    AND ($3::text[] IS NULL OR work_item_event.content_type = ANY($3::text[]))
+    AND ($4::text[] IS NULL OR work_item_has_label(last_snapshot.id, $4::text[]))
+    AND ($5::text[] IS NULL OR NOT work_item_has_label(last_snapshot.id, $5::text[]))
+    AND ($6::int[] IS NULL OR last_snapshot.priority = ANY($6::int[])))
+
+SELECT project_day
+     , coalesce(sum(removed.effort), 0)::decimal as qty
+  FROM (SELECT date_trunc('day', dd):: date as project_day
+          FROM generate_series
+                  ( $2::timestamp
+                  , (now() AT TIME ZONE coalesce($7::text, (SELECT time_zone FROM project WHERE project.id = $1::int)))
+                  , '1 day'::interval) dd) dates
+       LEFT JOIN removed on removed.event_date <= dates.project_day
+ GROUP BY dates.project_day
+ORDER BY dates.project_day
+`
+
+type GetProjectScopeRemovedParams struct {
+	ProjectID     int32
+	StartDate     pgtype.Timestamp
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+	TimeZone      pgtype.Text
+}
+
+type GetProjectScopeRemovedRow struct {
+	ProjectDay pgtype.Date
+	Qty        pgtype.Numeric
+}
+
+func (q *Queries) GetProjectScopeRemoved(ctx context.Context, arg GetProjectScopeRemovedParams) ([]GetProjectScopeRemovedRow, error) {
+	rows, err := q.db.Query(ctx, getProjectScopeRemoved,
+		arg.ProjectID,
+		arg.StartDate,
+		arg.Types,
+		arg.IncludeLabels,
+		arg.ExcludeLabels,
+		arg.Priorities,
+		arg.TimeZone,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProjectScopeRemovedRow
+	for rows.Next() {
+		var i GetProjectScopeRemovedRow
+		if err := rows.Scan(
+			&i.ProjectDay,
+			&i.Qty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectSyncRuns = `-- name: GetProjectSyncRuns :many
+SELECT id, sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error
+FROM sync_run
+WHERE project_id = $1
+ORDER BY started_at DESC, id DESC
+LIMIT $2
+`
+
+type GetProjectSyncRunsParams struct {
+	ProjectID pgtype.Int4
+	RowLimit  int32
+}
+
+func (q *Queries) GetProjectSyncRuns(ctx context.Context, arg GetProjectSyncRunsParams) ([]SyncRun, error) {
+	rows, err := q.db.Query(ctx, getProjectSyncRuns, arg.ProjectID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SyncRun
+	for rows.Next() {
+		var i SyncRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.SyncID,
+			&i.ProjectName,
+			&i.ProjectID,
+			&i.Trigger,
+			&i.Status,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ItemCount,
+			&i.GraphqlCalls,
+			&i.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectSyncState = `-- name: GetProjectSyncState :one
+SELECT project_name, items_updated_at, full_synced_at
+FROM project_sync_state
+WHERE project_name = $1
+`
+
+func (q *Queries) GetProjectSyncState(ctx context.Context, projectName string) (ProjectSyncState, error) {
+	row := q.db.QueryRow(ctx, getProjectSyncState, projectName)
+	var i ProjectSyncState
+	err := row.Scan(&i.ProjectName, &i.ItemsUpdatedAt, &i.FullSyncedAt)
+	return i, err
+}
+
+const getProjectTimeZone = `-- name: GetProjectTimeZone :one
+SELECT time_zone
+FROM project
+WHERE id = $1
+`
+
+func (q *Queries) GetProjectTimeZone(ctx context.Context, id int32) (string, error) {
+	row := q.db.QueryRow(ctx, getProjectTimeZone, id)
+	var time_zone string
+	err := row.Scan(&time_zone)
+	return time_zone, err
+}
+
+const getProjects = `-- name: GetProjects :many
+SELECT id, gh_id, name, time_zone, rollup
+FROM project
+`
+
+func (q *Queries) GetProjects(ctx context.Context) ([]Project, error) {
+	rows, err := q.db.Query(ctx, getProjects)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.GhID,
+			&i.Name,
+			&i.TimeZone,
+			&i.Rollup,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRegisteredProject = `-- name: GetRegisteredProject :one
+SELECT id, name, settings, encrypted_token, created_at, updated_at
+FROM registered_project
+WHERE id = $1
 `
 
-type GetIterationBurndownRow struct {
-	IterationDay pgtype.Date
-	Remaining    pgtype.Numeric
-	Ideal        pgtype.Numeric
+func (q *Queries) GetRegisteredProject(ctx context.Context, id int32) (RegisteredProject, error) {
+	row := q.db.QueryRow(ctx, getRegisteredProject, id)
+	var i RegisteredProject
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Settings,
+		&i.EncryptedToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) GetIterationBurndown(ctx context.Context, id int32) ([]GetIterationBurndownRow, error) {
-	rows, err := q.db.Query(ctx, getIterationBurndown, id)
+const getRegisteredProjects = `-- name: GetRegisteredProjects :many
+SELECT id, name, settings, encrypted_token, created_at, updated_at
+FROM registered_project
+ORDER BY id
+`
+
+func (q *Queries) GetRegisteredProjects(ctx context.Context) ([]RegisteredProject, error) {
+	rows, err := q.db.Query(ctx, getRegisteredProjects)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetIterationBurndownRow
+	var items []RegisteredProject
 	for rows.Next() {
-		var i GetIterationBurndownRow
-		if err := rows.Scan(&i.IterationDay, &i.Remaining, &i.Ideal); err != nil {
+		var i RegisteredProject
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Settings,
+			&i.EncryptedToken,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -67,26 +1235,34 @@ func (q *Queries) GetIterationBurndown(ctx context.Context, id int32) ([]GetIter
 	return items, nil
 }
 
-const getIterations = `-- name: GetIterations :many
-SELECT id, gh_id, name, start_date, end_date, project_id FROM iteration where project_id = $1
+const getSyncRuns = `-- name: GetSyncRuns :many
+SELECT id, sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error
+FROM sync_run
+ORDER BY started_at DESC, id DESC
+LIMIT $1
 `
 
-func (q *Queries) GetIterations(ctx context.Context, projectID int32) ([]Iteration, error) {
-	rows, err := q.db.Query(ctx, getIterations, projectID)
+func (q *Queries) GetSyncRuns(ctx context.Context, rowLimit int32) ([]SyncRun, error) {
+	rows, err := q.db.Query(ctx, getSyncRuns, rowLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Iteration
+	var items []SyncRun
 	for rows.Next() {
-		var i Iteration
+		var i SyncRun
 		if err := rows.Scan(
 			&i.ID,
-			&i.GhID,
-			&i.Name,
-			&i.StartDate,
-			&i.EndDate,
+			&i.SyncID,
+			&i.ProjectName,
 			&i.ProjectID,
+			&i.Trigger,
+			&i.Status,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ItemCount,
+			&i.GraphqlCalls,
+			&i.Error,
 		); err != nil {
 			return nil, err
 		}
@@ -98,44 +1274,62 @@ func (q *Queries) GetIterations(ctx context.Context, projectID int32) ([]Iterati
 	return items, nil
 }
 
-const getProjectBurnup = `-- name: GetProjectBurnup :many
-select statuses.name as status
-     , project_day
-     , sum(work_item_history.effort)::decimal as qty
-  from work_item_status statuses
-       join lateral (SELECT date_trunc('day', dd):: date as project_day
-                       FROM generate_series
-                               ( $2::timestamp 
-                               , now()::timestamp
-                               , '1 day'::interval) dd) dates on true
-        left join work_item_history on work_item_history.change_date = dates.project_day and work_item_history.status = statuses.name
-        left join iteration on work_item_history.iteration_id = iteration.id
- where (iteration.project_id = $1 or iteration.project_id is null)
- group by statuses.name, dates.project_day
-order by statuses.name, dates.project_day
+const getSyncedProjectIds = `-- name: GetSyncedProjectIds :many
+SELECT DISTINCT ON (project_name) project_name, project_id::int AS project_id
+FROM sync_run
+WHERE project_id IS NOT NULL
+  AND project_name = ANY($1::text[])
+ORDER BY project_name, started_at DESC
 `
 
-type GetProjectBurnupParams struct {
-	ProjectID int32
-	Column2   pgtype.Timestamp
+type GetSyncedProjectIdsRow struct {
+	ProjectName string
+	ProjectID   int32
 }
 
-type GetProjectBurnupRow struct {
-	Status     string
-	ProjectDay pgtype.Date
-	Qty        pgtype.Numeric
+func (q *Queries) GetSyncedProjectIds(ctx context.Context, projectNames []string) ([]GetSyncedProjectIdsRow, error) {
+	rows, err := q.db.Query(ctx, getSyncedProjectIds, projectNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSyncedProjectIdsRow
+	for rows.Next() {
+		var i GetSyncedProjectIdsRow
+		if err := rows.Scan(&i.ProjectName, &i.ProjectID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetProjectBurnup(ctx context.Context, arg GetProjectBurnupParams) ([]GetProjectBurnupRow, error) {
-	rows, err := q.db.Query(ctx, getProjectBurnup, arg.ProjectID, arg.Column2)
+const getTrackedProjects = `-- name: GetTrackedProjects :many
+SELECT DISTINCT ON (project.id) project.id, project.gh_id, sync_run.project_name
+FROM project
+JOIN sync_run ON sync_run.project_id = project.id
+ORDER BY project.id, sync_run.started_at DESC
+`
+
+type GetTrackedProjectsRow struct {
+	ID          int32
+	GhID        string
+	ProjectName string
+}
+
+func (q *Queries) GetTrackedProjects(ctx context.Context) ([]GetTrackedProjectsRow, error) {
+	rows, err := q.db.Query(ctx, getTrackedProjects)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetProjectBurnupRow
+	var items []GetTrackedProjectsRow
 	for rows.Next() {
-		var i GetProjectBurnupRow
-		if err := rows.Scan(&i.Status, &i.ProjectDay, &i.Qty); err != nil {
+		var i GetTrackedProjectsRow
+		if err := rows.Scan(&i.ID, &i.GhID, &i.ProjectName); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -146,20 +1340,47 @@ func (q *Queries) GetProjectBurnup(ctx context.Context, arg GetProjectBurnupPara
 	return items, nil
 }
 
-const getProjects = `-- name: GetProjects :many
-SELECT id, gh_id, name FROM project
+const getWorkItemTree = `-- name: GetWorkItemTree :many
+SELECT work_item_history.gh_id, work_item_history.name, status, effort, remaining_hours, parent_gh_id, inferred, rolled_up, project.rollup
+FROM work_item_history
+JOIN project ON project.id = work_item_history.project_id
+WHERE work_item_history.project_id = $1::int
+  AND change_date = (SELECT max(change_date) FROM work_item_history WHERE project_id = $1::int)
+ORDER BY work_item_history.name
 `
 
-func (q *Queries) GetProjects(ctx context.Context) ([]Project, error) {
-	rows, err := q.db.Query(ctx, getProjects)
+type GetWorkItemTreeRow struct {
+	GhID           string
+	Name           string
+	Status         pgtype.Text
+	Effort         pgtype.Int4
+	RemainingHours pgtype.Int4
+	ParentGhID     pgtype.Text
+	Inferred       bool
+	RolledUp       bool
+	Rollup         string
+}
+
+func (q *Queries) GetWorkItemTree(ctx context.Context, projectID int32) ([]GetWorkItemTreeRow, error) {
+	rows, err := q.db.Query(ctx, getWorkItemTree, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Project
+	var items []GetWorkItemTreeRow
 	for rows.Next() {
-		var i Project
-		if err := rows.Scan(&i.ID, &i.GhID, &i.Name); err != nil {
+		var i GetWorkItemTreeRow
+		if err := rows.Scan(
+			&i.GhID,
+			&i.Name,
+			&i.Status,
+			&i.Effort,
+			&i.RemainingHours,
+			&i.ParentGhID,
+			&i.Inferred,
+			&i.RolledUp,
+			&i.Rollup,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -232,6 +1453,225 @@ func (q *Queries) GetWorkItemsForIteration(ctx context.Context, name string) ([]
 	return items, nil
 }
 
+const insertRegisteredProject = `-- name: InsertRegisteredProject :one
+INSERT INTO registered_project (name, settings, encrypted_token)
+VALUES ($1, $2, $3)
+RETURNING id, name, settings, encrypted_token, created_at, updated_at
+`
+
+type InsertRegisteredProjectParams struct {
+	Name           string
+	Settings       []byte
+	EncryptedToken []byte
+}
+
+func (q *Queries) InsertRegisteredProject(ctx context.Context, arg InsertRegisteredProjectParams) (RegisteredProject, error) {
+	row := q.db.QueryRow(ctx, insertRegisteredProject, arg.Name, arg.Settings, arg.EncryptedToken)
+	var i RegisteredProject
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Settings,
+		&i.EncryptedToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertSyncRun = `-- name: InsertSyncRun :one
+INSERT INTO sync_run (sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, sync_id, project_name, project_id, trigger, status, started_at, finished_at, item_count, graphql_calls, error
+`
+
+type InsertSyncRunParams struct {
+	SyncID       pgtype.Text
+	ProjectName  string
+	ProjectID    pgtype.Int4
+	Trigger      string
+	Status       string
+	StartedAt    pgtype.Timestamp
+	FinishedAt   pgtype.Timestamp
+	ItemCount    int32
+	GraphqlCalls int32
+	Error        pgtype.Text
+}
+
+func (q *Queries) InsertSyncRun(ctx context.Context, arg InsertSyncRunParams) (SyncRun, error) {
+	row := q.db.QueryRow(ctx, insertSyncRun,
+		arg.SyncID,
+		arg.ProjectName,
+		arg.ProjectID,
+		arg.Trigger,
+		arg.Status,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.ItemCount,
+		arg.GraphqlCalls,
+		arg.Error,
+	)
+	var i SyncRun
+	err := row.Scan(
+		&i.ID,
+		&i.SyncID,
+		&i.ProjectName,
+		&i.ProjectID,
+		&i.Trigger,
+		&i.Status,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ItemCount,
+		&i.GraphqlCalls,
+		&i.Error,
+	)
+	return i, err
+}
+
+const insertWorkItemAssignee = `-- name: InsertWorkItemAssignee :exec
+INSERT INTO work_item_assignee (work_item_history_id, assignee_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertWorkItemAssigneeParams struct {
+	WorkItemHistoryID int32
+	AssigneeID        int32
+}
+
+func (q *Queries) InsertWorkItemAssignee(ctx context.Context, arg InsertWorkItemAssigneeParams) error {
+	_, err := q.db.Exec(ctx, insertWorkItemAssignee, arg.WorkItemHistoryID, arg.AssigneeID)
+	return err
+}
+
+const insertWorkItemEvent = `-- name: InsertWorkItemEvent :exec
+INSERT INTO work_item_event (gh_id, name, event_type, event_date, effort, content_type, iteration_id, project_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT(gh_id, event_type, event_date) DO NOTHING
+`
+
+type InsertWorkItemEventParams struct {
+	GhID        string
+	Name        string
+	EventType   string
+	EventDate   pgtype.Date
+	Effort      pgtype.Int4
+	ContentType string
+	IterationID pgtype.Int4
+	ProjectID   int32
+}
+
+func (q *Queries) InsertWorkItemEvent(ctx context.Context, arg InsertWorkItemEventParams) error {
+	_, err := q.db.Exec(ctx, insertWorkItemEvent,
+		arg.GhID,
+		arg.Name,
+		arg.EventType,
+		arg.EventDate,
+		arg.Effort,
+		arg.ContentType,
+		arg.IterationID,
+		arg.ProjectID,
+	)
+	return err
+}
+
+const insertWorkItemLabel = `-- name: InsertWorkItemLabel :exec
+INSERT INTO work_item_label (work_item_history_id, label_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertWorkItemLabelParams struct {
+	WorkItemHistoryID int32
+	LabelID           int32
+}
+
+func (q *Queries) InsertWorkItemLabel(ctx context.Context, arg InsertWorkItemLabelParams) error {
+	_, err := q.db.Exec(ctx, insertWorkItemLabel, arg.WorkItemHistoryID, arg.LabelID)
+	return err
+}
+
+const saveIntradaySnapshot = `-- name: SaveIntradaySnapshot :exec
+INSERT INTO work_item_intraday (captured_at, gh_id, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, labels)
+-- intraday snapshots do not keep the hierarchy so they hold the counted values
+SELECT $1, work_item_history.gh_id, work_item_history.status, work_item_history.priority, rollup_remaining_hours(work_item_history), rollup_effort(work_item_history), work_item_history.iteration_id, work_item_history.project_id, work_item_history.content_type
+     , coalesce((SELECT array_agg(label.name ORDER BY label.name) FROM work_item_label JOIN label ON label.id = work_item_label.label_id WHERE work_item_label.work_item_history_id = work_item_history.id), '{}')
+  FROM work_item_history
+ WHERE work_item_history.project_id = $2
+   AND work_item_history.change_date = $3
+   AND work_item_history.gh_id = ANY($4::text[])
+ON CONFLICT(captured_at, gh_id) DO NOTHING
+`
+
+type SaveIntradaySnapshotParams struct {
+	CapturedAt pgtype.Timestamp
+	ProjectID  int32
+	ChangeDate pgtype.Date
+	GhIds      []string
+}
+
+func (q *Queries) SaveIntradaySnapshot(ctx context.Context, arg SaveIntradaySnapshotParams) error {
+	_, err := q.db.Exec(ctx, saveIntradaySnapshot,
+		arg.CapturedAt,
+		arg.ProjectID,
+		arg.ChangeDate,
+		arg.GhIds,
+	)
+	return err
+}
+
+const updateRegisteredProject = `-- name: UpdateRegisteredProject :one
+UPDATE registered_project
+SET name = $2,
+    settings = $3,
+    encrypted_token = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, settings, encrypted_token, created_at, updated_at
+`
+
+type UpdateRegisteredProjectParams struct {
+	ID             int32
+	Name           string
+	Settings       []byte
+	EncryptedToken []byte
+}
+
+func (q *Queries) UpdateRegisteredProject(ctx context.Context, arg UpdateRegisteredProjectParams) (RegisteredProject, error) {
+	row := q.db.QueryRow(ctx, updateRegisteredProject,
+		arg.ID,
+		arg.Name,
+		arg.Settings,
+		arg.EncryptedToken,
+	)
+	var i RegisteredProject
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Settings,
+		&i.EncryptedToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertAssignee = `-- name: UpsertAssignee :one
+INSERT INTO assignee (login)
+VALUES ($1)
+ON CONFLICT(login) 
+DO UPDATE SET
+  "login" = EXCLUDED.login
+RETURNING id, login
+`
+
+func (q *Queries) UpsertAssignee(ctx context.Context, login string) (Assignee, error) {
+	row := q.db.QueryRow(ctx, upsertAssignee, login)
+	var i Assignee
+	err := row.Scan(&i.ID, &i.Login)
+	return i, err
+}
+
 const upsertIteration = `-- name: UpsertIteration :one
 INSERT INTO iteration (gh_id, name, start_date, end_date, project_id)
 VALUES ($1, $2, $3, $4, $5)
@@ -271,30 +1711,125 @@ func (q *Queries) UpsertIteration(ctx context.Context, arg UpsertIterationParams
 	return i, err
 }
 
+const upsertLabel = `-- name: UpsertLabel :one
+INSERT INTO label (name)
+VALUES ($1)
+ON CONFLICT(name) 
+DO UPDATE SET
+  "name" = EXCLUDED.name
+RETURNING id, name
+`
+
+func (q *Queries) UpsertLabel(ctx context.Context, name string) (Label, error) {
+	row := q.db.QueryRow(ctx, upsertLabel, name)
+	var i Label
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const upsertMilestone = `-- name: UpsertMilestone :one
+INSERT INTO milestone (gh_id, name, state, start_date, due_date, project_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT(gh_id, project_id)
+DO UPDATE SET
+  "name" = EXCLUDED.name,
+  "state" = EXCLUDED.state,
+  start_date = EXCLUDED.start_date,
+  due_date = EXCLUDED.due_date
+RETURNING id, gh_id, name, state, start_date, due_date, project_id
+`
+
+type UpsertMilestoneParams struct {
+	GhID      string
+	Name      string
+	State     string
+	StartDate pgtype.Date
+	DueDate   pgtype.Date
+	ProjectID int32
+}
+
+func (q *Queries) UpsertMilestone(ctx context.Context, arg UpsertMilestoneParams) (Milestone, error) {
+	row := q.db.QueryRow(ctx, upsertMilestone,
+		arg.GhID,
+		arg.Name,
+		arg.State,
+		arg.StartDate,
+		arg.DueDate,
+		arg.ProjectID,
+	)
+	var i Milestone
+	err := row.Scan(
+		&i.ID,
+		&i.GhID,
+		&i.Name,
+		&i.State,
+		&i.StartDate,
+		&i.DueDate,
+		&i.ProjectID,
+	)
+	return i, err
+}
+
 const upsertProject = `-- name: UpsertProject :one
-INSERT INTO project (gh_id, name)
-VALUES ($1, $2)
+INSERT INTO project (gh_id, name, time_zone, rollup)
+VALUES ($1, $2, coalesce($3::text, 'UTC'), coalesce($4::text, ''))
 ON CONFLICT(gh_id) 
 DO UPDATE SET
-  "name" = EXCLUDED.name
-RETURNING id, gh_id, name
+  "name" = EXCLUDED.name,
+  -- webhook project events do not know the time zone and rollup of the project
+  time_zone = coalesce($3::text, project.time_zone),
+  rollup = coalesce($4::text, project.rollup)
+RETURNING id, gh_id, name, time_zone, rollup
 `
 
 type UpsertProjectParams struct {
-	GhID string
-	Name string
+	GhID     string
+	Name     string
+	TimeZone pgtype.Text
+	Rollup   pgtype.Text
 }
 
 func (q *Queries) UpsertProject(ctx context.Context, arg UpsertProjectParams) (Project, error) {
-	row := q.db.QueryRow(ctx, upsertProject, arg.GhID, arg.Name)
+	row := q.db.QueryRow(ctx, upsertProject,
+		arg.GhID,
+		arg.Name,
+		arg.TimeZone,
+		arg.Rollup,
+	)
 	var i Project
-	err := row.Scan(&i.ID, &i.GhID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.GhID,
+		&i.Name,
+		&i.TimeZone,
+		&i.Rollup,
+	)
 	return i, err
 }
 
+const upsertProjectSyncState = `-- name: UpsertProjectSyncState :exec
+INSERT INTO project_sync_state (project_name, items_updated_at, full_synced_at)
+VALUES ($1, $2, $3)
+ON CONFLICT(project_name)
+DO UPDATE SET
+  items_updated_at = EXCLUDED.items_updated_at,
+  full_synced_at = EXCLUDED.full_synced_at
+`
+
+type UpsertProjectSyncStateParams struct {
+	ProjectName    string
+	ItemsUpdatedAt pgtype.Timestamp
+	FullSyncedAt   pgtype.Timestamp
+}
+
+func (q *Queries) UpsertProjectSyncState(ctx context.Context, arg UpsertProjectSyncStateParams) error {
+	_, err := q.db.Exec(ctx, upsertProjectSyncState, arg.ProjectName, arg.ItemsUpdatedAt, arg.FullSyncedAt)
+	return err
+}
+
 const upsertWorkItem = `-- name: UpsertWorkItem :one
-INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO work_item_history (change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 ON CONFLICT(change_date, gh_id) 
 DO UPDATE SET
   "name" = EXCLUDED.name,
@@ -303,8 +1838,16 @@ DO UPDATE SET
   remaining_hours = EXCLUDED.remaining_hours,
   effort = EXCLUDED.effort,
   iteration_id = EXCLUDED.iteration_id,
-  project_id = EXCLUDED.project_id
-RETURNING id, change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id
+  project_id = EXCLUDED.project_id,
+  content_type = EXCLUDED.content_type,
+  inferred = EXCLUDED.inferred,
+  milestone_id = EXCLUDED.milestone_id,
+  parent_gh_id = EXCLUDED.parent_gh_id,
+  root_gh_id = EXCLUDED.root_gh_id,
+  rolled_up = EXCLUDED.rolled_up
+-- inferred snapshots never replace snapshots pulled from GitHub
+WHERE work_item_history.inferred OR NOT EXCLUDED.inferred
+RETURNING id, change_date, gh_id, name, status, priority, remaining_hours, effort, iteration_id, project_id, content_type, inferred, milestone_id, parent_gh_id, root_gh_id, rolled_up
 `
 
 type UpsertWorkItemParams struct {
@@ -317,6 +1860,11 @@ type UpsertWorkItemParams struct {
 	Effort         pgtype.Int4
 	IterationID    pgtype.Int4
 	ProjectID      int32
+	ContentType    string
+	Inferred       bool
+	MilestoneID    pgtype.Int4
+	ParentGhID     pgtype.Text
+	RootGhID       pgtype.Text
 }
 
 func (q *Queries) UpsertWorkItem(ctx context.Context, arg UpsertWorkItemParams) (WorkItemHistory, error) {
@@ -330,6 +1878,11 @@ func (q *Queries) UpsertWorkItem(ctx context.Context, arg UpsertWorkItemParams)
 		arg.Effort,
 		arg.IterationID,
 		arg.ProjectID,
+		arg.ContentType,
+		arg.Inferred,
+		arg.MilestoneID,
+		arg.ParentGhID,
+		arg.RootGhID,
 	)
 	var i WorkItemHistory
 	err := row.Scan(
@@ -343,10 +1896,46 @@ func (q *Queries) UpsertWorkItem(ctx context.Context, arg UpsertWorkItemParams)
 		&i.Effort,
 		&i.IterationID,
 		&i.ProjectID,
+		&i.ContentType,
+		&i.Inferred,
+		&i.MilestoneID,
+		&i.ParentGhID,
+		&i.RootGhID,
+		&i.RolledUp,
 	)
 	return i, err
 }
 
+const upsertWorkItemDates = `-- name: UpsertWorkItemDates :exec
+INSERT INTO work_item (gh_id, name, created_at, closed_at, project_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT(gh_id) 
+DO UPDATE SET
+  "name" = EXCLUDED.name,
+  created_at = EXCLUDED.created_at,
+  closed_at = EXCLUDED.closed_at,
+  project_id = EXCLUDED.project_id
+`
+
+type UpsertWorkItemDatesParams struct {
+	GhID      string
+	Name      string
+	CreatedAt pgtype.Timestamp
+	ClosedAt  pgtype.Timestamp
+	ProjectID int32
+}
+
+func (q *Queries) UpsertWorkItemDates(ctx context.Context, arg UpsertWorkItemDatesParams) error {
+	_, err := q.db.Exec(ctx, upsertWorkItemDates,
+		arg.GhID,
+		arg.Name,
+		arg.CreatedAt,
+		arg.ClosedAt,
+		arg.ProjectID,
+	)
+	return err
+}
+
 const upsertWorkItemStatus = `-- name: UpsertWorkItemStatus :one
 INSERT INTO work_item_status (name)
 VALUES ($1)
@@ -362,3 +1951,22 @@ func (q *Queries) UpsertWorkItemStatus(ctx context.Context, name string) (WorkIt
 	err := row.Scan(&i.ID, &i.Name)
 	return i, err
 }
+
+const upsertWorkItemStatusChange = `-- name: UpsertWorkItemStatusChange :exec
+INSERT INTO work_item_status_change (gh_id, status, entered_date)
+VALUES ($1, $2, $3)
+ON CONFLICT(gh_id, status) 
+DO UPDATE SET
+  entered_date = LEAST(work_item_status_change.entered_date, EXCLUDED.entered_date)
+`
+
+type UpsertWorkItemStatusChangeParams struct {
+	GhID        string
+	Status      string
+	EnteredDate pgtype.Date
+}
+
+func (q *Queries) UpsertWorkItemStatusChange(ctx context.Context, arg UpsertWorkItemStatusChangeParams) error {
+	_, err := q.db.Exec(ctx, upsertWorkItemStatusChange, arg.GhID, arg.Status, arg.EnteredDate)
+	return err
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TxQuerier is a Querier that can also run a group of queries atomically.
+type TxQuerier interface {
+	Querier
+	// ExecTx runs fn in a transaction that is committed when fn succeeds and
+	// rolled back when it fails.
+	ExecTx(ctx context.Context, fn func(Querier) error) error
+}
+
+// Pool is a connection that transactions can be started from, e.g. a
+// pgxpool.Pool.
+type Pool interface {
+	DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Store runs queries on a pool and implements TxQuerier.
+type Store struct {
+	*Queries
+	pool Pool
+}
+
+func NewStore(pool Pool) *Store {
+	return &Store{
+		Queries: New(pool),
+		pool:    pool,
+	}
+}
+
+// ExecTx implements TxQuerier.
+func (s *Store) ExecTx(ctx context.Context, fn func(Querier) error) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(s.WithTx(tx)); err != nil {
+		// the context may be done already, rolling back must not depend on it
+		if rollbackErr := tx.Rollback(context.WithoutCancel(ctx)); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
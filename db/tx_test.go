@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTx struct {
+	pgx.Tx
+	committed   bool
+	rolledBack  bool
+	rollbackErr error
+}
+
+func (m *mockTx) Commit(ctx context.Context) error {
+	m.committed = true
+	return nil
+}
+
+func (m *mockTx) Rollback(ctx context.Context) error {
+	m.rolledBack = true
+	return m.rollbackErr
+}
+
+func (m *mockTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+type mockPool struct {
+	DBTX
+	tx       *mockTx
+	beginErr error
+}
+
+func (m *mockPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	if m.beginErr != nil {
+		return nil, m.beginErr
+	}
+
+	return m.tx, nil
+}
+
+func TestExecTxCommits(t *testing.T) {
+	pool := &mockPool{tx: &mockTx{}}
+	store := NewStore(pool)
+
+	err := store.ExecTx(context.Background(), func(queries Querier) error {
+		return queries.DeleteWorkItemLabels(context.Background(), 1)
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, pool.tx.committed)
+	assert.False(t, pool.tx.rolledBack)
+}
+
+func TestExecTxRollsBackOnError(t *testing.T) {
+	pool := &mockPool{tx: &mockTx{}}
+	store := NewStore(pool)
+
+	err := store.ExecTx(context.Background(), func(queries Querier) error {
+		return fmt.Errorf("error")
+	})
+
+	assert.EqualError(t, err, "error")
+	assert.False(t, pool.tx.committed)
+	assert.True(t, pool.tx.rolledBack)
+}
+
+func TestExecTxRollbackError(t *testing.T) {
+	pool := &mockPool{tx: &mockTx{rollbackErr: fmt.Errorf("connection lost")}}
+	store := NewStore(pool)
+
+	err := store.ExecTx(context.Background(), func(queries Querier) error {
+		return fmt.Errorf("error")
+	})
+
+	assert.EqualError(t, err, "error\nconnection lost")
+}
+
+func TestExecTxBeginError(t *testing.T) {
+	store := NewStore(&mockPool{beginErr: fmt.Errorf("unavailable")})
+	called := false
+
+	err := store.ExecTx(context.Background(), func(queries Querier) error {
+		called = true
+		return nil
+	})
+
+	assert.EqualError(t, err, "unavailable")
+	assert.False(t, called)
+}
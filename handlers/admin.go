@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// RequireAdmin only lets requests authenticated with the admin token
+// through, e.g. Authorization: Bearer <token>.
+func (h Handlers) RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if h.AdminToken == "" || !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.AdminToken)) != 1 {
+			h.JSON(w, http.StatusUnauthorized, &models.ErrorResult{Errors: []string{"invalid admin token"}})
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (h Handlers) GetRegisteredProjects(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.Registry.GetRegisteredProjects(r.Context())
+
+	if err != nil {
+		slog.Error("Error getting registered projects", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	h.JSON(w, http.StatusOK, projects)
+}
+
+// RegisterProject saves a project to be pulled from the next run on. The
+// project is read from GitHub before it is accepted.
+func (h Handlers) RegisterProject(w http.ResponseWriter, r *http.Request) {
+	registration, ok := h.readRegistration(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.Registry.RegisterProject(r.Context(), registration)
+	if err != nil {
+		h.registrationError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/api/admin/projects/%d", result.Id))
+	h.JSON(w, http.StatusCreated, result)
+}
+
+// UpdateRegisteredProject replaces a registered project. The stored token is
+// kept when the body has no credentials.
+func (h Handlers) UpdateRegisteredProject(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("registrationId"))
+
+	if err != nil {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"registrationId should be a number"}})
+		return
+	}
+
+	registration, ok := h.readRegistration(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.Registry.UpdateRegisteredProject(r.Context(), int32(id), registration)
+	if err != nil {
+		h.registrationError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+// DeleteRegisteredProject stops pulling a registered project. Its history is
+// kept.
+func (h Handlers) DeleteRegisteredProject(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("registrationId"))
+
+	if err != nil {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"registrationId should be a number"}})
+		return
+	}
+
+	if err := h.Registry.DeleteRegisteredProject(r.Context(), int32(id)); err != nil {
+		h.registrationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h Handlers) readRegistration(w http.ResponseWriter, r *http.Request) (*models.ProjectRegistration, bool) {
+	registration := &models.ProjectRegistration{}
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(registration); err != nil {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"invalid payload"}})
+		return nil, false
+	}
+
+	return registration, true
+}
+
+func (h Handlers) registrationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, models.ErrRegistrationRejected):
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{err.Error()}})
+	case errors.Is(err, models.ErrProjectAlreadyRegistered):
+		h.JSON(w, http.StatusConflict, &models.ErrorResult{Errors: []string{err.Error()}})
+	case errors.Is(err, models.ErrProjectNotFound):
+		h.JSON(w, http.StatusNotFound, &models.ErrorResult{Errors: []string{err.Error()}})
+	default:
+		slog.Error("Error saving project registration", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+	}
+}
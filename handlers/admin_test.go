@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRegistry struct {
+	Registrations []models.ProjectRegistration
+	UpdateId      int32
+	Registration  *models.ProjectRegistration
+	DeleteId      int32
+	Error         error
+}
+
+func (m *mockRegistry) GetRegisteredProjects(ctx context.Context) ([]models.ProjectRegistration, error) {
+	return m.Registrations, m.Error
+}
+
+func (m *mockRegistry) RegisterProject(ctx context.Context, registration *models.ProjectRegistration) (*models.ProjectRegistration, error) {
+	m.Registration = registration
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	result := *registration
+	result.Id = 4
+	result.Token = ""
+	result.HasToken = true
+
+	return &result, nil
+}
+
+func (m *mockRegistry) UpdateRegisteredProject(ctx context.Context, id int32, registration *models.ProjectRegistration) (*models.ProjectRegistration, error) {
+	m.UpdateId = id
+	m.Registration = registration
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	result := *registration
+	result.Id = id
+
+	return &result, nil
+}
+
+func (m *mockRegistry) DeleteRegisteredProject(ctx context.Context, id int32) error {
+	m.DeleteId = id
+	return m.Error
+}
+
+func getAdminRouter(registry *mockRegistry) *http.ServeMux {
+	handlers := &Handlers{Registry: registry, AdminToken: "admin-secret"}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/admin/projects", handlers.RequireAdmin(handlers.GetRegisteredProjects))
+	router.HandleFunc("POST /api/admin/projects", handlers.RequireAdmin(handlers.RegisterProject))
+	router.HandleFunc("PUT /api/admin/projects/{registrationId}", handlers.RequireAdmin(handlers.UpdateRegisteredProject))
+	router.HandleFunc("DELETE /api/admin/projects/{registrationId}", handlers.RequireAdmin(handlers.DeleteRegisteredProject))
+
+	return router
+}
+
+func makeAdminRequest(router *http.ServeMux, method string, url string, token string, body any) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req, _ := http.NewRequest(method, url, bytes.NewReader(payload))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestRegisterProject(t *testing.T) {
+	registry := &mockRegistry{}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "POST", "/api/admin/projects", "admin-secret", map[string]any{
+		"orgName":     "org",
+		"project":     "5",
+		"token":       "secret-token",
+		"statusField": "Stage",
+	})
+
+	body := &models.ProjectRegistration{}
+	err := json.Unmarshal(rr.Body.Bytes(), body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "/api/admin/projects/4", rr.Header().Get("Location"))
+	assert.Equal(t, "org", registry.Registration.OrgName)
+	assert.Equal(t, "secret-token", registry.Registration.Token)
+	assert.Equal(t, "Stage", registry.Registration.StatusField)
+	assert.Equal(t, int32(4), body.Id)
+	assert.NotContains(t, rr.Body.String(), "secret-token")
+}
+
+func TestRegisterProjectUnauthorized(t *testing.T) {
+	registry := &mockRegistry{}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "POST", "/api/admin/projects", "wrong", map[string]any{"orgName": "org"})
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Nil(t, registry.Registration)
+}
+
+func TestRegisterProjectUnknownField(t *testing.T) {
+	rr := makeAdminRequest(getAdminRouter(&mockRegistry{}), "POST", "/api/admin/projects", "admin-secret", map[string]any{"org": "org"})
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.JSONEq(t, `{"errors":["invalid payload"]}`, rr.Body.String())
+}
+
+func TestRegisterProjectRejected(t *testing.T) {
+	registry := &mockRegistry{Error: fmt.Errorf("%w: project could not be read from GitHub: Bad credentials", models.ErrRegistrationRejected)}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "POST", "/api/admin/projects", "admin-secret", map[string]any{"orgName": "org"})
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.JSONEq(t, `{"errors":["project registration rejected: project could not be read from GitHub: Bad credentials"]}`, rr.Body.String())
+}
+
+func TestRegisterProjectConflict(t *testing.T) {
+	registry := &mockRegistry{Error: models.ErrProjectAlreadyRegistered}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "POST", "/api/admin/projects", "admin-secret", map[string]any{"orgName": "org"})
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestGetRegisteredProjects(t *testing.T) {
+	registry := &mockRegistry{Registrations: []models.ProjectRegistration{{Id: 1, Name: "org/5", HasToken: true}}}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "GET", "/api/admin/projects", "admin-secret", nil)
+
+	body := []models.ProjectRegistration{}
+	err := json.Unmarshal(rr.Body.Bytes(), &body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Len(t, body, 1)
+	assert.Equal(t, "org/5", body[0].Name)
+}
+
+func TestUpdateRegisteredProject(t *testing.T) {
+	registry := &mockRegistry{}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "PUT", "/api/admin/projects/7", "admin-secret", map[string]any{"orgName": "org", "project": "5"})
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, int32(7), registry.UpdateId)
+	assert.Equal(t, "5", registry.Registration.Project)
+}
+
+func TestUpdateRegisteredProjectNotFound(t *testing.T) {
+	registry := &mockRegistry{Error: models.ErrProjectNotFound}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "PUT", "/api/admin/projects/7", "admin-secret", map[string]any{"orgName": "org"})
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestUpdateRegisteredProjectInvalidId(t *testing.T) {
+	rr := makeAdminRequest(getAdminRouter(&mockRegistry{}), "PUT", "/api/admin/projects/abc", "admin-secret", map[string]any{})
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.JSONEq(t, `{"errors":["registrationId should be a number"]}`, rr.Body.String())
+}
+
+func TestDeleteRegisteredProject(t *testing.T) {
+	registry := &mockRegistry{}
+
+	rr := makeAdminRequest(getAdminRouter(registry), "DELETE", "/api/admin/projects/7", "admin-secret", nil)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Equal(t, int32(7), registry.DeleteId)
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+const day = 24 * time.Hour
+
+type cycleTimeQuery struct {
+	From          time.Time
+	To            time.Time
+	Window        int
+	StartStatuses []string
+}
+
+func (h Handlers) GetCycleTime(w http.ResponseWriter, r *http.Request) {
+	projectId := r.PathValue("projectId")
+	projectIdInt, _ := strconv.Atoi(projectId)
+
+	// the default range ends today in the time zone of the project
+	filters := &chartFilters{}
+	if err := h.resolveTimeZone(r.Context(), filters, int32(projectIdInt)); err != nil {
+		slog.Error("Error getting project time zone", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	params, errors := getCycleTimeQuery(r, filters.today())
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	// fetch a full window before the requested range so the first
+	// percentiles are not computed over a partial window
+	items, err := h.Queries.GetCycleTimeItems(r.Context(), db.GetCycleTimeItemsParams{
+		ProjectID:     int32(projectIdInt),
+		StartStatuses: params.StartStatuses,
+		StartDate:     pgtype.Timestamp{Time: params.From.AddDate(0, 0, -params.Window), Valid: true},
+		EndDate:       pgtype.Timestamp{Time: params.To.Add(day), Valid: true},
+	})
+
+	if err != nil {
+		slog.Error("Error getting cycle time data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	allItems := []models.CycleTimeItem{}
+	for _, item := range items {
+		allItems = append(allItems, toCycleTimeItem(item))
+	}
+
+	result := &models.CycleTimeResult{
+		Items:       []models.CycleTimeItem{},
+		Percentiles: getRollingPercentiles(allItems, params.From, params.To, params.Window),
+	}
+
+	for _, item := range allItems {
+		if !item.ClosedAt.Before(params.From) {
+			result.Items = append(result.Items, item)
+		}
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+func getCycleTimeQuery(r *http.Request, today time.Time) (*cycleTimeQuery, []string) {
+	query := r.URL.Query()
+	errors := []string{}
+	result := &cycleTimeQuery{
+		From:          today.AddDate(0, -1, 0),
+		To:            today,
+		Window:        30,
+		StartStatuses: []string{"In Progress"},
+	}
+
+	if value := query.Get("from"); value != "" {
+		from, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			errors = append(errors, "from should be a date in the format YYYY-MM-DD")
+		}
+		result.From = from
+	}
+
+	if value := query.Get("to"); value != "" {
+		to, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			errors = append(errors, "to should be a date in the format YYYY-MM-DD")
+		}
+		result.To = to
+	}
+
+	if value := query.Get("window"); value != "" {
+		window, err := strconv.Atoi(value)
+		if err != nil || window < 1 {
+			errors = append(errors, "window should be greater than 0")
+		}
+		result.Window = window
+	}
+
+	if statuses := getListQuery(query.Get("start_status")); len(statuses) > 0 {
+		result.StartStatuses = statuses
+	}
+
+	if len(errors) == 0 && result.To.Before(result.From) {
+		errors = append(errors, "to should be greater than or equal to from")
+	}
+
+	return result, errors
+}
+
+func toCycleTimeItem(item db.GetCycleTimeItemsRow) models.CycleTimeItem {
+	result := models.CycleTimeItem{
+		Id:        item.GhID,
+		Title:     item.Name,
+		CreatedAt: item.CreatedAt.Time,
+		ClosedAt:  item.ClosedAt.Time,
+	}
+
+	if item.CreatedAt.Valid {
+		result.LeadTime = toDays(item.ClosedAt.Time.Sub(item.CreatedAt.Time))
+	}
+
+	if item.CycleStartDate.Valid {
+		cycleTime := toDays(item.ClosedAt.Time.Sub(item.CycleStartDate.Time))
+		result.CycleTime = &cycleTime
+	}
+
+	return result
+}
+
+// getRollingPercentiles calculates, for each day in the range, the cycle time
+// percentiles of the items closed within the preceding window of days.
+func getRollingPercentiles(items []models.CycleTimeItem, from time.Time, to time.Time, window int) []models.CycleTimePercentile {
+	result := []models.CycleTimePercentile{}
+
+	for current := from; !current.After(to); current = current.Add(day) {
+		windowEnd := current.Add(day)
+		windowStart := windowEnd.AddDate(0, 0, -window)
+		values := []float64{}
+
+		for _, item := range items {
+			if item.CycleTime == nil || item.ClosedAt.Before(windowStart) || !item.ClosedAt.Before(windowEnd) {
+				continue
+			}
+
+			values = append(values, *item.CycleTime)
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		slices.Sort(values)
+		result = append(result, models.CycleTimePercentile{
+			Day: current,
+			P50: percentile(values, 50),
+			P85: percentile(values, 85),
+			P95: percentile(values, 95),
+		})
+	}
+
+	return result
+}
+
+// percentile uses linear interpolation between the closest ranks, matching
+// PostgreSQL's percentile_cont.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+func toDays(duration time.Duration) float64 {
+	return math.Round(duration.Hours()/24*100) / 100
+}
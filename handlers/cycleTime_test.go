@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getDate(value string) time.Time {
+	result, _ := time.Parse("2006-01-02", value)
+	return result
+}
+
+func TestGetCycleTime(t *testing.T) {
+	querier := &MockQuerier{GetCycleTimeItemsResult: []db.GetCycleTimeItemsRow{
+		{
+			GhID:           "1",
+			Name:           "Before range",
+			CreatedAt:      pgtype.Timestamp{Time: getDate("2024-01-01"), Valid: true},
+			ClosedAt:       pgtype.Timestamp{Time: getDate("2024-01-08"), Valid: true},
+			CycleStartDate: pgtype.Date{Time: getDate("2024-01-06"), Valid: true},
+		},
+		{
+			GhID:           "2",
+			Name:           "In range",
+			CreatedAt:      pgtype.Timestamp{Time: getDate("2024-01-02"), Valid: true},
+			ClosedAt:       pgtype.Timestamp{Time: getDate("2024-01-10").Add(12 * time.Hour), Valid: true},
+			CycleStartDate: pgtype.Date{Time: getDate("2024-01-06"), Valid: true},
+		},
+		{
+			GhID:      "3",
+			Name:      "Never started",
+			CreatedAt: pgtype.Timestamp{Time: getDate("2024-01-09"), Valid: true},
+			ClosedAt:  pgtype.Timestamp{Time: getDate("2024-01-11"), Valid: true},
+		},
+	}}
+	handlers := new(Handlers)
+	handlers.Queries = querier
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+
+	code, body, _, err := makeRequest[models.CycleTimeResult](router, "GET", "/api/projects/1/cycle-time?from=2024-01-10&to=2024-01-11&window=7&start_status=Doing,Review", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(1), querier.GetCycleTimeItemsParams.ProjectID)
+	assert.Equal(t, []string{"Doing", "Review"}, querier.GetCycleTimeItemsParams.StartStatuses)
+	assert.Equal(t, "2024-01-03", querier.GetCycleTimeItemsParams.StartDate.Time.Format("2006-01-02"))
+	assert.Equal(t, "2024-01-12", querier.GetCycleTimeItemsParams.EndDate.Time.Format("2006-01-02"))
+	assert.Len(t, body.Items, 2)
+	assert.Equal(t, "2", body.Items[0].Id)
+	assert.Equal(t, 8.5, body.Items[0].LeadTime)
+	assert.Equal(t, 4.5, *body.Items[0].CycleTime)
+	assert.Equal(t, "3", body.Items[1].Id)
+	assert.Equal(t, 2.0, body.Items[1].LeadTime)
+	assert.Nil(t, body.Items[1].CycleTime)
+	assert.Len(t, body.Percentiles, 2)
+	assert.Equal(t, 3.25, body.Percentiles[0].P50)
+	assert.Equal(t, 4.125, body.Percentiles[0].P85)
+	assert.Equal(t, 4.375, body.Percentiles[0].P95)
+}
+
+func TestGetCycleTimeProjectTimeZone(t *testing.T) {
+	querier := &MockQuerier{GetProjectTimeZoneResult: "Pacific/Kiritimati"}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+
+	code, _, _, err := makeRequest[models.CycleTimeResult](router, "GET", "/api/projects/1/cycle-time", nil)
+
+	location, _ := time.LoadLocation("Pacific/Kiritimati")
+	year, month, day := time.Now().In(location).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(1), querier.GetProjectTimeZoneParams)
+	assert.Equal(t, today.AddDate(0, 0, 1), querier.GetCycleTimeItemsParams.EndDate.Time)
+	assert.Equal(t, today.AddDate(0, -1, -30), querier.GetCycleTimeItemsParams.StartDate.Time)
+}
+
+func TestGetCycleTimeProjectTimeZoneError(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{GetProjectTimeZoneError: fmt.Errorf("error")}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+
+	code, _, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/cycle-time", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetCycleTimeInvalidQuery(t *testing.T) {
+	handlers := new(Handlers)
+	handlers.Queries = &MockQuerier{}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+
+	code, body, _, _ := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/cycle-time?from=yesterday&window=0", nil)
+
+	assert.Equal(t, 400, code)
+	assert.Equal(t, "from should be a date in the format YYYY-MM-DD", (*body).Errors[0])
+	assert.Equal(t, "window should be greater than 0", (*body).Errors[1])
+}
+
+func TestGetCycleTimeError(t *testing.T) {
+	handlers := new(Handlers)
+	handlers.Queries = &MockQuerier{GetCycleTimeItemsError: fmt.Errorf("error")}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+
+	code, body, _, _ := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/cycle-time", nil)
+
+	assert.Equal(t, "Unknown error", (*body).Errors[0])
+	assert.Equal(t, 500, code)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.Equal(t, 5.5, percentile(values, 50))
+	assert.InDelta(t, 8.65, percentile(values, 85), 0.0001)
+	assert.InDelta(t, 9.55, percentile(values, 95), 0.0001)
+	assert.Equal(t, 3.0, percentile([]float64{3}, 95))
+}
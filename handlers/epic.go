@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// GetEpicTree returns the items of the latest snapshot of a project that
+// have sub-issues, each with its sub-issues and the progress of the effort
+// below it.
+func (h Handlers) GetEpicTree(w http.ResponseWriter, r *http.Request) {
+	projectId := r.PathValue("projectId")
+	projectIdInt, _ := strconv.Atoi(projectId)
+	items, err := h.Queries.GetWorkItemTree(r.Context(), int32(projectIdInt))
+
+	if err != nil {
+		slog.Error("Error getting epic tree data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	h.JSON(w, http.StatusOK, buildEpicTree(items))
+}
+
+func buildEpicTree(items []db.GetWorkItemTreeRow) []*models.EpicTreeItem {
+	nodes := make(map[string]*models.EpicTreeItem)
+	rollups := make(map[string]models.RollupMode)
+	for _, item := range items {
+		// snapshots saved before rollups were applied on read hold counted
+		// values already
+		rollups[item.GhID] = models.RollupMode(item.Rollup)
+		if item.RolledUp {
+			rollups[item.GhID] = models.RollupNone
+		}
+
+		nodes[item.GhID] = &models.EpicTreeItem{
+			Id:             item.GhID,
+			Title:          item.Name,
+			Status:         item.Status.String,
+			Effort:         float64(item.Effort.Int32),
+			RemainingHours: float64(item.RemainingHours.Int32),
+			Inferred:       item.Inferred,
+			Children:       []*models.EpicTreeItem{},
+		}
+	}
+
+	roots := []*models.EpicTreeItem{}
+	for _, item := range items {
+		node := nodes[item.GhID]
+		parent, ok := nodes[item.ParentGhID.String]
+
+		if item.ParentGhID.Valid && ok && parent != node {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	result := []*models.EpicTreeItem{}
+	for _, root := range roots {
+		if len(root.Children) > 0 {
+			sumEpicTree(root, rollups)
+			result = append(result, root)
+		}
+	}
+
+	return result
+}
+
+// sumEpicTree computes the totals of an item from the items below it,
+// counting the effort of each item the way the rollup mode of the project
+// does. Every item has a single parent, so items of a tree never form a cycle.
+func sumEpicTree(node *models.EpicTreeItem, rollups map[string]models.RollupMode) {
+	countsOwn, countsChildren := true, true
+	switch rollups[node.Id] {
+	case models.RollupLeaf, models.RollupDerived:
+		countsOwn = len(node.Children) == 0
+	case models.RollupParent:
+		countsChildren = false
+	}
+
+	ownRemainingHours := node.RemainingHours
+	node.RemainingHours = 0
+	if countsOwn {
+		node.TotalEffort = node.Effort
+		if node.Status == "Done" {
+			node.CompletedEffort = node.Effort
+		} else {
+			node.RemainingHours = ownRemainingHours
+		}
+	}
+
+	for _, child := range node.Children {
+		sumEpicTree(child, rollups)
+		node.Inferred = node.Inferred || child.Inferred
+
+		if countsChildren {
+			node.TotalEffort += child.TotalEffort
+			node.CompletedEffort += child.CompletedEffort
+			node.RemainingHours += child.RemainingHours
+		}
+	}
+
+	if node.TotalEffort > 0 {
+		node.Progress = node.CompletedEffort / node.TotalEffort
+	}
+}
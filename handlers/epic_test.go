@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getEpicRouter(querier *MockQuerier) *http.ServeMux {
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/epics", handlers.GetEpicTree)
+
+	return router
+}
+
+func getEpicTestItem(id string, parentId string, status string, effort int32, remainingHours int32) db.GetWorkItemTreeRow {
+	return db.GetWorkItemTreeRow{
+		GhID:           id,
+		Name:           id,
+		Status:         pgtype.Text{String: status, Valid: true},
+		Effort:         pgtype.Int4{Int32: effort, Valid: true},
+		RemainingHours: pgtype.Int4{Int32: remainingHours, Valid: true},
+		ParentGhID:     pgtype.Text{String: parentId, Valid: parentId != ""},
+	}
+}
+
+func TestGetEpicTree(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeResult: []db.GetWorkItemTreeRow{
+		getEpicTestItem("epic", "", "In Progress", 0, 0),
+		getEpicTestItem("task1", "epic", "Done", 3, 2),
+		getEpicTestItem("task2", "epic", "New", 1, 5),
+		getEpicTestItem("standalone", "", "New", 2, 1),
+	}}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(3), querier.GetWorkItemTreeParams)
+	assert.Equal(t, []models.EpicTreeItem{
+		{
+			Id:              "epic",
+			Title:           "epic",
+			Status:          "In Progress",
+			TotalEffort:     4,
+			CompletedEffort: 3,
+			RemainingHours:  5,
+			Progress:        0.75,
+			Children: []*models.EpicTreeItem{
+				{Id: "task1", Title: "task1", Status: "Done", Effort: 3, TotalEffort: 3, CompletedEffort: 3, Progress: 1, Children: []*models.EpicTreeItem{}},
+				{Id: "task2", Title: "task2", Status: "New", Effort: 1, TotalEffort: 1, RemainingHours: 5, Children: []*models.EpicTreeItem{}},
+			},
+		},
+	}, *body)
+}
+
+func TestGetEpicTreeError(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func getEpicRollupTestItems(rollup models.RollupMode) []db.GetWorkItemTreeRow {
+	items := []db.GetWorkItemTreeRow{
+		getEpicTestItem("epic", "", "In Progress", 8, 4),
+		getEpicTestItem("task1", "epic", "Done", 3, 2),
+		getEpicTestItem("task2", "epic", "New", 5, 6),
+	}
+
+	for i := range items {
+		items[i].Rollup = string(rollup)
+	}
+
+	return items
+}
+
+func TestGetEpicTreeRollupLeaf(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeResult: getEpicRollupTestItems(models.RollupLeaf)}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(8), (*body)[0].Effort)
+	assert.Equal(t, float64(8), (*body)[0].TotalEffort)
+	assert.Equal(t, float64(3), (*body)[0].CompletedEffort)
+	assert.Equal(t, float64(6), (*body)[0].RemainingHours)
+	assert.Equal(t, 0.375, (*body)[0].Progress)
+}
+
+func TestGetEpicTreeRollupParent(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeResult: getEpicRollupTestItems(models.RollupParent)}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(8), (*body)[0].TotalEffort)
+	assert.Equal(t, float64(0), (*body)[0].CompletedEffort)
+	assert.Equal(t, float64(4), (*body)[0].RemainingHours)
+	assert.Equal(t, float64(0), (*body)[0].Progress)
+	assert.Equal(t, float64(3), (*body)[0].Children[0].TotalEffort)
+}
+
+func TestGetEpicTreeRollupDerived(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeResult: getEpicRollupTestItems(models.RollupDerived)}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(8), (*body)[0].TotalEffort)
+	assert.Equal(t, float64(3), (*body)[0].CompletedEffort)
+	assert.Equal(t, 0.375, (*body)[0].Progress)
+}
+
+func TestGetEpicTreeRollupNone(t *testing.T) {
+	querier := &MockQuerier{GetWorkItemTreeResult: getEpicRollupTestItems(models.RollupNone)}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(16), (*body)[0].TotalEffort)
+	assert.Equal(t, float64(3), (*body)[0].CompletedEffort)
+	assert.Equal(t, float64(10), (*body)[0].RemainingHours)
+}
+
+func TestGetEpicTreeRollupSavedBeforeReadRollups(t *testing.T) {
+	items := getEpicRollupTestItems(models.RollupLeaf)
+	items[0].Effort = pgtype.Int4{Int32: 0, Valid: true}
+	items[0].RemainingHours = pgtype.Int4{Int32: 0, Valid: true}
+	for i := range items {
+		items[i].RolledUp = true
+	}
+	querier := &MockQuerier{GetWorkItemTreeResult: items}
+
+	code, body, _, err := makeRequest[[]models.EpicTreeItem](getEpicRouter(querier), "GET", "/api/projects/3/epics", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(8), (*body)[0].TotalEffort)
+	assert.Equal(t, float64(3), (*body)[0].CompletedEffort)
+}
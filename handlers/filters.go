@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var workItemTypes = []string{"Issue", "PullRequest", "DraftIssue"}
+
+const (
+	dayGranularity  = "day"
+	hourGranularity = "hour"
+)
+
+// intradayWindow is how far back hourly charts of a project go.
+const intradayWindow = 7 * 24 * time.Hour
+
+type chartFilters struct {
+	Types         []string
+	IncludeLabels []string
+	ExcludeLabels []string
+	Priorities    []int32
+	// TimeZone decides which day is today. It comes from the request or,
+	// through resolveTimeZone, from the project. Queries fall back to the
+	// time zone of the project when it is not set.
+	TimeZone pgtype.Text
+	location *time.Location
+}
+
+func getChartFilters(r *http.Request) (*chartFilters, []string) {
+	result := &chartFilters{}
+	errors := []string{}
+	query := r.URL.Query()
+
+	for _, value := range getListQuery(query.Get("types")) {
+		if !slices.Contains(workItemTypes, value) {
+			errors = append(errors, fmt.Sprintf("types should be one of %s", strings.Join(workItemTypes, ", ")))
+			break
+		}
+
+		result.Types = append(result.Types, value)
+	}
+
+	// labels prefixed with - are excluded, e.g. label=bug,-wontfix
+	for _, rawLabels := range query["label"] {
+		for _, value := range getListQuery(rawLabels) {
+			if label, ok := strings.CutPrefix(value, "-"); ok {
+				result.ExcludeLabels = append(result.ExcludeLabels, label)
+			} else {
+				result.IncludeLabels = append(result.IncludeLabels, value)
+			}
+		}
+	}
+
+	for _, value := range getListQuery(query.Get("priority")) {
+		priority, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			errors = append(errors, "priority should be a list of numbers")
+			break
+		}
+
+		result.Priorities = append(result.Priorities, int32(priority))
+	}
+
+	if value := query.Get("tz"); value != "" {
+		location, err := time.LoadLocation(value)
+		if err != nil || value == "Local" {
+			errors = append(errors, "tz should be an IANA time zone, e.g. America/New_York")
+		} else {
+			result.TimeZone = pgtype.Text{String: value, Valid: true}
+			result.location = location
+		}
+	}
+
+	return result, errors
+}
+
+// resolveTimeZone uses the time zone of the project when the request does not
+// ask for one, so the handler and the queries agree on which day is today.
+// Unknown projects have no data and fall back to UTC.
+func (h Handlers) resolveTimeZone(ctx context.Context, filters *chartFilters, projectId int32) error {
+	if filters.location != nil {
+		return nil
+	}
+
+	timeZone, err := h.Queries.GetProjectTimeZone(ctx, projectId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		timeZone, err = "UTC", nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return err
+	}
+
+	filters.TimeZone = pgtype.Text{String: timeZone, Valid: true}
+	filters.location = location
+
+	return nil
+}
+
+// today returns the current day in the time zone of the filters as midnight
+// UTC, the way snapshot dates are saved. The time zone must be resolved first.
+func (f *chartFilters) today() time.Time {
+	year, month, day := time.Now().In(f.location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// getGranularity reads whether a chart is served by day, the default, or by
+// hour from the intraday snapshots.
+func getGranularity(r *http.Request) (string, []string) {
+	switch value := r.URL.Query().Get("granularity"); value {
+	case "", dayGranularity:
+		return dayGranularity, nil
+	case hourGranularity:
+		return hourGranularity, nil
+	default:
+		return "", []string{fmt.Sprintf("granularity should be one of %s, %s", dayGranularity, hourGranularity)}
+	}
+}
+
+func getListQuery(value string) []string {
+	result := []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,8 +12,31 @@ import (
 )
 
 type Handlers struct {
-	CORSOrigins string
-	Queries     db.Querier
+	CORSOrigins   string
+	Queries       db.Querier
+	Syncer        Syncer
+	Webhooks      WebhookProcessor
+	WebhookSecret string
+	Registry      ProjectRegistry
+	AdminToken    string
+}
+
+type Syncer interface {
+	SyncProject(projectId int32) (*models.Sync, error)
+	SyncAll() (*models.Sync, error)
+	GetSync(id string) (*models.Sync, bool)
+}
+
+type WebhookProcessor interface {
+	ProcessProjectItemEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectItemEvent) error
+	ProcessProjectEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectEvent) error
+}
+
+type ProjectRegistry interface {
+	GetRegisteredProjects(ctx context.Context) ([]models.ProjectRegistration, error)
+	RegisterProject(ctx context.Context, registration *models.ProjectRegistration) (*models.ProjectRegistration, error)
+	UpdateRegisteredProject(ctx context.Context, id int32, registration *models.ProjectRegistration) (*models.ProjectRegistration, error)
+	DeleteRegisteredProject(ctx context.Context, id int32) error
 }
 
 func (h Handlers) JSON(w http.ResponseWriter, statusCode int, data interface{}) {
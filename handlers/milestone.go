@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+func (h Handlers) GetMilestones(w http.ResponseWriter, r *http.Request) {
+	projectId := r.PathValue("projectId")
+	projectIdInt, _ := strconv.Atoi(projectId)
+	milestones, err := h.Queries.GetMilestones(r.Context(), int32(projectIdInt))
+
+	if err != nil {
+		slog.Error("Error getting milestone data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.Milestone{}
+	for _, item := range milestones {
+		milestone := &models.Milestone{
+			Id:        strconv.FormatInt(int64(item.ID), 10),
+			Title:     item.Name,
+			State:     item.State,
+			StartDate: item.StartDate.Time,
+		}
+
+		if item.DueDate.Valid {
+			dueDate := item.DueDate.Time
+			milestone.DueDate = &dueDate
+		}
+
+		result = append(result, milestone)
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+// GetMilestoneBurndown returns the remaining effort of the items in a
+// milestone for each weekday from its creation to its due date, or to today
+// when it has no due date.
+func (h Handlers) GetMilestoneBurndown(w http.ResponseWriter, r *http.Request) {
+	projectId := r.PathValue("projectId")
+	projectIdInt, _ := strconv.Atoi(projectId)
+	milestoneId := r.PathValue("milestoneId")
+	milestoneIdInt, _ := strconv.Atoi(milestoneId)
+	filters, errors := getChartFilters(r)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	burndown, err := h.Queries.GetMilestoneBurndown(r.Context(), db.GetMilestoneBurndownParams{
+		MilestoneID:   int32(milestoneIdInt),
+		ProjectID:     int32(projectIdInt),
+		TimeZone:      filters.TimeZone,
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting milestone burndown data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.MilestoneBurndownItem{}
+	for _, item := range burndown {
+		remaining, _ := item.Remaining.Float64Value()
+		ideal, _ := item.Ideal.Float64Value()
+		result = append(result, &models.MilestoneBurndownItem{
+			MilestoneDay: item.MilestoneDay.Time,
+			Remaining:    remaining.Float64,
+			Ideal:        ideal.Float64,
+			Inferred:     item.Inferred,
+		})
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getMilestonesRouter(querier *MockQuerier) *http.ServeMux {
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/milestones", handlers.GetMilestones)
+	router.HandleFunc("GET /api/projects/{projectId}/milestones/{milestoneId}/burndown", handlers.GetMilestoneBurndown)
+
+	return router
+}
+
+func TestGetMilestones(t *testing.T) {
+	startDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	querier := &MockQuerier{GetMilestonesResult: []db.Milestone{
+		{
+			ID:        1,
+			Name:      "v1.0",
+			State:     "OPEN",
+			StartDate: pgtype.Date{Time: startDate, Valid: true},
+			DueDate:   pgtype.Date{Time: dueDate, Valid: true},
+		},
+		{
+			ID:        2,
+			Name:      "Backlog",
+			State:     "OPEN",
+			StartDate: pgtype.Date{Time: startDate, Valid: true},
+		},
+	}}
+
+	code, body, _, err := makeRequest[[]models.Milestone](getMilestonesRouter(querier), "GET", "/api/projects/3/milestones", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(3), querier.GetMilestonesParams)
+	assert.Equal(t, []models.Milestone{
+		{Id: "1", Title: "v1.0", State: "OPEN", StartDate: startDate, DueDate: &dueDate},
+		{Id: "2", Title: "Backlog", State: "OPEN", StartDate: startDate},
+	}, *body)
+}
+
+func TestGetMilestonesError(t *testing.T) {
+	querier := &MockQuerier{GetMilestonesError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getMilestonesRouter(querier), "GET", "/api/projects/3/milestones", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetMilestoneBurndown(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	querier := &MockQuerier{GetMilestoneBurndownResult: []db.GetMilestoneBurndownRow{
+		{
+			MilestoneDay: pgtype.Date{Time: day, Valid: true},
+			Remaining:    pgtype.Numeric{Int: big.NewInt(8), Valid: true},
+			Ideal:        pgtype.Numeric{Int: big.NewInt(10), Valid: true},
+			Inferred:     true,
+		},
+	}}
+
+	code, body, _, err := makeRequest[[]models.MilestoneBurndownItem](getMilestonesRouter(querier), "GET", "/api/projects/3/milestones/4/burndown?types=Issue&label=bug,-wontfix", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, db.GetMilestoneBurndownParams{
+		MilestoneID:   4,
+		ProjectID:     3,
+		Types:         []string{"Issue"},
+		IncludeLabels: []string{"bug"},
+		ExcludeLabels: []string{"wontfix"},
+	}, querier.GetMilestoneBurndownParams)
+	assert.Equal(t, []models.MilestoneBurndownItem{
+		{MilestoneDay: day, Remaining: 8, Ideal: 10, Inferred: true},
+	}, *body)
+}
+
+func TestGetMilestoneBurndownInvalidFilter(t *testing.T) {
+	code, _, _, err := makeRequest[models.ErrorResult](getMilestonesRouter(&MockQuerier{}), "GET", "/api/projects/3/milestones/4/burndown?types=Epic", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+}
+
+func TestGetMilestoneBurndownError(t *testing.T) {
+	querier := &MockQuerier{GetMilestoneBurndownError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getMilestonesRouter(querier), "GET", "/api/projects/3/milestones/4/burndown", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jlucaspains/github-charts/db"
 )
 
@@ -11,57 +12,321 @@ type MockQuerier struct {
 	GetIterationsResult []db.Iteration
 	GetIterationsError  error
 
+	GetIterationBurndownParams db.GetIterationBurndownParams
 	GetIterationBurndownResult []db.GetIterationBurndownRow
 	GetIterationBurndownError  error
 
 	GetProjectsResult []db.Project
 	GetProjectsError  error
 
+	GetProjectTimeZoneParams int32
+	GetProjectTimeZoneResult string
+	GetProjectTimeZoneError  error
+
+	GetCycleTimeItemsParams db.GetCycleTimeItemsParams
+	GetCycleTimeItemsResult []db.GetCycleTimeItemsRow
+	GetCycleTimeItemsError  error
+
+	GetIterationIntradayBurndownParams db.GetIterationIntradayBurndownParams
+	GetIterationIntradayBurndownResult []db.GetIterationIntradayBurndownRow
+	GetIterationIntradayBurndownError  error
+
+	GetProjectBurnupParams db.GetProjectBurnupParams
 	GetProjectBurnupResult []db.GetProjectBurnupRow
 	GetProjectBurnupError  error
+
+	GetProjectIntradayBurnupParams db.GetProjectIntradayBurnupParams
+	GetProjectIntradayBurnupResult []db.GetProjectIntradayBurnupRow
+	GetProjectIntradayBurnupError  error
+
+	GetSyncRunsParams int32
+	GetSyncRunsResult []db.SyncRun
+	GetSyncRunsError  error
+
+	GetProjectSyncRunsParams db.GetProjectSyncRunsParams
+	GetProjectSyncRunsResult []db.SyncRun
+	GetProjectSyncRunsError  error
+
+	GetProjectScopeRemovedParams db.GetProjectScopeRemovedParams
+	GetProjectScopeRemovedResult []db.GetProjectScopeRemovedRow
+	GetProjectScopeRemovedError  error
+
+	GetIterationScopeRemovedParams db.GetIterationScopeRemovedParams
+	GetIterationScopeRemovedResult []db.GetIterationScopeRemovedRow
+	GetIterationScopeRemovedError  error
+
+	GetMilestonesParams int32
+	GetMilestonesResult []db.Milestone
+	GetMilestonesError  error
+
+	GetMilestoneBurndownParams db.GetMilestoneBurndownParams
+	GetMilestoneBurndownResult []db.GetMilestoneBurndownRow
+	GetMilestoneBurndownError  error
+
+	GetProjectPriorityBreakdownParams db.GetProjectPriorityBreakdownParams
+	GetProjectPriorityBreakdownResult []db.GetProjectPriorityBreakdownRow
+	GetProjectPriorityBreakdownError  error
+
+	GetIterationWorkloadParams db.GetIterationWorkloadParams
+	GetIterationWorkloadResult []db.GetIterationWorkloadRow
+	GetIterationWorkloadError  error
+
+	GetWorkItemTreeParams int32
+	GetWorkItemTreeResult []db.GetWorkItemTreeRow
+	GetWorkItemTreeError  error
+}
+
+// CarryForwardWorkItems implements Querier.
+func (m *MockQuerier) CarryForwardWorkItems(ctx context.Context, arg db.CarryForwardWorkItemsParams) (int64, error) {
+	panic("unimplemented")
+}
+
+// DeleteRegisteredProject implements Querier.
+func (m *MockQuerier) DeleteRegisteredProject(ctx context.Context, id int32) (int64, error) {
+	panic("unimplemented")
+}
+
+// DeleteWorkItemAssignees implements Querier.
+func (m *MockQuerier) DeleteWorkItemAssignees(ctx context.Context, workItemHistoryID int32) error {
+	panic("unimplemented")
+}
+
+// DeleteWorkItemLabels implements Querier.
+func (m *MockQuerier) DeleteWorkItemLabels(ctx context.Context, workItemHistoryID int32) error {
+	panic("unimplemented")
+}
+
+// DeleteWorkItemSnapshot implements Querier.
+func (m *MockQuerier) DeleteWorkItemSnapshot(ctx context.Context, arg db.DeleteWorkItemSnapshotParams) error {
+	panic("unimplemented")
+}
+
+// FillWorkItemHistoryGaps implements Querier.
+func (m *MockQuerier) FillWorkItemHistoryGaps(ctx context.Context, arg db.FillWorkItemHistoryGapsParams) ([]pgtype.Date, error) {
+	panic("unimplemented")
+}
+
+// GetCycleTimeItems implements Querier.
+func (m *MockQuerier) GetCycleTimeItems(ctx context.Context, arg db.GetCycleTimeItemsParams) ([]db.GetCycleTimeItemsRow, error) {
+	m.GetCycleTimeItemsParams = arg
+	return m.GetCycleTimeItemsResult, m.GetCycleTimeItemsError
 }
 
 // GetIterationBurndown implements Querier.
-func (m *MockQuerier) GetIterationBurndown(ctx context.Context, id int32) ([]db.GetIterationBurndownRow, error) {
+func (m *MockQuerier) GetIterationBurndown(ctx context.Context, arg db.GetIterationBurndownParams) ([]db.GetIterationBurndownRow, error) {
+	m.GetIterationBurndownParams = arg
 	return m.GetIterationBurndownResult, m.GetIterationBurndownError
 }
 
+// GetIterationIntradayBurndown implements Querier.
+func (m *MockQuerier) GetIterationIntradayBurndown(ctx context.Context, arg db.GetIterationIntradayBurndownParams) ([]db.GetIterationIntradayBurndownRow, error) {
+	m.GetIterationIntradayBurndownParams = arg
+	return m.GetIterationIntradayBurndownResult, m.GetIterationIntradayBurndownError
+}
+
+// GetIterationScopeRemoved implements Querier.
+func (m *MockQuerier) GetIterationScopeRemoved(ctx context.Context, arg db.GetIterationScopeRemovedParams) ([]db.GetIterationScopeRemovedRow, error) {
+	m.GetIterationScopeRemovedParams = arg
+	return m.GetIterationScopeRemovedResult, m.GetIterationScopeRemovedError
+}
+
+// GetIterationWorkload implements Querier.
+func (m *MockQuerier) GetIterationWorkload(ctx context.Context, arg db.GetIterationWorkloadParams) ([]db.GetIterationWorkloadRow, error) {
+	m.GetIterationWorkloadParams = arg
+	return m.GetIterationWorkloadResult, m.GetIterationWorkloadError
+}
+
 // GetIterations implements Querier.
 func (m *MockQuerier) GetIterations(ctx context.Context, projectID int32) ([]db.Iteration, error) {
 	return m.GetIterationsResult, m.GetIterationsError
 }
 
+// GetMilestoneBurndown implements Querier.
+func (m *MockQuerier) GetMilestoneBurndown(ctx context.Context, arg db.GetMilestoneBurndownParams) ([]db.GetMilestoneBurndownRow, error) {
+	m.GetMilestoneBurndownParams = arg
+	return m.GetMilestoneBurndownResult, m.GetMilestoneBurndownError
+}
+
+// GetMilestones implements Querier.
+func (m *MockQuerier) GetMilestones(ctx context.Context, projectID int32) ([]db.Milestone, error) {
+	m.GetMilestonesParams = projectID
+	return m.GetMilestonesResult, m.GetMilestonesError
+}
+
+// GetPreviousWorkItems implements Querier.
+func (m *MockQuerier) GetPreviousWorkItems(ctx context.Context, arg db.GetPreviousWorkItemsParams) ([]db.GetPreviousWorkItemsRow, error) {
+	panic("unimplemented")
+}
+
 // GetProjectBurnup implements Querier.
 func (m *MockQuerier) GetProjectBurnup(ctx context.Context, arg db.GetProjectBurnupParams) ([]db.GetProjectBurnupRow, error) {
+	m.GetProjectBurnupParams = arg
 	return m.GetProjectBurnupResult, m.GetProjectBurnupError
 }
 
+// GetProjectFirstChangeDate implements Querier.
+func (m *MockQuerier) GetProjectFirstChangeDate(ctx context.Context, ghID string) (pgtype.Date, error) {
+	panic("unimplemented")
+}
+
+// GetProjectIntradayBurnup implements Querier.
+func (m *MockQuerier) GetProjectIntradayBurnup(ctx context.Context, arg db.GetProjectIntradayBurnupParams) ([]db.GetProjectIntradayBurnupRow, error) {
+	m.GetProjectIntradayBurnupParams = arg
+	return m.GetProjectIntradayBurnupResult, m.GetProjectIntradayBurnupError
+}
+
+// GetProjectPriorityBreakdown implements Querier.
+func (m *MockQuerier) GetProjectPriorityBreakdown(ctx context.Context, arg db.GetProjectPriorityBreakdownParams) ([]db.GetProjectPriorityBreakdownRow, error) {
+	m.GetProjectPriorityBreakdownParams = arg
+	return m.GetProjectPriorityBreakdownResult, m.GetProjectPriorityBreakdownError
+}
+
+// GetProjectScopeRemoved implements Querier.
+func (m *MockQuerier) GetProjectScopeRemoved(ctx context.Context, arg db.GetProjectScopeRemovedParams) ([]db.GetProjectScopeRemovedRow, error) {
+	m.GetProjectScopeRemovedParams = arg
+	return m.GetProjectScopeRemovedResult, m.GetProjectScopeRemovedError
+}
+
+// GetProjectSyncRuns implements Querier.
+func (m *MockQuerier) GetProjectSyncRuns(ctx context.Context, arg db.GetProjectSyncRunsParams) ([]db.SyncRun, error) {
+	m.GetProjectSyncRunsParams = arg
+	return m.GetProjectSyncRunsResult, m.GetProjectSyncRunsError
+}
+
+// GetProjectSyncState implements Querier.
+func (m *MockQuerier) GetProjectSyncState(ctx context.Context, projectName string) (db.ProjectSyncState, error) {
+	panic("unimplemented")
+}
+
+// GetProjectTimeZone implements Querier.
+func (m *MockQuerier) GetProjectTimeZone(ctx context.Context, id int32) (string, error) {
+	m.GetProjectTimeZoneParams = id
+	return m.GetProjectTimeZoneResult, m.GetProjectTimeZoneError
+}
+
 // GetProjects implements Querier.
 func (m *MockQuerier) GetProjects(ctx context.Context) ([]db.Project, error) {
 	return m.GetProjectsResult, m.GetProjectsError
 }
 
+// GetRegisteredProject implements Querier.
+func (m *MockQuerier) GetRegisteredProject(ctx context.Context, id int32) (db.RegisteredProject, error) {
+	panic("unimplemented")
+}
+
+// GetRegisteredProjects implements Querier.
+func (m *MockQuerier) GetRegisteredProjects(ctx context.Context) ([]db.RegisteredProject, error) {
+	panic("unimplemented")
+}
+
+// GetSyncRuns implements Querier.
+func (m *MockQuerier) GetSyncRuns(ctx context.Context, rowLimit int32) ([]db.SyncRun, error) {
+	m.GetSyncRunsParams = rowLimit
+	return m.GetSyncRunsResult, m.GetSyncRunsError
+}
+
+// GetSyncedProjectIds implements Querier.
+func (m *MockQuerier) GetSyncedProjectIds(ctx context.Context, projectNames []string) ([]db.GetSyncedProjectIdsRow, error) {
+	panic("unimplemented")
+}
+
+// GetTrackedProjects implements Querier.
+func (m *MockQuerier) GetTrackedProjects(ctx context.Context) ([]db.GetTrackedProjectsRow, error) {
+	panic("unimplemented")
+}
+
+// GetWorkItemTree implements Querier.
+func (m *MockQuerier) GetWorkItemTree(ctx context.Context, projectID int32) ([]db.GetWorkItemTreeRow, error) {
+	m.GetWorkItemTreeParams = projectID
+	return m.GetWorkItemTreeResult, m.GetWorkItemTreeError
+}
+
 // GetWorkItemsForIteration implements Querier.
 func (m *MockQuerier) GetWorkItemsForIteration(ctx context.Context, name string) ([]db.GetWorkItemsForIterationRow, error) {
 	panic("unimplemented")
 }
 
+// InsertRegisteredProject implements Querier.
+func (m *MockQuerier) InsertRegisteredProject(ctx context.Context, arg db.InsertRegisteredProjectParams) (db.RegisteredProject, error) {
+	panic("unimplemented")
+}
+
+// InsertSyncRun implements Querier.
+func (m *MockQuerier) InsertSyncRun(ctx context.Context, arg db.InsertSyncRunParams) (db.SyncRun, error) {
+	panic("unimplemented")
+}
+
+// InsertWorkItemAssignee implements Querier.
+func (m *MockQuerier) InsertWorkItemAssignee(ctx context.Context, arg db.InsertWorkItemAssigneeParams) error {
+	panic("unimplemented")
+}
+
+// InsertWorkItemEvent implements Querier.
+func (m *MockQuerier) InsertWorkItemEvent(ctx context.Context, arg db.InsertWorkItemEventParams) error {
+	panic("unimplemented")
+}
+
+// InsertWorkItemLabel implements Querier.
+func (m *MockQuerier) InsertWorkItemLabel(ctx context.Context, arg db.InsertWorkItemLabelParams) error {
+	panic("unimplemented")
+}
+
+// SaveIntradaySnapshot implements Querier.
+func (m *MockQuerier) SaveIntradaySnapshot(ctx context.Context, arg db.SaveIntradaySnapshotParams) error {
+	panic("unimplemented")
+}
+
+// UpdateRegisteredProject implements Querier.
+func (m *MockQuerier) UpdateRegisteredProject(ctx context.Context, arg db.UpdateRegisteredProjectParams) (db.RegisteredProject, error) {
+	panic("unimplemented")
+}
+
+// UpsertAssignee implements Querier.
+func (m *MockQuerier) UpsertAssignee(ctx context.Context, login string) (db.Assignee, error) {
+	panic("unimplemented")
+}
+
 // UpsertIteration implements Querier.
 func (m *MockQuerier) UpsertIteration(ctx context.Context, arg db.UpsertIterationParams) (db.Iteration, error) {
 	panic("unimplemented")
 }
 
+// UpsertLabel implements Querier.
+func (m *MockQuerier) UpsertLabel(ctx context.Context, name string) (db.Label, error) {
+	panic("unimplemented")
+}
+
+// UpsertMilestone implements Querier.
+func (m *MockQuerier) UpsertMilestone(ctx context.Context, arg db.UpsertMilestoneParams) (db.Milestone, error) {
+	panic("unimplemented")
+}
+
 // UpsertProject implements Querier.
 func (m *MockQuerier) UpsertProject(ctx context.Context, arg db.UpsertProjectParams) (db.Project, error) {
 	panic("unimplemented")
 }
 
+// UpsertProjectSyncState implements Querier.
+func (m *MockQuerier) UpsertProjectSyncState(ctx context.Context, arg db.UpsertProjectSyncStateParams) error {
+	panic("unimplemented")
+}
+
 // UpsertWorkItem implements Querier.
 func (m *MockQuerier) UpsertWorkItem(ctx context.Context, arg db.UpsertWorkItemParams) (db.WorkItemHistory, error) {
 	panic("unimplemented")
 }
 
+// UpsertWorkItemDates implements Querier.
+func (m *MockQuerier) UpsertWorkItemDates(ctx context.Context, arg db.UpsertWorkItemDatesParams) error {
+	panic("unimplemented")
+}
+
 // UpsertWorkItemStatus implements Querier.
 func (m *MockQuerier) UpsertWorkItemStatus(ctx context.Context, name string) (db.WorkItemStatus, error) {
 	panic("unimplemented")
 }
+
+// UpsertWorkItemStatusChange implements Querier.
+func (m *MockQuerier) UpsertWorkItemStatusChange(ctx context.Context, arg db.UpsertWorkItemStatusChangeParams) error {
+	panic("unimplemented")
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// GetPriorityBreakdown returns the remaining and done effort of the project
+// for each day of the last month grouped by priority. Items without a
+// priority are grouped under a null priority.
+func (h Handlers) GetPriorityBreakdown(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("projectId")
+	idInt, _ := strconv.Atoi(id)
+	filters, errors := getChartFilters(r)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	if err := h.resolveTimeZone(r.Context(), filters, int32(idInt)); err != nil {
+		slog.Error("Error getting project time zone", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	breakdown, err := h.Queries.GetProjectPriorityBreakdown(r.Context(), db.GetProjectPriorityBreakdownParams{
+		ProjectID:     int32(idInt),
+		StartDate:     pgtype.Timestamp{Time: filters.today().AddDate(0, -1, 0), Valid: true},
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting priority breakdown data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.PriorityBreakdownItem{}
+	for _, item := range breakdown {
+		remaining, _ := item.Remaining.Float64Value()
+		done, _ := item.Done.Float64Value()
+		breakdownItem := &models.PriorityBreakdownItem{
+			ProjectDay: item.ProjectDay.Time,
+			Remaining:  remaining.Float64,
+			Done:       done.Float64,
+			Inferred:   item.Inferred,
+		}
+
+		if item.Priority.Valid {
+			priority := item.Priority.Int32
+			breakdownItem.Priority = &priority
+		}
+
+		result = append(result, breakdownItem)
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getPriorityRouter(querier *MockQuerier) *http.ServeMux {
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+	router.HandleFunc("GET /api/projects/{projectId}/priority-breakdown", handlers.GetPriorityBreakdown)
+
+	return router
+}
+
+func TestGetBurnupPriorityFilter(t *testing.T) {
+	querier := &MockQuerier{}
+
+	code, _, _, err := makeRequest[[]models.BurnupItem](getPriorityRouter(querier), "GET", "/api/projects/1/burnup?priority=0,1", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, []int32{0, 1}, querier.GetProjectBurnupParams.Priorities)
+	assert.Equal(t, []int32{0, 1}, querier.GetProjectScopeRemovedParams.Priorities)
+}
+
+func TestGetBurnupInvalidPriorityFilter(t *testing.T) {
+	code, body, _, err := makeRequest[models.ErrorResult](getPriorityRouter(&MockQuerier{}), "GET", "/api/projects/1/burnup?priority=high", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+	assert.Equal(t, []string{"priority should be a list of numbers"}, body.Errors)
+}
+
+func TestGetPriorityBreakdown(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	querier := &MockQuerier{GetProjectPriorityBreakdownResult: []db.GetProjectPriorityBreakdownRow{
+		{
+			ProjectDay: pgtype.Date{Time: day, Valid: true},
+			Priority:   pgtype.Int4{Int32: 0, Valid: true},
+			Remaining:  pgtype.Numeric{Int: big.NewInt(5), Valid: true},
+			Done:       pgtype.Numeric{Int: big.NewInt(3), Valid: true},
+		},
+		{
+			ProjectDay: pgtype.Date{Time: day, Valid: true},
+			Remaining:  pgtype.Numeric{Int: big.NewInt(2), Valid: true},
+			Done:       pgtype.Numeric{Int: big.NewInt(0), Valid: true},
+			Inferred:   true,
+		},
+	}}
+
+	code, body, _, err := makeRequest[[]models.PriorityBreakdownItem](getPriorityRouter(querier), "GET", "/api/projects/1/priority-breakdown?types=Issue&priority=0", nil)
+
+	priority := int32(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(1), querier.GetProjectPriorityBreakdownParams.ProjectID)
+	assert.Equal(t, []string{"Issue"}, querier.GetProjectPriorityBreakdownParams.Types)
+	assert.Equal(t, []int32{0}, querier.GetProjectPriorityBreakdownParams.Priorities)
+	assert.Equal(t, []models.PriorityBreakdownItem{
+		{ProjectDay: day, Priority: &priority, Remaining: 5, Done: 3},
+		{ProjectDay: day, Remaining: 2, Done: 0, Inferred: true},
+	}, *body)
+}
+
+func TestGetPriorityBreakdownError(t *testing.T) {
+	querier := &MockQuerier{GetProjectPriorityBreakdownError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getPriorityRouter(querier), "GET", "/api/projects/1/priority-breakdown", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
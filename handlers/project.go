@@ -23,8 +23,10 @@ func (h Handlers) GetProjects(w http.ResponseWriter, r *http.Request) {
 		result := []*models.Project{}
 		for _, item := range projects {
 			result = append(result, &models.Project{
-				Id:    strconv.Itoa(int(item.ID)),
-				Title: item.Name,
+				Id:       strconv.Itoa(int(item.ID)),
+				Title:    item.Name,
+				TimeZone: item.TimeZone,
+				Rollup:   models.RollupMode(item.Rollup),
 			})
 		}
 
@@ -35,28 +37,116 @@ func (h Handlers) GetProjects(w http.ResponseWriter, r *http.Request) {
 func (h Handlers) GetBurnup(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("projectId")
 	idInt, _ := strconv.Atoi(id)
+	filters, errors := getChartFilters(r)
+	granularity, granularityErrors := getGranularity(r)
+	errors = append(errors, granularityErrors...)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	if granularity == hourGranularity {
+		h.getHourlyBurnup(w, r, int32(idInt), filters)
+		return
+	}
+
+	if err := h.resolveTimeZone(r.Context(), filters, int32(idInt)); err != nil {
+		slog.Error("Error getting project time zone", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	startDate := pgtype.Timestamp{Time: filters.today().AddDate(0, -1, 0), Valid: true}
 	burnup, err := h.Queries.GetProjectBurnup(r.Context(), db.GetProjectBurnupParams{
-		ProjectID: int32(idInt),
-		Column2:   pgtype.Timestamp{Time: time.Now().AddDate(0, -1, 0), Valid: true},
+		ProjectID:     int32(idInt),
+		StartDate:     startDate,
+		TimeZone:      filters.TimeZone,
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
 	})
 
 	if err != nil {
 		slog.Error("Error getting burnup data", "error", err)
 		status, body := h.ErrorToHttpResult(err)
 		h.JSON(w, status, body)
-	} else {
-		result := []*models.BurnupItem{}
-		for _, item := range burnup {
-			qty, _ := item.Qty.Float64Value()
-			result = append(result, &models.BurnupItem{
-				ProjectDay: item.ProjectDay.Time,
-				Qty:        qty.Float64,
-				Status:     item.Status,
-			})
-		}
+		return
+	}
 
-		h.JSON(w, http.StatusOK, result)
+	scopeRemoved, err := h.Queries.GetProjectScopeRemoved(r.Context(), db.GetProjectScopeRemovedParams{
+		ProjectID:     int32(idInt),
+		StartDate:     startDate,
+		TimeZone:      filters.TimeZone,
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting scope removed data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.BurnupItem{}
+	for _, item := range burnup {
+		qty, _ := item.Qty.Float64Value()
+		result = append(result, &models.BurnupItem{
+			ProjectDay: item.ProjectDay.Time,
+			Qty:        qty.Float64,
+			Status:     item.Status,
+			Inferred:   item.Inferred,
+		})
+	}
+
+	for _, item := range scopeRemoved {
+		qty, _ := item.Qty.Float64Value()
+		result = append(result, &models.BurnupItem{
+			ProjectDay: item.ProjectDay.Time,
+			Qty:        qty.Float64,
+			Status:     models.ScopeRemovedSeries,
+		})
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+// getHourlyBurnup returns the effort in each status for every hour of the
+// last week with an intraday snapshot.
+func (h Handlers) getHourlyBurnup(w http.ResponseWriter, r *http.Request, projectId int32, filters *chartFilters) {
+	burnup, err := h.Queries.GetProjectIntradayBurnup(r.Context(), db.GetProjectIntradayBurnupParams{
+		ProjectID:     projectId,
+		StartDate:     pgtype.Timestamp{Time: time.Now().UTC().Add(-intradayWindow), Valid: true},
+		TimeZone:      filters.TimeZone,
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting hourly burnup data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.BurnupItem{}
+	for _, item := range burnup {
+		qty, _ := item.Qty.Float64Value()
+		result = append(result, &models.BurnupItem{
+			ProjectDay: item.ProjectHour.Time,
+			Qty:        qty.Float64,
+			Status:     item.Status,
+		})
 	}
+
+	h.JSON(w, http.StatusOK, result)
 }
 
 func (h Handlers) GetIterations(w http.ResponseWriter, r *http.Request) {
@@ -65,7 +155,7 @@ func (h Handlers) GetIterations(w http.ResponseWriter, r *http.Request) {
 	iterations, err := h.Queries.GetIterations(r.Context(), int32(projectIdInt))
 
 	if err != nil {
-		slog.Error("Error getting iteration data: %s", err)
+		slog.Error("Error getting iteration data", "error", err)
 
 		status, body := h.ErrorToHttpResult(err)
 		h.JSON(w, status, body)
@@ -87,24 +177,101 @@ func (h Handlers) GetIterations(w http.ResponseWriter, r *http.Request) {
 func (h Handlers) GetBurndown(w http.ResponseWriter, r *http.Request) {
 	iterationId := r.PathValue("iterationId")
 	iterationIdInt, _ := strconv.Atoi(iterationId)
-	burndown, err := h.Queries.GetIterationBurndown(r.Context(), int32(iterationIdInt))
+	filters, errors := getChartFilters(r)
+	granularity, granularityErrors := getGranularity(r)
+	errors = append(errors, granularityErrors...)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	if granularity == hourGranularity {
+		h.getHourlyBurndown(w, r, int32(iterationIdInt), filters)
+		return
+	}
+
+	burndown, err := h.Queries.GetIterationBurndown(r.Context(), db.GetIterationBurndownParams{
+		IterationID:   int32(iterationIdInt),
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
 
 	if err != nil {
 		slog.Error("Error getting burndown data", "error", err)
 		status, body := h.ErrorToHttpResult(err)
 		h.JSON(w, status, body)
-	} else {
-		result := []*models.BurndownItem{}
-		for _, item := range burndown {
-			remaining, _ := item.Remaining.Float64Value()
-			ideal, _ := item.Ideal.Float64Value()
-			result = append(result, &models.BurndownItem{
-				IterationDay: item.IterationDay.Time,
-				Remaining:    remaining.Float64,
-				Ideal:        ideal.Float64,
-			})
-		}
+		return
+	}
 
-		h.JSON(w, http.StatusOK, result)
+	scopeRemoved, err := h.Queries.GetIterationScopeRemoved(r.Context(), db.GetIterationScopeRemovedParams{
+		IterationID:   int32(iterationIdInt),
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting scope removed data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	scopeRemovedByDay := make(map[time.Time]float64)
+	for _, item := range scopeRemoved {
+		qty, _ := item.Qty.Float64Value()
+		scopeRemovedByDay[item.IterationDay.Time] = qty.Float64
+	}
+
+	result := []*models.BurndownItem{}
+	for _, item := range burndown {
+		remaining, _ := item.Remaining.Float64Value()
+		ideal, _ := item.Ideal.Float64Value()
+		result = append(result, &models.BurndownItem{
+			IterationDay: item.IterationDay.Time,
+			Remaining:    remaining.Float64,
+			Ideal:        ideal.Float64,
+			ScopeRemoved: scopeRemovedByDay[item.IterationDay.Time],
+			Inferred:     item.Inferred,
+		})
 	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+// getHourlyBurndown returns the remaining effort of the iteration for every
+// hour with an intraday snapshot.
+func (h Handlers) getHourlyBurndown(w http.ResponseWriter, r *http.Request, iterationId int32, filters *chartFilters) {
+	burndown, err := h.Queries.GetIterationIntradayBurndown(r.Context(), db.GetIterationIntradayBurndownParams{
+		IterationID:   iterationId,
+		TimeZone:      filters.TimeZone,
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting hourly burndown data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.BurndownItem{}
+	for _, item := range burndown {
+		remaining, _ := item.Remaining.Float64Value()
+		ideal, _ := item.Ideal.Float64Value()
+		result = append(result, &models.BurndownItem{
+			IterationDay: item.IterationHour.Time,
+			Remaining:    remaining.Float64,
+			Ideal:        ideal.Float64,
+		})
+	}
+
+	h.JSON(w, http.StatusOK, result)
 }
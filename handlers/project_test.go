@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jlucaspains/github-charts/db"
 	"github.com/jlucaspains/github-charts/models"
@@ -52,6 +53,7 @@ func TestGetProjectBurnup(t *testing.T) {
 		Status:     "Done",
 		ProjectDay: pgtype.Date{Time: time.Now(), Valid: true},
 		Qty:        pgtype.Numeric{Int: big.NewInt(10), Valid: true},
+		Inferred:   true,
 	})
 	expected = append(expected, db.GetProjectBurnupRow{
 		Status:     "Done",
@@ -83,6 +85,8 @@ func TestGetProjectBurnup(t *testing.T) {
 	assert.Equal(t, expected[0].ProjectDay.Time.Format("2006-01-02"), (*body)[0].ProjectDay.Format("2006-01-02"))
 	assert.Equal(t, expectedQty.Float64, (*body)[0].Qty)
 	assert.Equal(t, expected[0].Status, (*body)[0].Status)
+	assert.True(t, (*body)[0].Inferred)
+	assert.False(t, (*body)[1].Inferred)
 }
 
 func TestGetProjectBurnupError(t *testing.T) {
@@ -177,3 +181,327 @@ func TestGetBurndownError(t *testing.T) {
 	assert.Equal(t, "Unknown error", (*body).Errors[0])
 	assert.Equal(t, 500, code)
 }
+
+func TestGetProjectBurnupTypesFilter(t *testing.T) {
+	querier := &MockQuerier{GetProjectBurnupResult: []db.GetProjectBurnupRow{}}
+	handlers := new(Handlers)
+	handlers.Queries = querier
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?types=Issue,PullRequest", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(1), querier.GetProjectBurnupParams.ProjectID)
+	assert.Equal(t, []string{"Issue", "PullRequest"}, querier.GetProjectBurnupParams.Types)
+}
+
+func TestGetProjectBurnupInvalidTypesFilter(t *testing.T) {
+	handlers := new(Handlers)
+	handlers.Queries = &MockQuerier{}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, _ := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup?types=Epic", nil)
+
+	assert.Equal(t, 400, code)
+	assert.Equal(t, "types should be one of Issue, PullRequest, DraftIssue", (*body).Errors[0])
+}
+
+func TestGetBurndownTypesFilter(t *testing.T) {
+	querier := &MockQuerier{GetIterationBurndownResult: []db.GetIterationBurndownRow{}}
+	handlers := new(Handlers)
+	handlers.Queries = querier
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+
+	code, _, _, err := makeRequest[[]*models.BurndownItem](router, "GET", "/api/projects/1/iterations/2/burndown?types=DraftIssue", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(2), querier.GetIterationBurndownParams.IterationID)
+	assert.Equal(t, []string{"DraftIssue"}, querier.GetIterationBurndownParams.Types)
+}
+
+func TestGetProjectBurnupLabelFilter(t *testing.T) {
+	querier := &MockQuerier{GetProjectBurnupResult: []db.GetProjectBurnupRow{}}
+	handlers := new(Handlers)
+	handlers.Queries = querier
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?label=bug,-wontfix&label=regression", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, []string{"bug", "regression"}, querier.GetProjectBurnupParams.IncludeLabels)
+	assert.Equal(t, []string{"wontfix"}, querier.GetProjectBurnupParams.ExcludeLabels)
+}
+
+func TestGetBurndownLabelFilter(t *testing.T) {
+	querier := &MockQuerier{GetIterationBurndownResult: []db.GetIterationBurndownRow{}}
+	handlers := new(Handlers)
+	handlers.Queries = querier
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+
+	code, _, _, err := makeRequest[[]*models.BurndownItem](router, "GET", "/api/projects/1/iterations/2/burndown?label=-chore", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Nil(t, querier.GetIterationBurndownParams.IncludeLabels)
+	assert.Equal(t, []string{"chore"}, querier.GetIterationBurndownParams.ExcludeLabels)
+}
+
+func TestGetProjectBurnupScopeRemoved(t *testing.T) {
+	day := pgtype.Date{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true}
+	querier := &MockQuerier{
+		GetProjectBurnupResult: []db.GetProjectBurnupRow{
+			{Status: "Done", ProjectDay: day, Qty: pgtype.Numeric{Int: big.NewInt(10), Valid: true}},
+		},
+		GetProjectScopeRemovedResult: []db.GetProjectScopeRemovedRow{
+			{ProjectDay: day, Qty: pgtype.Numeric{Int: big.NewInt(3), Valid: true}},
+		},
+	}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?types=Issue&label=-wontfix", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Len(t, *body, 2)
+	assert.Equal(t, models.ScopeRemovedSeries, (*body)[1].Status)
+	assert.Equal(t, float64(3), (*body)[1].Qty)
+	assert.Equal(t, int32(1), querier.GetProjectScopeRemovedParams.ProjectID)
+	assert.Equal(t, querier.GetProjectBurnupParams.StartDate, querier.GetProjectScopeRemovedParams.StartDate)
+	assert.Equal(t, []string{"Issue"}, querier.GetProjectScopeRemovedParams.Types)
+	assert.Equal(t, []string{"wontfix"}, querier.GetProjectScopeRemovedParams.ExcludeLabels)
+}
+
+func TestGetProjectBurnupTimeZone(t *testing.T) {
+	querier := &MockQuerier{}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?tz=America/Sao_Paulo", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, pgtype.Text{String: "America/Sao_Paulo", Valid: true}, querier.GetProjectBurnupParams.TimeZone)
+	assert.Equal(t, pgtype.Text{String: "America/Sao_Paulo", Valid: true}, querier.GetProjectScopeRemovedParams.TimeZone)
+	assert.Equal(t, int32(0), querier.GetProjectTimeZoneParams)
+}
+
+func TestGetProjectBurnupProjectTimeZone(t *testing.T) {
+	querier := &MockQuerier{GetProjectTimeZoneResult: "Pacific/Kiritimati"}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup", nil)
+
+	location, _ := time.LoadLocation("Pacific/Kiritimati")
+	year, month, day := time.Now().In(location).Date()
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(1), querier.GetProjectTimeZoneParams)
+	assert.Equal(t, pgtype.Text{String: "Pacific/Kiritimati", Valid: true}, querier.GetProjectBurnupParams.TimeZone)
+	assert.Equal(t, time.Date(year, month, day, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0), querier.GetProjectBurnupParams.StartDate.Time)
+}
+
+func TestGetProjectBurnupProjectTimeZoneError(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{GetProjectTimeZoneError: fmt.Errorf("error")}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetProjectBurnupUnknownProject(t *testing.T) {
+	querier := &MockQuerier{GetProjectTimeZoneError: pgx.ErrNoRows}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Empty(t, *body)
+	assert.Equal(t, pgtype.Text{String: "UTC", Valid: true}, querier.GetProjectBurnupParams.TimeZone)
+	assert.Equal(t, time.Now().UTC().Truncate(24*time.Hour).AddDate(0, -1, 0), querier.GetProjectBurnupParams.StartDate.Time)
+}
+
+func TestGetProjectBurnupInvalidTimeZone(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup?tz=Mars/Olympus", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+	assert.Equal(t, []string{"tz should be an IANA time zone, e.g. America/New_York"}, body.Errors)
+}
+
+func TestGetProjectBurnupScopeRemovedError(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{GetProjectScopeRemovedError: fmt.Errorf("error")}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetBurndownScopeRemoved(t *testing.T) {
+	firstDay := pgtype.Date{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true}
+	secondDay := pgtype.Date{Time: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), Valid: true}
+	querier := &MockQuerier{
+		GetIterationBurndownResult: []db.GetIterationBurndownRow{
+			{IterationDay: firstDay, Remaining: pgtype.Numeric{Int: big.NewInt(10), Valid: true}, Ideal: pgtype.Numeric{Int: big.NewInt(10), Valid: true}},
+			{IterationDay: secondDay, Remaining: pgtype.Numeric{Int: big.NewInt(5), Valid: true}, Ideal: pgtype.Numeric{Int: big.NewInt(5), Valid: true}},
+		},
+		GetIterationScopeRemovedResult: []db.GetIterationScopeRemovedRow{
+			{IterationDay: firstDay, Qty: pgtype.Numeric{Int: big.NewInt(0), Valid: true}},
+			{IterationDay: secondDay, Qty: pgtype.Numeric{Int: big.NewInt(2), Valid: true}},
+		},
+	}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+
+	code, body, _, err := makeRequest[[]*models.BurndownItem](router, "GET", "/api/projects/1/iterations/4/burndown", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, float64(0), (*body)[0].ScopeRemoved)
+	assert.Equal(t, float64(2), (*body)[1].ScopeRemoved)
+	assert.Equal(t, int32(4), querier.GetIterationScopeRemovedParams.IterationID)
+}
+
+func TestGetProjectBurnupHourly(t *testing.T) {
+	hour := pgtype.Timestamp{Time: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC), Valid: true}
+	querier := &MockQuerier{
+		GetProjectIntradayBurnupResult: []db.GetProjectIntradayBurnupRow{
+			{Status: "Done", ProjectHour: hour, Qty: pgtype.Numeric{Int: big.NewInt(10), Valid: true}},
+		},
+	}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?granularity=hour&label=bug", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, []*models.BurnupItem{{ProjectDay: hour.Time, Qty: 10, Status: "Done"}}, *body)
+	assert.Equal(t, int32(1), querier.GetProjectIntradayBurnupParams.ProjectID)
+	assert.Equal(t, []string{"bug"}, querier.GetProjectIntradayBurnupParams.IncludeLabels)
+	assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), querier.GetProjectIntradayBurnupParams.StartDate.Time, time.Minute)
+	assert.False(t, querier.GetProjectIntradayBurnupParams.TimeZone.Valid)
+}
+
+func TestGetProjectBurnupHourlyTimeZone(t *testing.T) {
+	querier := &MockQuerier{}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[[]*models.BurnupItem](router, "GET", "/api/projects/1/burnup?granularity=hour&tz=America/New_York", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, pgtype.Text{String: "America/New_York", Valid: true}, querier.GetProjectIntradayBurnupParams.TimeZone)
+}
+
+func TestGetProjectBurnupHourlyError(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{GetProjectIntradayBurnupError: fmt.Errorf("error")}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, _, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup?granularity=hour", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetBurnupInvalidGranularity(t *testing.T) {
+	handlers := &Handlers{Queries: &MockQuerier{}}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+
+	code, body, _, err := makeRequest[models.ErrorResult](router, "GET", "/api/projects/1/burnup?granularity=minute", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+	assert.Equal(t, []string{"granularity should be one of day, hour"}, body.Errors)
+}
+
+func TestGetBurndownHourly(t *testing.T) {
+	hour := pgtype.Timestamp{Time: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC), Valid: true}
+	querier := &MockQuerier{
+		GetIterationIntradayBurndownResult: []db.GetIterationIntradayBurndownRow{
+			{IterationHour: hour, Remaining: pgtype.Numeric{Int: big.NewInt(8), Valid: true}, Ideal: pgtype.Numeric{Int: big.NewInt(9), Valid: true}},
+		},
+	}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+
+	code, body, _, err := makeRequest[[]*models.BurndownItem](router, "GET", "/api/projects/1/iterations/4/burndown?granularity=hour&types=Issue", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, []*models.BurndownItem{{IterationDay: hour.Time, Remaining: 8, Ideal: 9}}, *body)
+	assert.Equal(t, int32(4), querier.GetIterationIntradayBurndownParams.IterationID)
+	assert.Equal(t, []string{"Issue"}, querier.GetIterationIntradayBurndownParams.Types)
+	assert.False(t, querier.GetIterationIntradayBurndownParams.TimeZone.Valid)
+}
+
+func TestGetBurndownHourlyTimeZone(t *testing.T) {
+	// hours are local to the requested zone rather than UTC
+	hour := pgtype.Timestamp{Time: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), Valid: true}
+	querier := &MockQuerier{
+		GetIterationIntradayBurndownResult: []db.GetIterationIntradayBurndownRow{
+			{IterationHour: hour, Remaining: pgtype.Numeric{Int: big.NewInt(8), Valid: true}, Ideal: pgtype.Numeric{Int: big.NewInt(9), Valid: true}},
+		},
+	}
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+
+	code, body, _, err := makeRequest[[]*models.BurndownItem](router, "GET", "/api/projects/1/iterations/4/burndown?granularity=hour&tz=America/New_York", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, hour.Time, (*body)[0].IterationDay)
+	assert.Equal(t, pgtype.Text{String: "America/New_York", Valid: true}, querier.GetIterationIntradayBurndownParams.TimeZone)
+}
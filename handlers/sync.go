@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+func (h Handlers) SyncProject(w http.ResponseWriter, r *http.Request) {
+	projectId, err := strconv.Atoi(r.PathValue("projectId"))
+
+	if err != nil {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"projectId should be a number"}})
+		return
+	}
+
+	sync, err := h.Syncer.SyncProject(int32(projectId))
+	h.syncResult(w, sync, err)
+}
+
+func (h Handlers) SyncAll(w http.ResponseWriter, r *http.Request) {
+	sync, err := h.Syncer.SyncAll()
+	h.syncResult(w, sync, err)
+}
+
+func (h Handlers) GetSync(w http.ResponseWriter, r *http.Request) {
+	sync, ok := h.Syncer.GetSync(r.PathValue("syncId"))
+
+	if !ok {
+		h.JSON(w, http.StatusNotFound, &models.ErrorResult{Errors: []string{"sync not found"}})
+		return
+	}
+
+	h.JSON(w, http.StatusOK, sync)
+}
+
+func (h Handlers) syncResult(w http.ResponseWriter, sync *models.Sync, err error) {
+	switch {
+	case errors.Is(err, models.ErrProjectNotFound):
+		h.JSON(w, http.StatusNotFound, &models.ErrorResult{Errors: []string{err.Error()}})
+	case errors.Is(err, models.ErrSyncInProgress):
+		h.JSON(w, http.StatusConflict, &models.ErrorResult{Errors: []string{err.Error()}})
+	case err != nil:
+		slog.Error("Error starting sync", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+	default:
+		w.Header().Set("Location", "/api/syncs/"+sync.Id)
+		h.JSON(w, http.StatusAccepted, sync)
+	}
+}
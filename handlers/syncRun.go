@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+const (
+	defaultSyncRunsLimit = 50
+	maxSyncRunsLimit     = 500
+)
+
+func (h Handlers) GetSyncRuns(w http.ResponseWriter, r *http.Request) {
+	limit, errors := getSyncRunsLimit(r)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	runs, err := h.Queries.GetSyncRuns(r.Context(), limit)
+	h.syncRunsResult(w, runs, err)
+}
+
+func (h Handlers) GetProjectSyncRuns(w http.ResponseWriter, r *http.Request) {
+	limit, errors := getSyncRunsLimit(r)
+	projectId, err := strconv.Atoi(r.PathValue("projectId"))
+
+	if err != nil {
+		errors = append(errors, "projectId should be a number")
+	}
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	runs, err := h.Queries.GetProjectSyncRuns(r.Context(), db.GetProjectSyncRunsParams{
+		ProjectID: pgtype.Int4{Int32: int32(projectId), Valid: true},
+		RowLimit:  limit,
+	})
+	h.syncRunsResult(w, runs, err)
+}
+
+func (h Handlers) syncRunsResult(w http.ResponseWriter, runs []db.SyncRun, err error) {
+	if err != nil {
+		slog.Error("Error getting sync runs", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.SyncRun{}
+	for _, item := range runs {
+		result = append(result, &models.SyncRun{
+			Id:           item.ID,
+			SyncId:       item.SyncID.String,
+			ProjectName:  item.ProjectName,
+			ProjectId:    item.ProjectID.Int32,
+			Trigger:      models.SyncTrigger(item.Trigger),
+			Status:       models.SyncStatus(item.Status),
+			StartedAt:    item.StartedAt.Time,
+			FinishedAt:   item.FinishedAt.Time,
+			ItemCount:    item.ItemCount,
+			GraphqlCalls: item.GraphqlCalls,
+			Error:        item.Error.String,
+		})
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
+
+func getSyncRunsLimit(r *http.Request) (int32, []string) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultSyncRunsLimit, []string{}
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxSyncRunsLimit {
+		return 0, []string{fmt.Sprintf("limit should be a number between 1 and %d", maxSyncRunsLimit)}
+	}
+
+	return int32(limit), []string{}
+}
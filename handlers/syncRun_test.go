@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSyncRunsRouter(querier *MockQuerier) *http.ServeMux {
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/syncs", handlers.GetSyncRuns)
+	router.HandleFunc("GET /api/projects/{projectId}/syncs", handlers.GetProjectSyncRuns)
+
+	return router
+}
+
+func TestGetSyncRuns(t *testing.T) {
+	startedAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	querier := &MockQuerier{GetSyncRunsResult: []db.SyncRun{
+		{
+			ID:           2,
+			ProjectName:  "org/1",
+			ProjectID:    pgtype.Int4{Int32: 1, Valid: true},
+			Trigger:      "webhook",
+			Status:       "failed",
+			StartedAt:    pgtype.Timestamp{Time: startedAt, Valid: true},
+			FinishedAt:   pgtype.Timestamp{Time: startedAt.Add(time.Second), Valid: true},
+			GraphqlCalls: 1,
+			Error:        pgtype.Text{String: "unavailable", Valid: true},
+		},
+		{
+			ID:           1,
+			SyncID:       pgtype.Text{String: "abc", Valid: true},
+			ProjectName:  "org/1",
+			ProjectID:    pgtype.Int4{Int32: 1, Valid: true},
+			Trigger:      "schedule",
+			Status:       "succeeded",
+			StartedAt:    pgtype.Timestamp{Time: startedAt.Add(-time.Hour), Valid: true},
+			FinishedAt:   pgtype.Timestamp{Time: startedAt.Add(-time.Hour + time.Minute), Valid: true},
+			ItemCount:    10,
+			GraphqlCalls: 2,
+		},
+	}}
+
+	code, body, _, err := makeRequest[[]models.SyncRun](getSyncRunsRouter(querier), "GET", "/api/syncs", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(50), querier.GetSyncRunsParams)
+	assert.Len(t, *body, 2)
+	assert.Equal(t, models.SyncTriggerWebhook, (*body)[0].Trigger)
+	assert.Equal(t, models.SyncStatusFailed, (*body)[0].Status)
+	assert.Equal(t, "unavailable", (*body)[0].Error)
+	assert.Equal(t, startedAt, (*body)[0].StartedAt)
+	assert.Equal(t, "abc", (*body)[1].SyncId)
+	assert.Equal(t, int32(10), (*body)[1].ItemCount)
+	assert.Equal(t, int32(2), (*body)[1].GraphqlCalls)
+}
+
+func TestGetSyncRunsLimit(t *testing.T) {
+	querier := &MockQuerier{}
+
+	code, _, _, err := makeRequest[[]models.SyncRun](getSyncRunsRouter(querier), "GET", "/api/syncs?limit=5", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, int32(5), querier.GetSyncRunsParams)
+}
+
+func TestGetSyncRunsInvalidLimit(t *testing.T) {
+	code, body, _, err := makeRequest[models.ErrorResult](getSyncRunsRouter(&MockQuerier{}), "GET", "/api/syncs?limit=1000", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+	assert.Equal(t, []string{"limit should be a number between 1 and 500"}, body.Errors)
+}
+
+func TestGetSyncRunsError(t *testing.T) {
+	querier := &MockQuerier{GetSyncRunsError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getSyncRunsRouter(querier), "GET", "/api/syncs", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
+
+func TestGetProjectSyncRuns(t *testing.T) {
+	querier := &MockQuerier{GetProjectSyncRunsResult: []db.SyncRun{
+		{ID: 1, ProjectName: "org/1", Trigger: "manual", Status: "succeeded"},
+	}}
+
+	code, body, _, err := makeRequest[[]models.SyncRun](getSyncRunsRouter(querier), "GET", "/api/projects/3/syncs?limit=10", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Len(t, *body, 1)
+	assert.Equal(t, pgtype.Int4{Int32: 3, Valid: true}, querier.GetProjectSyncRunsParams.ProjectID)
+	assert.Equal(t, int32(10), querier.GetProjectSyncRunsParams.RowLimit)
+}
+
+func TestGetProjectSyncRunsInvalidProject(t *testing.T) {
+	code, body, _, err := makeRequest[models.ErrorResult](getSyncRunsRouter(&MockQuerier{}), "GET", "/api/projects/abc/syncs?limit=0", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+	assert.Equal(t, []string{"limit should be a number between 1 and 500", "projectId should be a number"}, body.Errors)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSyncer struct {
+	SyncProjectValue int32
+	SyncResult       *models.Sync
+	SyncError        error
+	GetSyncResult    *models.Sync
+}
+
+func (m *mockSyncer) SyncProject(projectId int32) (*models.Sync, error) {
+	m.SyncProjectValue = projectId
+	return m.SyncResult, m.SyncError
+}
+
+func (m *mockSyncer) SyncAll() (*models.Sync, error) {
+	return m.SyncResult, m.SyncError
+}
+
+func (m *mockSyncer) GetSync(id string) (*models.Sync, bool) {
+	if m.GetSyncResult == nil || m.GetSyncResult.Id != id {
+		return nil, false
+	}
+
+	return m.GetSyncResult, true
+}
+
+func getSyncRouter(syncer *mockSyncer) *http.ServeMux {
+	handlers := &Handlers{Syncer: syncer}
+
+	router := http.NewServeMux()
+	router.HandleFunc("POST /api/projects/{projectId}/sync", handlers.SyncProject)
+	router.HandleFunc("POST /api/sync", handlers.SyncAll)
+	router.HandleFunc("GET /api/syncs/{syncId}", handlers.GetSync)
+
+	return router
+}
+
+func TestSyncProject(t *testing.T) {
+	syncer := &mockSyncer{SyncResult: &models.Sync{Id: "abc", Status: models.SyncStatusQueued}}
+
+	code, body, headers, err := makeRequest[models.Sync](getSyncRouter(syncer), "POST", "/api/projects/3/sync", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, "/api/syncs/abc", headers.Get("Location"))
+	assert.Equal(t, "abc", body.Id)
+	assert.Equal(t, int32(3), syncer.SyncProjectValue)
+}
+
+func TestSyncProjectInvalidId(t *testing.T) {
+	code, body, _, err := makeRequest[models.ErrorResult](getSyncRouter(&mockSyncer{}), "POST", "/api/projects/abc/sync", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, []string{"projectId should be a number"}, body.Errors)
+}
+
+func TestSyncProjectNotFound(t *testing.T) {
+	syncer := &mockSyncer{SyncError: models.ErrProjectNotFound}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getSyncRouter(syncer), "POST", "/api/projects/3/sync", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestSyncAllInProgress(t *testing.T) {
+	syncer := &mockSyncer{SyncError: models.ErrSyncInProgress}
+
+	code, body, _, err := makeRequest[models.ErrorResult](getSyncRouter(syncer), "POST", "/api/sync", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Equal(t, []string{"a sync is already running for this project"}, body.Errors)
+}
+
+func TestSyncAllError(t *testing.T) {
+	syncer := &mockSyncer{SyncError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getSyncRouter(syncer), "POST", "/api/sync", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestGetSync(t *testing.T) {
+	syncer := &mockSyncer{GetSyncResult: &models.Sync{Id: "abc", Status: models.SyncStatusSucceeded}}
+
+	code, body, _, err := makeRequest[models.Sync](getSyncRouter(syncer), "GET", "/api/syncs/abc", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, models.SyncStatusSucceeded, body.Status)
+}
+
+func TestGetSyncNotFound(t *testing.T) {
+	code, _, _, err := makeRequest[models.ErrorResult](getSyncRouter(&mockSyncer{}), "GET", "/api/syncs/abc", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// GitHub caps webhook payloads at 25MB
+const maxWebhookPayloadSize = 25 << 20
+
+func (h Handlers) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+
+	if err != nil {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"unable to read payload"}})
+		return
+	}
+
+	if !isValidSignature(h.WebhookSecret, payload, r.Header.Get("X-Hub-Signature-256")) {
+		h.JSON(w, http.StatusUnauthorized, &models.ErrorResult{Errors: []string{"invalid signature"}})
+		return
+	}
+
+	deliveryId := r.Header.Get("X-GitHub-Delivery")
+
+	switch r.Header.Get("X-GitHub-Event") {
+	case "projects_v2_item":
+		event := &models.WebhookProjectItemEvent{}
+		if err := json.Unmarshal(payload, event); err != nil {
+			h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"invalid payload"}})
+			return
+		}
+
+		err = h.Webhooks.ProcessProjectItemEvent(r.Context(), deliveryId, event)
+	case "projects_v2":
+		event := &models.WebhookProjectEvent{}
+		if err := json.Unmarshal(payload, event); err != nil {
+			h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: []string{"invalid payload"}})
+			return
+		}
+
+		err = h.Webhooks.ProcessProjectEvent(r.Context(), deliveryId, event)
+	default:
+		err = models.ErrWebhookIgnored
+	}
+
+	if errors.Is(err, models.ErrWebhookIgnored) {
+		slog.Info("Ignoring webhook", "delivery", deliveryId, "reason", err)
+		h.JSON(w, http.StatusOK, &models.WebhookResult{Status: "ignored"})
+		return
+	}
+
+	if err != nil {
+		slog.Error("Error processing webhook", "delivery", deliveryId, "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	h.JSON(w, http.StatusOK, &models.WebhookResult{Status: "processed"})
+}
+
+// isValidSignature checks the HMAC SHA-256 of the payload GitHub sends in
+// the format sha256=<hex digest>.
+func isValidSignature(secret string, payload []byte, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if secret == "" || !ok {
+		return false
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWebhookProcessor struct {
+	DeliveryId       string
+	ProjectItemEvent *models.WebhookProjectItemEvent
+	ProjectEvent     *models.WebhookProjectEvent
+	Error            error
+}
+
+func (m *mockWebhookProcessor) ProcessProjectItemEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectItemEvent) error {
+	m.DeliveryId = deliveryId
+	m.ProjectItemEvent = event
+	return m.Error
+}
+
+func (m *mockWebhookProcessor) ProcessProjectEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectEvent) error {
+	m.DeliveryId = deliveryId
+	m.ProjectEvent = event
+	return m.Error
+}
+
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func makeWebhookRequest(processor *mockWebhookProcessor, event string, payload []byte, signature string) (int, *models.WebhookResult) {
+	handlers := &Handlers{Webhooks: processor, WebhookSecret: "secret"}
+
+	router := http.NewServeMux()
+	router.HandleFunc("POST /webhooks/github", handlers.GitHubWebhook)
+
+	req, _ := http.NewRequest("POST", "/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", "delivery-1")
+	req.Header.Set("X-Hub-Signature-256", signature)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	result := &models.WebhookResult{}
+	json.Unmarshal(rr.Body.Bytes(), result)
+
+	return rr.Code, result
+}
+
+func TestGitHubWebhookProjectItem(t *testing.T) {
+	processor := &mockWebhookProcessor{}
+	payload := []byte(`{"action":"edited","projects_v2_item":{"node_id":"PVTI_1","project_node_id":"PVT_1","content_type":"Issue"}}`)
+
+	code, body := makeWebhookRequest(processor, "projects_v2_item", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "processed", body.Status)
+	assert.Equal(t, "delivery-1", processor.DeliveryId)
+	assert.Equal(t, "edited", processor.ProjectItemEvent.Action)
+	assert.Equal(t, "PVTI_1", processor.ProjectItemEvent.Item.NodeId)
+	assert.Equal(t, "PVT_1", processor.ProjectItemEvent.Item.ProjectNodeId)
+}
+
+func TestGitHubWebhookProject(t *testing.T) {
+	processor := &mockWebhookProcessor{}
+	payload := []byte(`{"action":"edited","projects_v2":{"node_id":"PVT_1","title":"Renamed"}}`)
+
+	code, body := makeWebhookRequest(processor, "projects_v2", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "processed", body.Status)
+	assert.Equal(t, "Renamed", processor.ProjectEvent.Project.Title)
+}
+
+func TestGitHubWebhookInvalidSignature(t *testing.T) {
+	processor := &mockWebhookProcessor{}
+	payload := []byte(`{"action":"edited"}`)
+
+	code, _ := makeWebhookRequest(processor, "projects_v2_item", payload, sign("other", payload))
+
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Nil(t, processor.ProjectItemEvent)
+}
+
+func TestGitHubWebhookMissingSignature(t *testing.T) {
+	code, _ := makeWebhookRequest(&mockWebhookProcessor{}, "projects_v2_item", []byte(`{}`), "")
+
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestGitHubWebhookIgnored(t *testing.T) {
+	processor := &mockWebhookProcessor{Error: fmt.Errorf("%w: project is not tracked", models.ErrWebhookIgnored)}
+	payload := []byte(`{"action":"edited","projects_v2_item":{"node_id":"PVTI_1"}}`)
+
+	code, body := makeWebhookRequest(processor, "projects_v2_item", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ignored", body.Status)
+}
+
+func TestGitHubWebhookUnsupportedEvent(t *testing.T) {
+	payload := []byte(`{"zen":"Keep it logically awesome."}`)
+
+	code, body := makeWebhookRequest(&mockWebhookProcessor{}, "ping", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ignored", body.Status)
+}
+
+func TestGitHubWebhookInvalidPayload(t *testing.T) {
+	payload := []byte(`not json`)
+
+	code, _ := makeWebhookRequest(&mockWebhookProcessor{}, "projects_v2_item", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestGitHubWebhookError(t *testing.T) {
+	processor := &mockWebhookProcessor{Error: fmt.Errorf("error")}
+	payload := []byte(`{"action":"edited","projects_v2_item":{"node_id":"PVTI_1"}}`)
+
+	code, _ := makeWebhookRequest(processor, "projects_v2_item", payload, sign("secret", payload))
+
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// GetWorkload returns the committed, completed and remaining effort of an
+// iteration per assignee as of its last snapshot. Items assigned to several
+// people count towards each of them.
+func (h Handlers) GetWorkload(w http.ResponseWriter, r *http.Request) {
+	iterationId := r.PathValue("iterationId")
+	iterationIdInt, _ := strconv.Atoi(iterationId)
+	filters, errors := getChartFilters(r)
+
+	if len(errors) > 0 {
+		h.JSON(w, http.StatusBadRequest, &models.ErrorResult{Errors: errors})
+		return
+	}
+
+	workload, err := h.Queries.GetIterationWorkload(r.Context(), db.GetIterationWorkloadParams{
+		IterationID:   int32(iterationIdInt),
+		Types:         filters.Types,
+		IncludeLabels: filters.IncludeLabels,
+		ExcludeLabels: filters.ExcludeLabels,
+		Priorities:    filters.Priorities,
+	})
+
+	if err != nil {
+		slog.Error("Error getting workload data", "error", err)
+		status, body := h.ErrorToHttpResult(err)
+		h.JSON(w, status, body)
+		return
+	}
+
+	result := []*models.WorkloadItem{}
+	for _, item := range workload {
+		committed, _ := item.Committed.Float64Value()
+		completed, _ := item.Completed.Float64Value()
+		remainingHours, _ := item.RemainingHours.Float64Value()
+		result = append(result, &models.WorkloadItem{
+			Assignee:       item.Assignee.String,
+			Unassigned:     !item.Assignee.Valid,
+			Committed:      committed.Float64,
+			Completed:      completed.Float64,
+			RemainingHours: remainingHours.Float64,
+			Inferred:       item.Inferred,
+		})
+	}
+
+	h.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getWorkloadRouter(querier *MockQuerier) *http.ServeMux {
+	handlers := &Handlers{Queries: querier}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/workload", handlers.GetWorkload)
+
+	return router
+}
+
+func TestGetWorkload(t *testing.T) {
+	querier := &MockQuerier{GetIterationWorkloadResult: []db.GetIterationWorkloadRow{
+		{
+			Assignee:       pgtype.Text{String: "octocat", Valid: true},
+			Committed:      pgtype.Numeric{Int: big.NewInt(8), Valid: true},
+			Completed:      pgtype.Numeric{Int: big.NewInt(3), Valid: true},
+			RemainingHours: pgtype.Numeric{Int: big.NewInt(12), Valid: true},
+		},
+		{
+			Committed:      pgtype.Numeric{Int: big.NewInt(2), Valid: true},
+			Completed:      pgtype.Numeric{Int: big.NewInt(0), Valid: true},
+			RemainingHours: pgtype.Numeric{Int: big.NewInt(4), Valid: true},
+			Inferred:       true,
+		},
+	}}
+
+	code, body, _, err := makeRequest[[]models.WorkloadItem](getWorkloadRouter(querier), "GET", "/api/projects/1/iterations/2/workload?label=-wontfix&priority=0", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, db.GetIterationWorkloadParams{
+		IterationID:   2,
+		ExcludeLabels: []string{"wontfix"},
+		Priorities:    []int32{0},
+	}, querier.GetIterationWorkloadParams)
+	assert.Equal(t, []models.WorkloadItem{
+		{Assignee: "octocat", Committed: 8, Completed: 3, RemainingHours: 12},
+		{Unassigned: true, Committed: 2, Completed: 0, RemainingHours: 4, Inferred: true},
+	}, *body)
+}
+
+func TestGetWorkloadInvalidFilter(t *testing.T) {
+	code, _, _, err := makeRequest[models.ErrorResult](getWorkloadRouter(&MockQuerier{}), "GET", "/api/projects/1/iterations/2/workload?types=Epic", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, code)
+}
+
+func TestGetWorkloadError(t *testing.T) {
+	querier := &MockQuerier{GetIterationWorkloadError: fmt.Errorf("error")}
+
+	code, _, _, err := makeRequest[models.ErrorResult](getWorkloadRouter(querier), "GET", "/api/projects/1/iterations/2/workload", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 500, code)
+}
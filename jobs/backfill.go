@@ -0,0 +1,251 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+type timelineEventKind int
+
+const (
+	closedEvent timelineEventKind = iota
+	reopenedEvent
+	labeledEvent
+	unlabeledEvent
+)
+
+type timelineEvent struct {
+	kind      timelineEventKind
+	createdAt time.Time
+	label     string
+}
+
+type inferredSnapshot struct {
+	day   time.Time
+	issue models.Issue
+}
+
+type itemTimeline struct {
+	addedAt time.Time
+	events  []timelineEvent
+}
+
+// backfillProject reconstructs daily snapshots for the days before the first
+// pull of a project. GitHub does not expose the change history of project
+// field values, so only the open state and labels are replayed from the issue
+// timeline while the remaining fields keep their current values.
+func (c *DataPullJob) backfillProject(ctx context.Context, project models.JobConfigItem, parsedProject *models.Project) error {
+	slog.Info("Backfilling project history", "project", project.GetUniqueName(), "days", project.BackfillDays)
+
+	timelines, err := c.getProjectTimelines(ctx, project)
+	if err != nil {
+		slog.Error("Error fetching project timeline", "error", err)
+		return err
+	}
+
+	today := project.Today(time.Now())
+	from := today.AddDate(0, 0, -project.BackfillDays)
+
+	return c.queries.ExecTx(ctx, func(queries db.Querier) error {
+		structure, err := saveProjectStructure(ctx, queries, parsedProject)
+		if err != nil {
+			return err
+		}
+
+		lookups := newWorkItemLookups()
+		for _, issue := range parsedProject.Issues {
+			timeline, ok := timelines[issue.Id]
+			if !ok {
+				timeline = &itemTimeline{addedAt: issue.CreatedAt}
+			}
+
+			for _, snapshot := range inferSnapshots(project, issue, timeline, parsedProject.Statuses, from, today) {
+				err := saveWorkItemSnapshot(ctx, queries, structure, &snapshot.issue, snapshot.day, true, lookups)
+
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (c *DataPullJob) getProjectTimelines(ctx context.Context, project models.JobConfigItem) (map[string]*itemTimeline, error) {
+	projectId, _ := strconv.Atoi(project.Project)
+	graphqlClient := c.graphqlClient(project)
+	result := make(map[string]*itemTimeline)
+	hasNextPage := true
+	cursor := ""
+
+	for hasNextPage {
+		var items ProjectItemsTimelineItemsProjectV2ItemConnection
+
+		if project.OrgName != "" {
+			response, err := getOrganizationProjectTimeline(ctx, graphqlClient, project.OrgName, projectId, cursor)
+			if err != nil {
+				return nil, err
+			}
+
+			items = response.Organization.ProjectV2.Items
+		} else {
+			response, err := getRepositoryProjectTimeline(ctx, graphqlClient, project.RepoOwner, project.RepoName, projectId, cursor)
+			if err != nil {
+				return nil, err
+			}
+
+			items = response.Repository.ProjectV2.Items
+		}
+
+		for _, item := range items.Nodes {
+			result[item.Id] = &itemTimeline{
+				addedAt: item.CreatedAt,
+				events:  parseTimelineEvents(item.Content),
+			}
+		}
+
+		hasNextPage = items.PageInfo.HasNextPage
+		cursor = items.PageInfo.EndCursor
+	}
+
+	return result, nil
+}
+
+func parseTimelineEvents(content ProjectItemTimelineContentProjectV2ItemContent) []timelineEvent {
+	result := []timelineEvent{}
+
+	switch content := content.(type) {
+	case *ProjectItemTimelineContentIssue:
+		for _, node := range content.TimelineItems.Nodes {
+			switch event := node.(type) {
+			case *IssueTimelineEventClosedEvent:
+				result = append(result, timelineEvent{kind: closedEvent, createdAt: event.CreatedAt})
+			case *IssueTimelineEventReopenedEvent:
+				result = append(result, timelineEvent{kind: reopenedEvent, createdAt: event.CreatedAt})
+			case *IssueTimelineEventLabeledEvent:
+				result = append(result, timelineEvent{kind: labeledEvent, createdAt: event.CreatedAt, label: event.Label.Name})
+			case *IssueTimelineEventUnlabeledEvent:
+				result = append(result, timelineEvent{kind: unlabeledEvent, createdAt: event.CreatedAt, label: event.Label.Name})
+			}
+		}
+	case *ProjectItemTimelineContentPullRequest:
+		for _, node := range content.TimelineItems.Nodes {
+			switch event := node.(type) {
+			case *PullRequestTimelineEventClosedEvent:
+				result = append(result, timelineEvent{kind: closedEvent, createdAt: event.CreatedAt})
+			case *PullRequestTimelineEventReopenedEvent:
+				result = append(result, timelineEvent{kind: reopenedEvent, createdAt: event.CreatedAt})
+			case *PullRequestTimelineEventLabeledEvent:
+				result = append(result, timelineEvent{kind: labeledEvent, createdAt: event.CreatedAt, label: event.Label.Name})
+			case *PullRequestTimelineEventUnlabeledEvent:
+				result = append(result, timelineEvent{kind: unlabeledEvent, createdAt: event.CreatedAt, label: event.Label.Name})
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a timelineEvent, b timelineEvent) int {
+		return a.createdAt.Compare(b.createdAt)
+	})
+
+	return result
+}
+
+// inferSnapshots returns the state of the issue at the end of each day of the
+// project from the day it was added to the project, but no earlier than from,
+// until the day before to.
+func inferSnapshots(project models.JobConfigItem, issue models.Issue, timeline *itemTimeline, statuses []string, from time.Time, to time.Time) []inferredSnapshot {
+	result := []inferredSnapshot{}
+	location := project.Location()
+	addedDay := project.Today(timeline.addedAt)
+	if addedDay.After(from) {
+		from = addedDay
+	}
+
+	initialLabels := getInitialLabels(issue.Labels, timeline.events)
+	hasStateEvents := slices.ContainsFunc(timeline.events, func(event timelineEvent) bool {
+		return event.kind == closedEvent || event.kind == reopenedEvent
+	})
+
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		// days are saved as midnight UTC but end at midnight of the project
+		dayEnd := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location)
+		labels := slices.Clone(initialLabels)
+		closed := false
+
+		for _, event := range timeline.events {
+			if !event.createdAt.Before(dayEnd) {
+				break
+			}
+
+			switch event.kind {
+			case closedEvent:
+				closed = true
+			case reopenedEvent:
+				closed = false
+			case labeledEvent:
+				if !slices.Contains(labels, event.label) {
+					labels = append(labels, event.label)
+				}
+			case unlabeledEvent:
+				labels = slices.DeleteFunc(labels, func(label string) bool { return label == event.label })
+			}
+		}
+
+		// draft issues and issues with truncated timelines only have dates
+		if !hasStateEvents {
+			closed = !issue.ClosedAt.IsZero() && issue.ClosedAt.Before(dayEnd)
+		}
+
+		snapshot := issue
+		snapshot.Labels = labels
+		snapshot.Status = inferStatus(issue, closed, statuses)
+		result = append(result, inferredSnapshot{day: day, issue: snapshot})
+	}
+
+	return result
+}
+
+// getInitialLabels undoes the label events, newest first, to find the labels
+// the issue had when it was created.
+func getInitialLabels(current []string, events []timelineEvent) []string {
+	labels := slices.Clone(current)
+
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+
+		switch event.kind {
+		case labeledEvent:
+			labels = slices.DeleteFunc(labels, func(label string) bool { return label == event.label })
+		case unlabeledEvent:
+			if !slices.Contains(labels, event.label) {
+				labels = append(labels, event.label)
+			}
+		}
+	}
+
+	return labels
+}
+
+// inferStatus keeps the current status while the issue was in its current
+// open state, otherwise it assumes the first status option for open issues
+// and the last one for closed issues, e.g. Todo and Done.
+func inferStatus(issue models.Issue, closed bool, statuses []string) string {
+	currentlyClosed := !issue.ClosedAt.IsZero()
+
+	if closed == currentlyClosed || len(statuses) == 0 {
+		return issue.Status
+	}
+
+	if closed {
+		return statuses[len(statuses)-1]
+	}
+
+	return statuses[0]
+}
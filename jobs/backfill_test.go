@@ -0,0 +1,166 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphqlTimelineClient struct {
+	mockGraphqlOrgClient
+	timeline []ProjectItemTimeline
+	requests int
+}
+
+func (m *mockGraphqlTimelineClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if timelineResponse, ok := resp.Data.(*getOrganizationProjectTimelineResponse); ok {
+		m.requests++
+		timelineResponse.Organization.ProjectV2.Items.Nodes = m.timeline
+		return nil
+	}
+
+	return m.mockGraphqlOrgClient.MakeRequest(ctx, req, resp)
+}
+
+func date(value string) time.Time {
+	result, _ := time.Parse("2006-01-02T15:04", value)
+	return result
+}
+
+func TestInferSnapshotsReplaysTimeline(t *testing.T) {
+	issue := models.Issue{
+		Id:       "1",
+		Status:   "Done",
+		Labels:   []string{"bug"},
+		ClosedAt: date("2024-01-04T10:00"),
+	}
+	timeline := &itemTimeline{
+		addedAt: date("2024-01-02T09:00"),
+		events: []timelineEvent{
+			{kind: labeledEvent, createdAt: date("2024-01-03T08:00"), label: "bug"},
+			{kind: unlabeledEvent, createdAt: date("2024-01-03T09:00"), label: "feature"},
+			{kind: closedEvent, createdAt: date("2024-01-04T10:00")},
+		},
+	}
+
+	snapshots := inferSnapshots(models.JobConfigItem{}, issue, timeline, []string{"Todo", "In Progress", "Done"}, date("2024-01-01T00:00"), date("2024-01-05T00:00"))
+
+	assert.Len(t, snapshots, 3)
+	assert.Equal(t, date("2024-01-02T00:00"), snapshots[0].day)
+	assert.Equal(t, []string{"feature"}, snapshots[0].issue.Labels)
+	assert.Equal(t, "Todo", snapshots[0].issue.Status)
+	assert.Equal(t, []string{"bug"}, snapshots[1].issue.Labels)
+	assert.Equal(t, "Todo", snapshots[1].issue.Status)
+	assert.Equal(t, "Done", snapshots[2].issue.Status)
+}
+
+func TestInferSnapshotsFallsBackToDates(t *testing.T) {
+	issue := models.Issue{
+		Id:        "1",
+		Status:    "In Progress",
+		CreatedAt: date("2024-01-01T12:00"),
+	}
+	timeline := &itemTimeline{addedAt: issue.CreatedAt}
+
+	snapshots := inferSnapshots(models.JobConfigItem{}, issue, timeline, []string{"Todo", "In Progress", "Done"}, date("2024-01-02T00:00"), date("2024-01-04T00:00"))
+
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, date("2024-01-02T00:00"), snapshots[0].day)
+	assert.Equal(t, "In Progress", snapshots[0].issue.Status)
+	assert.Equal(t, "In Progress", snapshots[1].issue.Status)
+}
+
+func TestInferSnapshotsReopenedIssue(t *testing.T) {
+	issue := models.Issue{
+		Id:     "1",
+		Status: "In Progress",
+	}
+	timeline := &itemTimeline{
+		addedAt: date("2024-01-01T00:00"),
+		events: []timelineEvent{
+			{kind: closedEvent, createdAt: date("2024-01-01T10:00")},
+			{kind: reopenedEvent, createdAt: date("2024-01-02T10:00")},
+		},
+	}
+
+	snapshots := inferSnapshots(models.JobConfigItem{}, issue, timeline, []string{"Todo", "In Progress", "Done"}, date("2024-01-01T00:00"), date("2024-01-03T00:00"))
+
+	assert.Equal(t, "Done", snapshots[0].issue.Status)
+	assert.Equal(t, "In Progress", snapshots[1].issue.Status)
+}
+
+func TestInferSnapshotsProjectTimeZone(t *testing.T) {
+	issue := models.Issue{
+		Id:     "1",
+		Status: "In Progress",
+	}
+	// 2024-01-02 01:00 in Kiritimati, a day ahead of UTC
+	timeline := &itemTimeline{
+		addedAt: date("2024-01-01T11:00"),
+		events: []timelineEvent{
+			{kind: closedEvent, createdAt: date("2024-01-02T11:00")},
+		},
+	}
+
+	snapshots := inferSnapshots(models.JobConfigItem{TimeZone: "Pacific/Kiritimati"}, issue, timeline, []string{"Todo", "In Progress", "Done"}, date("2024-01-01T00:00"), date("2024-01-04T00:00"))
+
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, date("2024-01-02T00:00"), snapshots[0].day)
+	assert.Equal(t, "In Progress", snapshots[0].issue.Status)
+	assert.Equal(t, date("2024-01-03T00:00"), snapshots[1].day)
+	assert.Equal(t, "Done", snapshots[1].issue.Status)
+}
+
+func getBackfillTestJob(t *testing.T, querier *MockQuerier) (*DataPullJob, *mockGraphqlTimelineClient) {
+	dataPullJob, client := newProjectTestJob(t, querier, []ProjectItem{getIssueTestItem("item")}, func(projectClient mockGraphqlOrgClient) *mockGraphqlTimelineClient {
+		return &mockGraphqlTimelineClient{
+			mockGraphqlOrgClient: projectClient,
+			timeline: []ProjectItemTimeline{
+				{Id: "item", CreatedAt: time.Now().AddDate(0, 0, -10)},
+			},
+		}
+	})
+	dataPullJob.projects[0].BackfillDays = 3
+
+	return dataPullJob, client
+}
+
+func TestExecuteWillBackfillNewProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := getBackfillTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	assert.Equal(t, 1, client.requests)
+	assert.Equal(t, "1", querier.GetProjectFirstChangeDateValue)
+	assert.Len(t, querier.UpsertWorkItemsValue, 4)
+	for index, workItem := range querier.UpsertWorkItemsValue[:3] {
+		assert.True(t, workItem.Inferred)
+		assert.Equal(t, today.AddDate(0, 0, index-3), workItem.ChangeDate.Time)
+	}
+	assert.False(t, querier.UpsertWorkItemsValue[3].Inferred)
+	assert.Equal(t, today, querier.UpsertWorkItemsValue[3].ChangeDate.Time)
+}
+
+func TestExecuteWillNotBackfillPulledProject(t *testing.T) {
+	querier := &MockQuerier{
+		GetProjectFirstChangeDateResult: pgtype.Date{Time: time.Now(), Valid: true},
+	}
+	dataPullJob, client := getBackfillTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, 0, client.requests)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+	assert.False(t, querier.UpsertWorkItemsValue[0].Inferred)
+}
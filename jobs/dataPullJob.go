@@ -2,40 +2,78 @@ package jobs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
-	"strconv"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/adhocore/gronx"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jlucaspains/github-charts/db"
 	"github.com/jlucaspains/github-charts/models"
 )
 
+const labelsPerIssueCount = 5
+
+const assigneesPerIssueCount = 10
+
+const (
+	DefaultPullConcurrency  = 4
+	DefaultPullTimeout      = 10 * time.Minute
+	DefaultFullSyncInterval = 24 * time.Hour
+)
+
 type DataPullJob struct {
 	cron           string
 	ticker         *time.Ticker
 	gron           *gronx.Gronx
 	running        bool
-	queries        db.Querier
+	queries        db.TxQuerier
 	projects       []models.JobConfigItem
 	graphqlClients map[string]graphql.Client
+	newClient      func(project models.JobConfigItem) (graphql.Client, error)
+	concurrency    int
+	pullTimeout    time.Duration
+
+	fullSyncInterval time.Duration
+
+	registry           *projectRegistry
+	registeredProjects []models.JobConfigItem
+	discoveredProjects map[string][]models.JobConfigItem
+	reloadMutex        sync.Mutex
+
+	mutex           sync.Mutex
+	syncs           map[string]*models.Sync
+	syncOrder       []string
+	runningProjects map[string]string
+	projectNames    map[int32]string
+	projectGhIds    map[string]string
+	deliveries      map[string]bool
+	deliveryOrder   []string
 }
 
 type authedTransport struct {
-	key     string
+	tokens  tokenSource
 	wrapped http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "bearer "+t.key)
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "bearer "+token)
 	return t.wrapped.RoundTrip(req)
 }
 
-func NewDataPullJob(schedule string, queries db.Querier, projects []models.JobConfigItem) (*DataPullJob, error) {
+func NewDataPullJob(schedule string, queries db.TxQuerier, projects []models.JobConfigItem) (*DataPullJob, error) {
 	c := &DataPullJob{}
 	c.gron = gronx.New()
 
@@ -48,6 +86,14 @@ func NewDataPullJob(schedule string, queries db.Querier, projects []models.JobCo
 	c.projects = projects
 	c.ticker = time.NewTicker(time.Minute)
 	c.queries = queries
+	c.concurrency = DefaultPullConcurrency
+	c.pullTimeout = DefaultPullTimeout
+	c.fullSyncInterval = DefaultFullSyncInterval
+	c.syncs = make(map[string]*models.Sync)
+	c.runningProjects = make(map[string]string)
+	c.projectNames = make(map[int32]string)
+	c.projectGhIds = make(map[string]string)
+	c.deliveries = make(map[string]bool)
 
 	slog.Info("Init DataPullJob job")
 
@@ -55,21 +101,211 @@ func NewDataPullJob(schedule string, queries db.Querier, projects []models.JobCo
 		slog.Info("Project configuration", "index", index, "repoOwner", item.RepoOwner, "repoName", item.RepoName, "orgName", item.OrgName, "projectId", item.Project)
 	}
 
+	c.newClient = newGraphqlClient
 	c.graphqlClients = make(map[string]graphql.Client)
 	for _, project := range projects {
-		httpClient := http.Client{
-			Transport: &authedTransport{
-				key:     project.Token,
-				wrapped: http.DefaultTransport,
-			},
+		graphqlClient, err := c.newClient(project)
+		if err != nil {
+			return nil, err
 		}
-		graphqlClient := graphql.NewClient("https://api.github.com/graphql", &httpClient)
+
 		c.graphqlClients[project.GetUniqueName()] = graphqlClient
 	}
 
 	return c, nil
 }
 
+func newGraphqlClient(project models.JobConfigItem) (graphql.Client, error) {
+	transport, err := newHttpTransport(project.Connection)
+	if err != nil {
+		slog.Error("Invalid project connection", "project", project.GetUniqueName(), "error", err)
+		return nil, err
+	}
+
+	tokens, err := newTokenSource(project, &http.Client{Transport: transport})
+	if err != nil {
+		slog.Error("Invalid project credentials", "project", project.GetUniqueName(), "error", err)
+		return nil, err
+	}
+
+	httpClient := http.Client{
+		Transport: newRateLimitTransport(project.GetUniqueName(), &authedTransport{
+			tokens:  tokens,
+			wrapped: transport,
+		}),
+	}
+
+	return graphql.NewClient(getGraphqlUrl(project.Connection), &httpClient), nil
+}
+
+// SetProjects replaces the configured projects, e.g. when the configuration
+// file changes.
+func (c *DataPullJob) SetProjects(projects []models.JobConfigItem) error {
+	c.reloadMutex.Lock()
+	defer c.reloadMutex.Unlock()
+
+	c.mutex.Lock()
+	registered := c.registeredProjects
+	discovered := c.discoveredProjects
+	c.mutex.Unlock()
+
+	return c.replaceProjects(projects, registered, discovered)
+}
+
+// replaceProjects swaps the configured, registered and discovered projects.
+// Clients of unchanged projects are reused, and pulls already running finish
+// with the settings they started with. An invalid configured project fails
+// the whole change while an invalid registered or discovered project is only
+// skipped. Configured projects take precedence over registered ones, and
+// both over discovered ones.
+func (c *DataPullJob) replaceProjects(configured []models.JobConfigItem, registered []models.JobConfigItem, discovered map[string][]models.JobConfigItem) error {
+	c.mutex.Lock()
+	current := c.allProjects()
+	currentClients := c.graphqlClients
+	c.mutex.Unlock()
+
+	clients := make(map[string]graphql.Client)
+	for _, project := range configured {
+		graphqlClient, err := c.reuseOrCreateClient(project, current, currentClients)
+		if err != nil {
+			return err
+		}
+
+		clients[project.GetUniqueName()] = graphqlClient
+	}
+
+	addOptional := func(projects []models.JobConfigItem, source string) []models.JobConfigItem {
+		result := []models.JobConfigItem{}
+		for _, project := range projects {
+			name := project.GetUniqueName()
+			if _, ok := clients[name]; ok {
+				slog.Warn("Skipping project that is already configured", "project", name, "source", source)
+				continue
+			}
+
+			graphqlClient, err := c.reuseOrCreateClient(project, current, currentClients)
+			if err != nil {
+				continue
+			}
+
+			clients[name] = graphqlClient
+			result = append(result, project)
+		}
+
+		return result
+	}
+
+	pulledRegistered := addOptional(registered, "registration")
+
+	pulledDiscovered := make(map[string][]models.JobConfigItem)
+	for _, project := range configured {
+		name := project.GetUniqueName()
+		if projects, ok := discovered[name]; ok && project.Discover {
+			pulledDiscovered[name] = addOptional(projects, name)
+		}
+	}
+
+	for name, graphqlClient := range currentClients {
+		if _, ok := clients[name]; ok {
+			continue
+		}
+
+		if slices.ContainsFunc(current, func(project models.JobConfigItem) bool {
+			return project.GetUniqueName() == name
+		}) {
+			slog.Info("Project configuration removed", "project", name)
+		}
+
+		// kept for the pulls of the removed project still running
+		clients[name] = graphqlClient
+	}
+
+	c.mutex.Lock()
+	c.projects = configured
+	c.registeredProjects = pulledRegistered
+	c.discoveredProjects = pulledDiscovered
+	c.graphqlClients = clients
+	c.mutex.Unlock()
+
+	return nil
+}
+
+func (c *DataPullJob) reuseOrCreateClient(project models.JobConfigItem, current []models.JobConfigItem, currentClients map[string]graphql.Client) (graphql.Client, error) {
+	name := project.GetUniqueName()
+	if graphqlClient, ok := currentClients[name]; ok && slices.Contains(current, project) {
+		return graphqlClient, nil
+	}
+
+	graphqlClient, err := c.newClient(project)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Project configuration changed", "project", name)
+
+	return graphqlClient, nil
+}
+
+// getProjects returns the projects to pull with their clients.
+func (c *DataPullJob) getProjects() ([]models.JobConfigItem, map[string]graphql.Client) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.pulledProjects(), c.graphqlClients
+}
+
+// pulledProjects returns the configured, registered and discovered projects
+// to pull. Callers must hold the mutex.
+func (c *DataPullJob) pulledProjects() []models.JobConfigItem {
+	result := []models.JobConfigItem{}
+	for _, project := range c.projects {
+		if !project.Discover {
+			result = append(result, project)
+		}
+	}
+
+	result = append(result, c.registeredProjects...)
+
+	for _, project := range c.projects {
+		result = append(result, c.discoveredProjects[project.GetUniqueName()]...)
+	}
+
+	return result
+}
+
+// allProjects returns every known project including the organizations
+// configured for discovery. Callers must hold the mutex.
+func (c *DataPullJob) allProjects() []models.JobConfigItem {
+	result := slices.Concat(c.projects, c.registeredProjects)
+	for _, projects := range c.discoveredProjects {
+		result = append(result, projects...)
+	}
+
+	return result
+}
+
+func newTokenSource(project models.JobConfigItem, httpClient *http.Client) (tokenSource, error) {
+	if !project.UsesGitHubApp() {
+		return staticTokenSource(project.Token), nil
+	}
+
+	return newAppTokenSource(project.AppId, project.AppInstallationId, project.AppPrivateKeyFile, getApiUrl(project.Connection), httpClient)
+}
+
+// SetPullLimits sets how many projects are pulled at the same time and how
+// long a single project pull may take before it is cancelled.
+func (c *DataPullJob) SetPullLimits(concurrency int, timeout time.Duration) {
+	c.concurrency = concurrency
+	c.pullTimeout = timeout
+}
+
+// SetFullSyncInterval sets how often every item of a project is pulled again.
+// Pulls in between only fetch the items updated since the previous pull, and
+// an interval of 0 pulls every item each time.
+func (c *DataPullJob) SetFullSyncInterval(interval time.Duration) {
+	c.fullSyncInterval = interval
+}
+
 func (c *DataPullJob) Start() {
 	c.running = true
 	slog.Info("Started DataPullJob job", "cron", c.cron)
@@ -89,61 +325,241 @@ func (c *DataPullJob) Stop() {
 	}
 }
 
-func (c *DataPullJob) tryExecute() {
+func (c *DataPullJob) tryExecute() *models.Sync {
 	due, _ := c.gron.IsDue(c.cron, time.Now().Truncate(time.Minute))
 
 	slog.Info("tryExecute job", "isDue", due)
 
-	if due {
-		c.execute()
+	if !due {
+		return nil
 	}
+
+	return c.execute()
 }
 
-func (c *DataPullJob) execute() {
-	for _, project := range c.projects {
-		projectId, _ := strconv.Atoi(project.Project)
-		var projectFields *ProjectFields
-		var err error
-		if project.OrgName != "" {
-			slog.Info("Data pull job started", "orgName", project.OrgName, "projectId", projectId)
-			var orgProject *getOrganizationProjectResponse
-			orgProject, err = getOrgProject(c.graphqlClients[project.GetUniqueName()], project.OrgName, projectId)
+// execute queues a scheduled sync and runs it in the background so that a
+// slow project does not hold the ticker, returning the queued sync.
+func (c *DataPullJob) execute() *models.Sync {
+	c.refreshProjects()
 
-			if err == nil {
-				projectFields = &orgProject.Organization.ProjectV2.ProjectFields
-			}
-		} else {
-			slog.Info("Data pull job started", "repoOwner", project.RepoOwner, "repoName", project.RepoName, "projectId", projectId)
-			var repoProject *getRepositoryProjectResponse
-			repoProject, err = getRepoProject(c.graphqlClients[project.GetUniqueName()], project.RepoOwner, project.RepoName, projectId)
+	sync, projects, err := c.queueScheduledSync()
+	if err != nil {
+		slog.Error("Unable to queue scheduled sync", "error", err)
+		return nil
+	}
+
+	go c.runSync(sync, projects)
+
+	return sync
+}
+
+// pullProject fetches a project from GitHub and saves it, returning the
+// database id of the project and the number of items saved.
+func (c *DataPullJob) pullProject(ctx context.Context, project models.JobConfigItem) (int32, int, error) {
+	now := time.Now().UTC()
+	pull, err := c.getProjectPull(ctx, project, now)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pull.project.TimeZone = project.Location().String()
+	pull.project.Rollup = project.Rollup
+	if project.Intraday {
+		pull.capturedAt = now.Truncate(time.Minute)
+	}
 
-			if err == nil {
-				projectFields = &repoProject.Repository.ProjectV2.ProjectFields
+	if project.BackfillDays > 0 {
+		firstChangeDate, err := c.queries.GetProjectFirstChangeDate(ctx, pull.project.Id)
+		if err != nil {
+			slog.Error("Error on GetProjectFirstChangeDate", "error", err)
+			return 0, 0, err
+		}
+
+		// backfill before saving today's snapshots so that a failed backfill
+		// is retried by the next pull
+		if !firstChangeDate.Valid {
+			if err := c.backfillProject(ctx, project, pull.project); err != nil {
+				return 0, 0, err
 			}
 		}
+	}
 
-		if err == nil {
-			project := parseProjectInformation(projectFields)
-			saveProjectInformation(project, c.queries)
-		} else {
-			slog.Error("Error fetching project information", "error", err)
+	today := project.Today(now)
+	changes, err := c.getScopeChanges(ctx, project, pull, today)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dbProjectId, err := saveProjectInformation(ctx, pull, changes, today, c.queries)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	c.trackProject(project, pull.project.Id, dbProjectId)
+
+	return dbProjectId, pull.itemCount(), nil
+}
+
+// trackProject remembers how a configured project is identified in the
+// database and on GitHub so that syncs and webhooks can find it.
+func (c *DataPullJob) trackProject(project models.JobConfigItem, ghId string, dbId int32) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.projectNames[dbId] = project.GetUniqueName()
+	c.projectGhIds[ghId] = project.GetUniqueName()
+}
+
+// findTrackedProject looks up the unique name of a tracked project. Projects
+// not pulled since startup are read from the database, where the sync runs
+// remember which configured project each saved project belongs to.
+func (c *DataPullJob) findTrackedProject(ctx context.Context, find func() (string, bool)) (string, bool) {
+	c.mutex.Lock()
+	name, ok := find()
+	c.mutex.Unlock()
+
+	if ok {
+		return name, true
+	}
+
+	rows, err := c.queries.GetTrackedProjects(ctx)
+	if err != nil {
+		slog.Error("Error on GetTrackedProjects", "error", err)
+		return "", false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, row := range rows {
+		// pulls since startup are more recent than the database
+		if _, ok := c.projectNames[row.ID]; !ok {
+			c.projectNames[row.ID] = row.ProjectName
+		}
+
+		if _, ok := c.projectGhIds[row.GhID]; !ok {
+			c.projectGhIds[row.GhID] = row.ProjectName
+		}
+	}
+
+	return find()
+}
+
+// saveProjectInformation saves the snapshot of the project for the day with
+// its scope changes and sync state in a single transaction so that a failed
+// pull never leaves a partial snapshot behind.
+func saveProjectInformation(ctx context.Context, pull *projectPull, changes []scopeChange, today time.Time, queries db.TxQuerier) (int32, error) {
+	var dbProjectId int32
+
+	err := queries.ExecTx(ctx, func(queries db.Querier) error {
+		var err error
+		dbProjectId, err = saveProjectSnapshot(ctx, queries, pull.project, today)
+		if err != nil {
+			return err
+		}
+
+		err = carryForwardWorkItems(ctx, queries, dbProjectId, pull.carriedItemIds, today)
+		if err != nil {
+			return err
+		}
+
+		err = saveScopeChanges(ctx, queries, dbProjectId, changes, today)
+		if err != nil {
+			return err
+		}
+
+		err = saveIntradaySnapshot(ctx, queries, dbProjectId, pull, today)
+		if err != nil {
+			return err
 		}
+
+		return saveProjectSyncState(ctx, queries, pull.syncState)
+	})
+
+	if err != nil {
+		slog.Error("Error saving project snapshot, changes were rolled back", "project", pull.project.Title, "error", err)
+		return 0, err
 	}
+
+	return dbProjectId, nil
 }
 
-func saveProjectInformation(project *models.Project, queries db.Querier) error {
-	ctx := context.Background()
+func saveProjectSnapshot(ctx context.Context, queries db.Querier, project *models.Project, today time.Time) (int32, error) {
+	structure, err := saveProjectStructure(ctx, queries, project)
+
+	if err != nil {
+		return 0, err
+	}
+
+	lookups := newWorkItemLookups()
+	for _, issue := range project.Issues {
+		err := saveWorkItemSnapshot(ctx, queries, structure, &issue, today, false, lookups)
+
+		if err != nil {
+			return 0, err
+		}
+
+		err = queries.UpsertWorkItemDates(ctx, db.UpsertWorkItemDatesParams{
+			GhID:      issue.Id,
+			Name:      issue.Title,
+			CreatedAt: pgtype.Timestamp{Time: issue.CreatedAt, Valid: !issue.CreatedAt.IsZero()},
+			ClosedAt:  pgtype.Timestamp{Time: issue.ClosedAt, Valid: !issue.ClosedAt.IsZero()},
+			ProjectID: structure.projectId,
+		})
+
+		if err != nil {
+			slog.Error("Error on UpsertWorkItemDates", "error", err)
+			return 0, err
+		}
+
+		if issue.Status == "" {
+			continue
+		}
+
+		err = queries.UpsertWorkItemStatusChange(ctx, db.UpsertWorkItemStatusChangeParams{
+			GhID:        issue.Id,
+			Status:      issue.Status,
+			EnteredDate: pgtype.Date{Time: today, Valid: true},
+		})
+
+		if err != nil {
+			slog.Error("Error on UpsertWorkItemStatusChange", "error", err)
+			return 0, err
+		}
+	}
+
+	return structure.projectId, nil
+}
+
+// projectStructure holds the database ids of a project and of its iterations
+// and milestones by GitHub id.
+type projectStructure struct {
+	projectId  int32
+	iterations map[string]int32
+	milestones map[string]int32
+}
+
+// saveProjectStructure saves the project with its iterations, milestones and
+// statuses, returning their database ids.
+func saveProjectStructure(ctx context.Context, queries db.Querier, project *models.Project) (*projectStructure, error) {
 	dbProject, err := queries.UpsertProject(ctx, db.UpsertProjectParams{
-		GhID: project.Id,
-		Name: project.Title,
+		GhID:     project.Id,
+		Name:     project.Title,
+		TimeZone: pgtype.Text{String: project.TimeZone, Valid: project.TimeZone != ""},
+		Rollup:   pgtype.Text{String: string(project.Rollup), Valid: true},
 	})
 
 	if err != nil {
 		slog.Error("Error on UpsertProject", "error", err)
-		return err
+		return nil, err
+	}
+
+	structure := &projectStructure{
+		projectId:  dbProject.ID,
+		iterations: make(map[string]int32),
+		milestones: make(map[string]int32),
 	}
 
-	iterationsMap := make(map[string]int32)
 	for _, iteration := range project.Iterations {
 		dbIteration, err := queries.UpsertIteration(ctx, db.UpsertIterationParams{
 			GhID:      iteration.Id,
@@ -153,12 +569,35 @@ func saveProjectInformation(project *models.Project, queries db.Querier) error {
 			EndDate:   pgtype.Date{Time: iteration.EndDate, Valid: true},
 		})
 
-		iterationsMap[iteration.Id] = dbIteration.ID
+		structure.iterations[iteration.Id] = dbIteration.ID
 
 		if err != nil {
 			slog.Error("Error on UpsertIteration", "error", err)
-			return err
+			return nil, err
+		}
+	}
+
+	for _, milestone := range project.Milestones {
+		dueDate := pgtype.Date{}
+		if milestone.DueDate != nil {
+			dueDate = pgtype.Date{Time: *milestone.DueDate, Valid: true}
+		}
+
+		dbMilestone, err := queries.UpsertMilestone(ctx, db.UpsertMilestoneParams{
+			GhID:      milestone.Id,
+			Name:      milestone.Title,
+			State:     milestone.State,
+			StartDate: pgtype.Date{Time: milestone.StartDate, Valid: !milestone.StartDate.IsZero()},
+			DueDate:   dueDate,
+			ProjectID: dbProject.ID,
+		})
+
+		if err != nil {
+			slog.Error("Error on UpsertMilestone", "error", err)
+			return nil, err
 		}
+
+		structure.milestones[milestone.Id] = dbMilestone.ID
 	}
 
 	for _, status := range project.Statuses {
@@ -166,28 +605,142 @@ func saveProjectInformation(project *models.Project, queries db.Querier) error {
 
 		if err != nil {
 			slog.Error("Error on UpserWorkItemStatus", "error", err)
+			return nil, err
+		}
+	}
+
+	return structure, nil
+}
+
+// workItemLookups caches the database ids of the labels and assignees saved
+// with the work item snapshots of a pull by name.
+type workItemLookups struct {
+	labels    map[string]int32
+	assignees map[string]int32
+}
+
+func newWorkItemLookups() *workItemLookups {
+	return &workItemLookups{
+		labels:    make(map[string]int32),
+		assignees: make(map[string]int32),
+	}
+}
+
+// saveWorkItemSnapshot saves the state of an issue on the given day. Inferred
+// snapshots are skipped when a snapshot pulled from GitHub already exists.
+func saveWorkItemSnapshot(ctx context.Context, queries db.Querier, structure *projectStructure, issue *models.Issue, day time.Time, inferred bool, lookups *workItemLookups) error {
+	iterationId, iterationIdOk := structure.iterations[issue.IterationId]
+	milestoneId, milestoneIdOk := structure.milestones[issue.MilestoneId]
+
+	priority := pgtype.Int4{}
+	if issue.Priority != nil {
+		priority = pgtype.Int4{Int32: int32(*issue.Priority), Valid: true}
+	}
+
+	dbWorkItem, err := queries.UpsertWorkItem(ctx, db.UpsertWorkItemParams{
+		GhID:           issue.Id,
+		ChangeDate:     pgtype.Date{Time: day, Valid: true},
+		Name:           issue.Title,
+		Effort:         pgtype.Int4{Int32: int32(issue.Effort), Valid: true},
+		RemainingHours: pgtype.Int4{Int32: int32(issue.RemainingHours), Valid: true},
+		Status:         pgtype.Text{String: issue.Status, Valid: true},
+		Priority:       priority,
+		ContentType:    issue.Type,
+		IterationID:    pgtype.Int4{Int32: iterationId, Valid: iterationIdOk},
+		MilestoneID:    pgtype.Int4{Int32: milestoneId, Valid: milestoneIdOk},
+		ParentGhID:     pgtype.Text{String: issue.ParentId, Valid: issue.ParentId != ""},
+		RootGhID:       pgtype.Text{String: issue.RootId, Valid: issue.RootId != ""},
+		ProjectID:      structure.projectId,
+		Inferred:       inferred,
+	})
+
+	if inferred && errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		slog.Error("Error on UpserWorkItem", "error", err)
+		return err
+	}
+
+	err = saveWorkItemLabels(ctx, queries, dbWorkItem.ID, issue.Labels, lookups.labels)
+	if err != nil {
+		return err
+	}
+
+	return saveWorkItemAssignees(ctx, queries, dbWorkItem.ID, issue.Assignees, lookups.assignees)
+}
+
+// saveWorkItemLabels replaces the labels of a daily work item snapshot so
+// that a second pull on the same day reflects labels removed since the first.
+func saveWorkItemLabels(ctx context.Context, queries db.Querier, workItemId int32, labels []string, labelsMap map[string]int32) error {
+	err := queries.DeleteWorkItemLabels(ctx, workItemId)
+
+	if err != nil {
+		slog.Error("Error on DeleteWorkItemLabels", "error", err)
+		return err
+	}
+
+	for _, label := range labels {
+		labelId, ok := labelsMap[label]
+
+		if !ok {
+			dbLabel, err := queries.UpsertLabel(ctx, label)
+
+			if err != nil {
+				slog.Error("Error on UpsertLabel", "error", err)
+				return err
+			}
+
+			labelId = dbLabel.ID
+			labelsMap[label] = labelId
+		}
+
+		err = queries.InsertWorkItemLabel(ctx, db.InsertWorkItemLabelParams{
+			WorkItemHistoryID: workItemId,
+			LabelID:           labelId,
+		})
+
+		if err != nil {
+			slog.Error("Error on InsertWorkItemLabel", "error", err)
 			return err
 		}
 	}
 
-	for _, issue := range project.Issues {
-		now := time.Now().Truncate(24 * time.Hour)
-		today := now.UTC()
-		iterationId, iterationIdOk := iterationsMap[issue.IterationId]
-
-		_, err := queries.UpsertWorkItem(ctx, db.UpsertWorkItemParams{
-			GhID:           issue.Id,
-			ChangeDate:     pgtype.Date{Time: today, Valid: true},
-			Name:           issue.Title,
-			Effort:         pgtype.Int4{Int32: int32(issue.Effort), Valid: true},
-			RemainingHours: pgtype.Int4{Int32: int32(issue.RemainingHours), Valid: true},
-			Status:         pgtype.Text{String: issue.Status, Valid: true},
-			IterationID:    pgtype.Int4{Int32: iterationId, Valid: iterationIdOk},
-			ProjectID:      dbProject.ID,
+	return nil
+}
+
+// saveWorkItemAssignees replaces the assignees of a daily work item snapshot.
+func saveWorkItemAssignees(ctx context.Context, queries db.Querier, workItemId int32, assignees []string, assigneesMap map[string]int32) error {
+	err := queries.DeleteWorkItemAssignees(ctx, workItemId)
+
+	if err != nil {
+		slog.Error("Error on DeleteWorkItemAssignees", "error", err)
+		return err
+	}
+
+	for _, assignee := range assignees {
+		assigneeId, ok := assigneesMap[assignee]
+
+		if !ok {
+			dbAssignee, err := queries.UpsertAssignee(ctx, assignee)
+
+			if err != nil {
+				slog.Error("Error on UpsertAssignee", "error", err)
+				return err
+			}
+
+			assigneeId = dbAssignee.ID
+			assigneesMap[assignee] = assigneeId
+		}
+
+		err = queries.InsertWorkItemAssignee(ctx, db.InsertWorkItemAssigneeParams{
+			WorkItemHistoryID: workItemId,
+			AssigneeID:        assigneeId,
 		})
 
 		if err != nil {
-			slog.Error("Error on UpserWorkItem", "error", err)
+			slog.Error("Error on InsertWorkItemAssignee", "error", err)
 			return err
 		}
 	}
@@ -195,14 +748,14 @@ func saveProjectInformation(project *models.Project, queries db.Querier) error {
 	return nil
 }
 
-func getOrgProject(graphqlClient graphql.Client, orgName string, projectId int) (*getOrganizationProjectResponse, error) {
+func getOrgProject(ctx context.Context, graphqlClient graphql.Client, orgName string, projectId int) (*getOrganizationProjectResponse, error) {
 	hasNextPage := true
 	isFirstPage := true
 	cursor := ""
 	orgProject := &getOrganizationProjectResponse{}
 
 	for hasNextPage {
-		result, err := getOrganizationProject(context.Background(), graphqlClient, orgName, projectId, 5, cursor)
+		result, err := getOrganizationProject(ctx, graphqlClient, orgName, projectId, labelsPerIssueCount, assigneesPerIssueCount, cursor)
 		if err != nil {
 			return nil, err
 		}
@@ -221,14 +774,14 @@ func getOrgProject(graphqlClient graphql.Client, orgName string, projectId int)
 	return orgProject, nil
 }
 
-func getRepoProject(graphqlClient graphql.Client, repoOwner string, repoName string, projectId int) (*getRepositoryProjectResponse, error) {
+func getRepoProject(ctx context.Context, graphqlClient graphql.Client, repoOwner string, repoName string, projectId int) (*getRepositoryProjectResponse, error) {
 	hasNextPage := true
 	isFirstPage := true
 	cursor := ""
 	repoProject := &getRepositoryProjectResponse{}
 
 	for hasNextPage {
-		result, err := getRepositoryProject(context.Background(), graphqlClient, repoOwner, repoName, projectId, 5, cursor)
+		result, err := getRepositoryProject(ctx, graphqlClient, repoOwner, repoName, projectId, labelsPerIssueCount, assigneesPerIssueCount, cursor)
 		if err != nil {
 			return nil, err
 		}
@@ -247,22 +800,25 @@ func getRepoProject(graphqlClient graphql.Client, repoOwner string, repoName str
 	return repoProject, nil
 }
 
-func parseProjectInformation(projectFields *ProjectFields) *models.Project {
+func parseProjectInformation(projectFields *ProjectFields, mapping models.FieldMapping) (*models.Project, error) {
 	project := &models.Project{
 		Id:         projectFields.Id,
 		Title:      projectFields.Title,
 		Iterations: []models.Iteration{},
+		Milestones: []models.Milestone{},
 		Statuses:   []string{},
 	}
 
-	status := projectFields.Status.(*ProjectFieldsStatusProjectV2SingleSelectField)
+	fields, err := resolveFields(projectFields.Fields.Nodes, mapping)
+	if err != nil {
+		return nil, fmt.Errorf("project %s: %w", projectFields.Title, err)
+	}
 
-	for _, option := range status.Options {
+	for _, option := range fields.status.Options {
 		project.Statuses = append(project.Statuses, option.Name)
 	}
 
-	iteration := projectFields.Iteration.(*ProjectFieldsIterationProjectV2IterationField)
-	for _, completedIteration := range iteration.Configuration.CompletedIterations {
+	for _, completedIteration := range fields.iteration.Configuration.CompletedIterations {
 		startDate, _ := time.Parse("2006-01-02", completedIteration.StartDate)
 		project.Iterations = append(project.Iterations, models.Iteration{
 			Id:        completedIteration.Id,
@@ -271,7 +827,7 @@ func parseProjectInformation(projectFields *ProjectFields) *models.Project {
 			EndDate:   startDate.Add(time.Duration(completedIteration.Duration) * 24 * time.Hour),
 		})
 	}
-	for _, futureIteration := range iteration.Configuration.Iterations {
+	for _, futureIteration := range fields.iteration.Configuration.Iterations {
 		startDate, _ := time.Parse("2006-01-02", futureIteration.StartDate)
 		project.Iterations = append(project.Iterations, models.Iteration{
 			Id:        futureIteration.Id,
@@ -281,40 +837,140 @@ func parseProjectInformation(projectFields *ProjectFields) *models.Project {
 		})
 	}
 
+	milestones := make(map[string]bool)
+	itemIds := make(map[string]string)
+	parentIds := make(map[string]string)
 	for _, item := range projectFields.Items.Nodes {
-		if item.Content.GetTypename() != "Issue" {
+		issue, ok := parseProjectItem(&item, fields)
+
+		if !ok {
+			// content is not accessible with the current credentials
 			continue
 		}
 
-		content := item.Content.(*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue)
-		issue := models.Issue{
-			Id:        item.Id,
-			Title:     content.Title,
-			CreatedAt: content.CreatedAt,
-			ClosedAt:  content.ClosedAt,
-			Status:    item.Status.(*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue).Name,
-		}
-		if item.Effort != nil {
-			issue.Effort = item.Effort.(*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue).Number
+		project.Issues = append(project.Issues, *issue)
+
+		if contentId, parentId := getItemParent(&item); contentId != "" {
+			itemIds[contentId] = item.Id
+			parentIds[item.Id] = parentId
 		}
 
-		if item.Remaining != nil {
-			issue.RemainingHours = item.Remaining.(*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue).Number
+		if milestone := getItemMilestone(&item); milestone != nil && !milestones[milestone.Id] {
+			milestones[milestone.Id] = true
+			project.Milestones = append(project.Milestones, parseMilestone(milestone))
 		}
+	}
 
-		if item.Iteration != nil {
-			iteration := item.Iteration.(*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue)
+	linkParents(project.Issues, itemIds, parentIds)
 
-			issue.IterationId = iteration.IterationId
-		}
+	return project, nil
+}
+
+func parseProjectItem(item *ProjectItem, fields *resolvedFields) (*models.Issue, bool) {
+	issue := &models.Issue{
+		Id: item.Id,
+	}
+
+	switch content := item.Content.(type) {
+	case *ProjectItemContentIssue:
+		issue.Type = content.Typename
+		issue.Title = content.Title
+		issue.CreatedAt = content.CreatedAt
+		issue.ClosedAt = content.ClosedAt
 
 		// extract labels from isues
 		for _, label := range content.Labels.Nodes {
 			issue.Labels = append(issue.Labels, label.Name)
 		}
 
-		project.Issues = append(project.Issues, issue)
+		for _, assignee := range content.Assignees.Nodes {
+			issue.Assignees = append(issue.Assignees, assignee.Login)
+		}
+	case *ProjectItemContentPullRequest:
+		issue.Type = content.Typename
+		issue.Title = content.Title
+		issue.CreatedAt = content.CreatedAt
+		issue.ClosedAt = content.ClosedAt
+
+		for _, label := range content.Labels.Nodes {
+			issue.Labels = append(issue.Labels, label.Name)
+		}
+
+		for _, assignee := range content.Assignees.Nodes {
+			issue.Assignees = append(issue.Assignees, assignee.Login)
+		}
+	case *ProjectItemContentDraftIssue:
+		issue.Type = content.Typename
+		issue.Title = content.Title
+		issue.CreatedAt = content.CreatedAt
+
+		for _, assignee := range content.Assignees.Nodes {
+			issue.Assignees = append(issue.Assignees, assignee.Login)
+		}
+	default:
+		return nil, false
+	}
+
+	if milestone := getItemMilestone(item); milestone != nil {
+		issue.MilestoneId = milestone.Id
+	}
+
+	for _, fieldValue := range item.FieldValues.Nodes {
+		switch value := fieldValue.(type) {
+		case *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue:
+			switch fieldValueFieldId(value.Field) {
+			case fields.status.Id:
+				issue.Status = value.Name
+			case fields.priorityId:
+				if rank, ok := fields.priorityRanks[value.Name]; ok {
+					issue.Priority = &rank
+				}
+			}
+		case *ProjectItemFieldValueProjectV2ItemFieldNumberValue:
+			switch fieldValueFieldId(value.Field) {
+			case fields.effortId:
+				issue.Effort = value.Number
+			case fields.remainingId:
+				issue.RemainingHours = value.Number
+			case fields.priorityId:
+				rank := int(math.Round(value.Number))
+				issue.Priority = &rank
+			}
+		case *ProjectItemFieldValueProjectV2ItemFieldIterationValue:
+			if fieldValueFieldId(value.Field) == fields.iteration.Id {
+				issue.IterationId = value.IterationId
+			}
+		}
+	}
+
+	return issue, true
+}
+
+// getItemMilestone returns the milestone of the issue or pull request of a
+// project item, if any. Draft issues have no milestone.
+func getItemMilestone(item *ProjectItem) *ProjectItemMilestone {
+	switch content := item.Content.(type) {
+	case *ProjectItemContentIssue:
+		return content.Milestone
+	case *ProjectItemContentPullRequest:
+		return content.Milestone
+	default:
+		return nil
+	}
+}
+
+func parseMilestone(milestone *ProjectItemMilestone) models.Milestone {
+	result := models.Milestone{
+		Id:        milestone.Id,
+		Title:     milestone.Title,
+		State:     string(milestone.State),
+		StartDate: milestone.CreatedAt.UTC().Truncate(24 * time.Hour),
+	}
+
+	if !milestone.DueOn.IsZero() {
+		dueDate := milestone.DueOn.UTC().Truncate(24 * time.Hour)
+		result.DueDate = &dueDate
 	}
 
-	return project
+	return result
 }
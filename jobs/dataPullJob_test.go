@@ -2,10 +2,13 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
 	"github.com/jlucaspains/github-charts/models"
 	"github.com/stretchr/testify/assert"
 )
@@ -134,21 +137,39 @@ func TestExecuteWillInsertOrgProject(t *testing.T) {
 			Organization: getOrganizationProjectOrganization{
 				ProjectV2: getOrganizationProjectOrganizationProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name:    "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations:          []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:      "status",
+										Name:    "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations:          []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+								},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -157,11 +178,12 @@ func TestExecuteWillInsertOrgProject(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertProjectValue)
 	assert.Equal(t, "1", querier.UpsertProjectValue.GhID)
 	assert.Equal(t, "Project 1", querier.UpsertProjectValue.Name)
+	assert.Equal(t, pgtype.Text{String: "UTC", Valid: true}, querier.UpsertProjectValue.TimeZone)
 }
 
 func TestExecuteWillInsertRepoProject(t *testing.T) {
@@ -179,22 +201,39 @@ func TestExecuteWillInsertRepoProject(t *testing.T) {
 			Repository: getRepositoryProjectRepository{
 				ProjectV2: getRepositoryProjectRepositoryProjectV2{
 					ProjectFields: ProjectFields{
-
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name:    "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations:          []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:      "status",
+										Name:    "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations:          []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+								},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -203,7 +242,7 @@ func TestExecuteWillInsertRepoProject(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertProjectValue)
 	assert.Equal(t, "1", querier.UpsertProjectValue.GhID)
@@ -224,28 +263,46 @@ func TestExecuteWillInsertOrgCategories(t *testing.T) {
 			Organization: getOrganizationProjectOrganization{
 				ProjectV2: getOrganizationProjectOrganizationProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name: "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{
-								{
-									Name: "New",
-								},
-								{
-									Name: "Done",
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:   "status",
+										Name: "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+											{
+												Name: "New",
+											},
+											{
+												Name: "Done",
+											},
+										},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations:          []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
+									},
 								},
 							},
 						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations:          []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
-							},
-						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -254,7 +311,7 @@ func TestExecuteWillInsertOrgCategories(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertWorkItemStatusValue)
 	assert.Equal(t, "New", querier.UpsertWorkItemStatusValue[0])
@@ -276,28 +333,46 @@ func TestExecuteWillInsertRepoCategories(t *testing.T) {
 			Repository: getRepositoryProjectRepository{
 				ProjectV2: getRepositoryProjectRepositoryProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name: "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{
-								{
-									Name: "New",
-								},
-								{
-									Name: "Done",
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:   "status",
+										Name: "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+											{
+												Name: "New",
+											},
+											{
+												Name: "Done",
+											},
+										},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations:          []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
+									},
 								},
 							},
 						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations:          []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
-							},
-						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -306,7 +381,7 @@ func TestExecuteWillInsertRepoCategories(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertWorkItemStatusValue)
 	assert.Equal(t, "New", querier.UpsertWorkItemStatusValue[0])
@@ -327,35 +402,53 @@ func TestExecuteWillInsertOrgIterations(t *testing.T) {
 			Organization: getOrganizationProjectOrganization{
 				ProjectV2: getOrganizationProjectOrganizationProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name:    "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "2",
-										Title:     "Iteration 2",
-										StartDate: "2024-01-08",
-										Duration:  7,
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:      "status",
+										Name:    "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{},
 									},
-								},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "1",
-										Title:     "Iteration 1",
-										StartDate: "2024-01-01",
-										Duration:  7,
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "2",
+													Title:     "Iteration 2",
+													StartDate: "2024-01-08",
+													Duration:  7,
+												},
+											},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "1",
+													Title:     "Iteration 1",
+													StartDate: "2024-01-01",
+													Duration:  7,
+												},
+											},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
 									},
 								},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -364,7 +457,7 @@ func TestExecuteWillInsertOrgIterations(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertWorkItemIterationsValue)
 	assert.Nil(t, querier.UpsertWorkItemIterationsError)
@@ -393,35 +486,53 @@ func TestExecuteWillInsertRepoIterations(t *testing.T) {
 			Repository: getRepositoryProjectRepository{
 				ProjectV2: getRepositoryProjectRepositoryProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name:    "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "2",
-										Title:     "Iteration 2",
-										StartDate: "2024-01-08",
-										Duration:  7,
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:      "status",
+										Name:    "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{},
 									},
-								},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "1",
-										Title:     "Iteration 1",
-										StartDate: "2024-01-01",
-										Duration:  7,
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "2",
+													Title:     "Iteration 2",
+													StartDate: "2024-01-08",
+													Duration:  7,
+												},
+											},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "1",
+													Title:     "Iteration 1",
+													StartDate: "2024-01-01",
+													Duration:  7,
+												},
+											},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
 									},
 								},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{},
+							Nodes: []ProjectItem{},
 						},
 					},
 				},
@@ -430,7 +541,7 @@ func TestExecuteWillInsertRepoIterations(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	assert.NotNil(t, querier.UpsertWorkItemIterationsValue)
 	assert.Nil(t, querier.UpsertWorkItemIterationsError)
@@ -458,52 +569,78 @@ func TestExecuteWillInsertOrgWorkItems(t *testing.T) {
 			Organization: getOrganizationProjectOrganization{
 				ProjectV2: getOrganizationProjectOrganizationProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name: "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{
-								{
-									Name: "New",
-								},
-							},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "2",
-										Title:     "Iteration 2",
-										StartDate: "2024-01-08",
-										Duration:  7,
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:   "status",
+										Name: "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+											{
+												Name: "New",
+											},
+										},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "2",
+													Title:     "Iteration 2",
+													StartDate: "2024-01-08",
+													Duration:  7,
+												},
+											},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
 									},
 								},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{
+							Nodes: []ProjectItem{
 								{
 									Id: "1",
-									Status: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue{
-										Name: "New",
-									},
-									Effort: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue{
-										Number: 5,
-									},
-									Remaining: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue{
-										Number: 16,
-									},
-									Iteration: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue{
-										IterationId: "2",
+									FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+										Nodes: []ProjectItemFieldValue{
+											&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+												Name:  "New",
+												Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "status"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 5,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "effort"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 16,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "remaining"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldIterationValue{
+												IterationId: "2",
+												Field:       &ProjectItemFieldReferenceProjectV2IterationField{Id: "iteration"},
+											},
+										},
 									},
-									Content: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue{
+									Content: &ProjectItemContentIssue{
 										Typename:  "Issue",
 										Title:     "Issue 1",
 										CreatedAt: time.Now().AddDate(0, 0, -1),
-										Labels: ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection{
-											Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel{
+										Labels: ProjectItemContentIssueLabelsLabelConnection{
+											Nodes: []ProjectItemContentIssueLabelsLabelConnectionNodesLabel{
 												{
 													Name: "Label 1",
 												},
@@ -513,24 +650,32 @@ func TestExecuteWillInsertOrgWorkItems(t *testing.T) {
 								},
 								{
 									Id: "2",
-									Status: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue{
-										Name: "New",
-									},
-									Effort: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue{
-										Number: 3,
-									},
-									Remaining: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue{
-										Number: 8,
-									},
-									Iteration: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue{
-										IterationId: "2",
+									FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+										Nodes: []ProjectItemFieldValue{
+											&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+												Name:  "New",
+												Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "status"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 3,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "effort"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 8,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "remaining"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldIterationValue{
+												IterationId: "2",
+												Field:       &ProjectItemFieldReferenceProjectV2IterationField{Id: "iteration"},
+											},
+										},
 									},
-									Content: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue{
+									Content: &ProjectItemContentIssue{
 										Typename:  "Issue",
 										Title:     "Issue 2",
 										CreatedAt: time.Now().AddDate(0, 0, -2),
-										Labels: ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection{
-											Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel{
+										Labels: ProjectItemContentIssueLabelsLabelConnection{
+											Nodes: []ProjectItemContentIssueLabelsLabelConnectionNodesLabel{
 												{
 													Name: "Label 2",
 												},
@@ -547,7 +692,7 @@ func TestExecuteWillInsertOrgWorkItems(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	effort1, _ := querier.UpsertWorkItemsValue[0].Effort.Int64Value()
 	remaining1, _ := querier.UpsertWorkItemsValue[0].RemainingHours.Int64Value()
@@ -565,6 +710,18 @@ func TestExecuteWillInsertOrgWorkItems(t *testing.T) {
 	assert.Equal(t, int64(3), effort2.Int64)
 	assert.Equal(t, int64(8), remaining2.Int64)
 	assert.Equal(t, time.Now().UTC().Format("2006-01-02"), querier.UpsertWorkItemsValue[0].ChangeDate.Time.Format("2006-01-02"))
+	assert.Equal(t, []int32{1, 2}, querier.DeleteWorkItemLabelsValue)
+	assert.Equal(t, []string{"Label 1", "Label 2"}, querier.UpsertLabelValue)
+	assert.Equal(t, int32(1), querier.InsertWorkItemLabelValue[0].WorkItemHistoryID)
+	assert.Equal(t, int32(1), querier.InsertWorkItemLabelValue[0].LabelID)
+	assert.Equal(t, int32(2), querier.InsertWorkItemLabelValue[1].WorkItemHistoryID)
+	assert.Equal(t, int32(2), querier.InsertWorkItemLabelValue[1].LabelID)
+	assert.Equal(t, "1", querier.UpsertWorkItemDatesValue[0].GhID)
+	assert.True(t, querier.UpsertWorkItemDatesValue[0].CreatedAt.Valid)
+	assert.False(t, querier.UpsertWorkItemDatesValue[0].ClosedAt.Valid)
+	assert.Equal(t, "1", querier.UpsertWorkItemStatusChangeValue[0].GhID)
+	assert.Equal(t, "New", querier.UpsertWorkItemStatusChangeValue[0].Status)
+	assert.Equal(t, time.Now().UTC().Format("2006-01-02"), querier.UpsertWorkItemStatusChangeValue[0].EnteredDate.Time.Format("2006-01-02"))
 }
 
 func TestExecuteWillInsertRepoWorkItems(t *testing.T) {
@@ -582,52 +739,78 @@ func TestExecuteWillInsertRepoWorkItems(t *testing.T) {
 			Repository: getRepositoryProjectRepository{
 				ProjectV2: getRepositoryProjectRepositoryProjectV2{
 					ProjectFields: ProjectFields{
-						Id:    "1",
-						Title: "Project 1",
-						Status: &ProjectFieldsStatusProjectV2SingleSelectField{
-							Name: "Status",
-							Options: []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption{
-								{
-									Name: "New",
-								},
-							},
-						},
-						Iteration: &ProjectFieldsIterationProjectV2IterationField{
-							Name: "Iteration",
-							Configuration: ProjectFieldsIterationProjectV2IterationFieldConfiguration{
-								Iterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
-									{
-										Id:        "2",
-										Title:     "Iteration 2",
-										StartDate: "2024-01-08",
-										Duration:  7,
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{
+										Id:   "status",
+										Name: "Status",
+										Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+											{
+												Name: "New",
+											},
+										},
+									},
+									&ProjectFieldProjectV2IterationField{
+										Id:   "iteration",
+										Name: "Iteration",
+										Configuration: ProjectFieldConfigurationProjectV2IterationFieldConfiguration{
+											Iterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration{
+												{
+													Id:        "2",
+													Title:     "Iteration 2",
+													StartDate: "2024-01-08",
+													Duration:  7,
+												},
+											},
+											CompletedIterations: []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
+										},
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "effort",
+										Name:     "Effort",
+										DataType: ProjectV2FieldTypeNumber,
+									},
+									&ProjectFieldProjectV2Field{
+										Id:       "remaining",
+										Name:     "RemainingHours",
+										DataType: ProjectV2FieldTypeNumber,
 									},
 								},
-								CompletedIterations: []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration{},
 							},
 						},
 						Items: ProjectFieldsItemsProjectV2ItemConnection{
-							Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item{
+							Nodes: []ProjectItem{
 								{
 									Id: "1",
-									Status: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue{
-										Name: "New",
-									},
-									Effort: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue{
-										Number: 5,
-									},
-									Remaining: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue{
-										Number: 16,
-									},
-									Iteration: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue{
-										IterationId: "2",
+									FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+										Nodes: []ProjectItemFieldValue{
+											&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+												Name:  "New",
+												Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "status"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 5,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "effort"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 16,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "remaining"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldIterationValue{
+												IterationId: "2",
+												Field:       &ProjectItemFieldReferenceProjectV2IterationField{Id: "iteration"},
+											},
+										},
 									},
-									Content: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue{
+									Content: &ProjectItemContentIssue{
 										Typename:  "Issue",
 										Title:     "Issue 1",
 										CreatedAt: time.Now().AddDate(0, 0, -1),
-										Labels: ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection{
-											Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel{
+										Labels: ProjectItemContentIssueLabelsLabelConnection{
+											Nodes: []ProjectItemContentIssueLabelsLabelConnectionNodesLabel{
 												{
 													Name: "Label 1",
 												},
@@ -637,24 +820,32 @@ func TestExecuteWillInsertRepoWorkItems(t *testing.T) {
 								},
 								{
 									Id: "2",
-									Status: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue{
-										Name: "New",
-									},
-									Effort: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue{
-										Number: 3,
-									},
-									Remaining: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue{
-										Number: 8,
-									},
-									Iteration: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue{
-										IterationId: "2",
+									FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+										Nodes: []ProjectItemFieldValue{
+											&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+												Name:  "New",
+												Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "status"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 3,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "effort"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+												Number: 8,
+												Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "remaining"},
+											},
+											&ProjectItemFieldValueProjectV2ItemFieldIterationValue{
+												IterationId: "2",
+												Field:       &ProjectItemFieldReferenceProjectV2IterationField{Id: "iteration"},
+											},
+										},
 									},
-									Content: &ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue{
+									Content: &ProjectItemContentIssue{
 										Typename:  "Issue",
 										Title:     "Issue 2",
 										CreatedAt: time.Now().AddDate(0, 0, -2),
-										Labels: ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection{
-											Nodes: []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel{
+										Labels: ProjectItemContentIssueLabelsLabelConnection{
+											Nodes: []ProjectItemContentIssueLabelsLabelConnectionNodesLabel{
 												{
 													Name: "Label 2",
 												},
@@ -671,7 +862,7 @@ func TestExecuteWillInsertRepoWorkItems(t *testing.T) {
 	}
 	dataPullJob.Start()
 
-	dataPullJob.tryExecute()
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
 
 	effort1, _ := querier.UpsertWorkItemsValue[0].Effort.Int64Value()
 	remaining1, _ := querier.UpsertWorkItemsValue[0].RemainingHours.Int64Value()
@@ -690,3 +881,280 @@ func TestExecuteWillInsertRepoWorkItems(t *testing.T) {
 	assert.Equal(t, int64(8), remaining2.Int64)
 	assert.Equal(t, time.Now().UTC().Format("2006-01-02"), querier.UpsertWorkItemsValue[0].ChangeDate.Time.Format("2006-01-02"))
 }
+
+func TestExecuteWillSkipProjectWithMissingField(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{
+			OrgName: "org",
+			Project: "1",
+			Token:   "token",
+			Fields:  models.FieldMapping{Effort: "Story Points"},
+		},
+	})
+	dataPullJob.graphqlClients[dataPullJob.projects[0].GetUniqueName()] = mockGraphqlOrgClient{
+		result: getOrganizationProjectResponse{
+			Organization: getOrganizationProjectOrganization{
+				ProjectV2: getOrganizationProjectOrganizationProjectV2{
+					ProjectFields: ProjectFields{
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{Id: "status", Name: "Status"},
+									&ProjectFieldProjectV2IterationField{Id: "iteration", Name: "Iteration"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	dataPullJob.Start()
+
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
+
+	assert.Empty(t, querier.UpsertProjectValue.GhID)
+	assert.Nil(t, querier.UpsertWorkItemsValue)
+}
+
+func TestExecuteWillInsertPullRequestsAndDrafts(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{
+			OrgName: "org",
+			Project: "1",
+			Token:   "token",
+		},
+	})
+	dataPullJob.graphqlClients[dataPullJob.projects[0].GetUniqueName()] = mockGraphqlOrgClient{
+		result: getOrganizationProjectResponse{
+			Organization: getOrganizationProjectOrganization{
+				ProjectV2: getOrganizationProjectOrganizationProjectV2{
+					ProjectFields: ProjectFields{
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{Id: "status", Name: "Status"},
+									&ProjectFieldProjectV2IterationField{Id: "iteration", Name: "Iteration"},
+								},
+							},
+						},
+						Items: ProjectFieldsItemsProjectV2ItemConnection{
+							Nodes: []ProjectItem{
+								{
+									Id: "1",
+									Content: &ProjectItemContentPullRequest{
+										Typename: "PullRequest",
+										Title:    "PR 1",
+									},
+								},
+								{
+									Id: "2",
+									Content: &ProjectItemContentDraftIssue{
+										Typename: "DraftIssue",
+										Title:    "Draft 1",
+									},
+								},
+								{
+									Id: "3",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	dataPullJob.Start()
+
+	waitForScheduledSync(t, dataPullJob, dataPullJob.tryExecute())
+
+	assert.Len(t, querier.UpsertWorkItemsValue, 2)
+	assert.Equal(t, "PR 1", querier.UpsertWorkItemsValue[0].Name)
+	assert.Equal(t, "PullRequest", querier.UpsertWorkItemsValue[0].ContentType)
+	assert.Equal(t, "Draft 1", querier.UpsertWorkItemsValue[1].Name)
+	assert.Equal(t, "DraftIssue", querier.UpsertWorkItemsValue[1].ContentType)
+}
+
+func TestExecuteWillInsertMilestones(t *testing.T) {
+	querier := &MockQuerier{}
+	milestone := &ProjectItemMilestone{
+		Id:        "milestone",
+		Title:     "v1.0",
+		State:     MilestoneStateOpen,
+		CreatedAt: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC),
+		DueOn:     time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC),
+	}
+	dataPullJob, _ := newProjectTestJob(t, querier, []ProjectItem{
+		{
+			Id: "1",
+			Content: &ProjectItemContentIssue{
+				Typename:  "Issue",
+				Title:     "Issue 1",
+				Milestone: milestone,
+			},
+		},
+		{
+			Id: "2",
+			Content: &ProjectItemContentPullRequest{
+				Typename:  "PullRequest",
+				Title:     "PR 1",
+				Milestone: milestone,
+			},
+		},
+		{
+			Id: "3",
+			Content: &ProjectItemContentIssue{
+				Typename: "Issue",
+				Title:    "Issue 2",
+			},
+		},
+	}, useProjectClient)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, []db.UpsertMilestoneParams{
+		{
+			GhID:      "milestone",
+			Name:      "v1.0",
+			State:     "OPEN",
+			StartDate: pgtype.Date{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+			DueDate:   pgtype.Date{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			ProjectID: 1,
+		},
+	}, querier.UpsertMilestoneValue)
+	assert.Len(t, querier.UpsertWorkItemsValue, 3)
+	assert.Equal(t, pgtype.Int4{Int32: 1, Valid: true}, querier.UpsertWorkItemsValue[0].MilestoneID)
+	assert.Equal(t, pgtype.Int4{Int32: 1, Valid: true}, querier.UpsertWorkItemsValue[1].MilestoneID)
+	assert.False(t, querier.UpsertWorkItemsValue[2].MilestoneID.Valid)
+}
+
+func TestParseMilestoneWithoutDueDate(t *testing.T) {
+	milestone := parseMilestone(&ProjectItemMilestone{
+		Id:        "milestone",
+		Title:     "Backlog",
+		State:     MilestoneStateClosed,
+		CreatedAt: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC),
+	})
+
+	assert.Equal(t, "CLOSED", milestone.State)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), milestone.StartDate)
+	assert.Nil(t, milestone.DueDate)
+}
+
+func getTransactionTestJob(t *testing.T, querier *MockQuerier) *DataPullJob {
+	dataPullJob, _ := newProjectTestJob(t, querier, []ProjectItem{getIssueTestItem("item")}, useProjectClient)
+	return dataPullJob
+}
+
+func TestExecuteWillSaveProjectInTransaction(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getTransactionTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, 1, querier.ExecTxCount)
+	assert.Equal(t, 0, querier.ExecTxRollbacks)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+}
+
+func TestExecuteWillRollbackFailedSave(t *testing.T) {
+	querier := &MockQuerier{UpsertWorkItemDatesError: fmt.Errorf("error")}
+	dataPullJob := getTransactionTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, 1, querier.ExecTxCount)
+	assert.Equal(t, 1, querier.ExecTxRollbacks)
+	assert.Empty(t, dataPullJob.projectNames)
+}
+
+func TestExecuteWillFailWhenTransactionCannotStart(t *testing.T) {
+	querier := &MockQuerier{ExecTxError: fmt.Errorf("unavailable")}
+	dataPullJob := getTransactionTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Nil(t, querier.UpsertWorkItemsValue)
+	assert.Empty(t, dataPullJob.projectNames)
+}
+
+func TestExecuteWillInsertPriority(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := newSyncTestJob(t, querier)
+	client := getSyncTestClient(nil)
+	projectFields := &client.result.Organization.ProjectV2.ProjectFields
+	projectFields.Fields.Nodes = append(projectFields.Fields.Nodes, &ProjectFieldProjectV2SingleSelectField{
+		Id:   "priority",
+		Name: "Priority",
+		Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+			{Name: "P0"}, {Name: "P1"}, {Name: "P2"},
+		},
+	})
+	projectFields.Items.Nodes = []ProjectItem{
+		{
+			Id: "1",
+			FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+				Nodes: []ProjectItemFieldValue{
+					&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+						Name:  "P1",
+						Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "priority"},
+					},
+				},
+			},
+			Content: &ProjectItemContentIssue{Typename: "Issue", Title: "Issue 1"},
+		},
+		{
+			Id:      "2",
+			Content: &ProjectItemContentIssue{Typename: "Issue", Title: "Issue 2"},
+		},
+	}
+	dataPullJob.graphqlClients["org/1"] = client
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Len(t, querier.UpsertWorkItemsValue, 2)
+	assert.Equal(t, pgtype.Int4{Int32: 1, Valid: true}, querier.UpsertWorkItemsValue[0].Priority)
+	assert.False(t, querier.UpsertWorkItemsValue[1].Priority.Valid)
+}
+
+func TestExecuteWillInsertAssignees(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := newProjectTestJob(t, querier, []ProjectItem{
+		{
+			Id: "1",
+			Content: &ProjectItemContentIssue{
+				Typename: "Issue",
+				Title:    "Issue 1",
+				Assignees: ProjectItemContentIssueAssigneesUserConnection{
+					Nodes: []ProjectItemContentIssueAssigneesUserConnectionNodesUser{{Login: "octocat"}, {Login: "hubot"}},
+				},
+			},
+		},
+		{
+			Id: "2",
+			Content: &ProjectItemContentDraftIssue{
+				Typename: "DraftIssue",
+				Title:    "Draft 1",
+				Assignees: ProjectItemContentDraftIssueAssigneesUserConnection{
+					Nodes: []ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser{{Login: "octocat"}},
+				},
+			},
+		},
+	}, useProjectClient)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, []string{"octocat", "hubot"}, querier.UpsertAssigneeValue)
+	assert.Equal(t, []int32{1, 2}, querier.DeleteWorkItemAssigneesValue)
+	assert.Equal(t, []db.InsertWorkItemAssigneeParams{
+		{WorkItemHistoryID: 1, AssigneeID: 1},
+		{WorkItemHistoryID: 1, AssigneeID: 2},
+		{WorkItemHistoryID: 2, AssigneeID: 1},
+	}, querier.InsertWorkItemAssigneeValue)
+}
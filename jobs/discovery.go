@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// refreshProjects reloads the registered projects and lists the projects of
+// the organizations configured for discovery before a run.
+func (c *DataPullJob) refreshProjects() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.pullTimeout)
+	defer cancel()
+
+	c.loadRegisteredProjects(ctx)
+	c.discoverProjects(ctx)
+}
+
+// discoverProjects lists the projects of each organization configured for
+// discovery and keeps those matching its patterns. The projects discovered
+// before are kept when an organization cannot be listed.
+func (c *DataPullJob) discoverProjects(ctx context.Context) {
+	c.mutex.Lock()
+	configured := c.projects
+	clients := c.graphqlClients
+	previous := c.discoveredProjects
+	c.mutex.Unlock()
+
+	discovered := make(map[string][]models.JobConfigItem)
+	for _, source := range configured {
+		if !source.Discover {
+			continue
+		}
+
+		name := source.GetUniqueName()
+		projects, err := listOrganizationProjects(ctx, clients[name], source)
+		if err != nil {
+			slog.Error("Error discovering projects, keeping the projects discovered before", "organization", name, "error", err)
+			discovered[name] = previous[name]
+			continue
+		}
+
+		slog.Info("Discovered projects", "organization", name, "count", len(projects))
+		discovered[name] = projects
+	}
+
+	if len(discovered) == 0 {
+		return
+	}
+
+	c.reloadMutex.Lock()
+	defer c.reloadMutex.Unlock()
+
+	c.mutex.Lock()
+	configured = c.projects
+	registered := c.registeredProjects
+	c.mutex.Unlock()
+
+	// discovered projects never fail the change
+	_ = c.replaceProjects(configured, registered, discovered)
+}
+
+// listOrganizationProjects returns the configuration of every project of the
+// organization matching the patterns of the discovery source.
+func listOrganizationProjects(ctx context.Context, graphqlClient graphql.Client, source models.JobConfigItem) ([]models.JobConfigItem, error) {
+	hasNextPage := true
+	cursor := ""
+	result := []models.JobConfigItem{}
+
+	for hasNextPage {
+		page, err := getOrganizationProjects(ctx, graphqlClient, source.OrgName, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range page.Organization.ProjectsV2.Nodes {
+			if source.IncludesProject(project.Title, project.Closed) {
+				result = append(result, source.ForProject(project.Number))
+			}
+		}
+
+		hasNextPage = page.Organization.ProjectsV2.PageInfo.HasNextPage
+		cursor = page.Organization.ProjectsV2.PageInfo.EndCursor
+	}
+
+	return result, nil
+}
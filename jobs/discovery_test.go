@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphqlDiscoveryClient struct {
+	pages []getOrganizationProjectsResponse
+	err   error
+	calls int
+}
+
+func (m *mockGraphqlDiscoveryClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	*resp.Data.(*getOrganizationProjectsResponse) = m.pages[m.calls]
+	m.calls++
+
+	return nil
+}
+
+func getDiscoveryPage(hasNextPage bool, projects ...OrganizationProject) getOrganizationProjectsResponse {
+	page := getOrganizationProjectsResponse{}
+	page.Organization.ProjectsV2.Nodes = projects
+	page.Organization.ProjectsV2.PageInfo.HasNextPage = hasNextPage
+	page.Organization.ProjectsV2.PageInfo.EndCursor = "cursor"
+
+	return page
+}
+
+func getDiscoveryJob(client *mockGraphqlDiscoveryClient, source models.JobConfigItem) *DataPullJob {
+	dataPullJob, _ := NewDataPullJob("* * * * *", &MockQuerier{}, []models.JobConfigItem{
+		{OrgName: "org", Project: "2", Token: "token", Fields: models.FieldMapping{Status: "Stage"}},
+		source,
+	})
+	dataPullJob.graphqlClients[source.GetUniqueName()] = client
+
+	return dataPullJob
+}
+
+func TestListOrganizationProjects(t *testing.T) {
+	client := &mockGraphqlDiscoveryClient{pages: []getOrganizationProjectsResponse{
+		getDiscoveryPage(true, OrganizationProject{Number: 1, Title: "Team Alpha"}, OrganizationProject{Number: 2, Title: "Roadmap"}),
+		getDiscoveryPage(false, OrganizationProject{Number: 3, Title: "Team Beta", Closed: true}, OrganizationProject{Number: 4, Title: "team gamma"}),
+	}}
+
+	projects, err := listOrganizationProjects(context.Background(), client, models.JobConfigItem{
+		OrgName:         "org",
+		Token:           "token",
+		Discover:        true,
+		IncludePatterns: "Team *",
+		BackfillDays:    7,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, client.calls)
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "org/1", projects[0].GetUniqueName())
+	assert.Equal(t, "org/4", projects[1].GetUniqueName())
+	assert.Equal(t, "token", projects[1].Token)
+	assert.Equal(t, 7, projects[1].BackfillDays)
+	assert.False(t, projects[1].Discover)
+	assert.Empty(t, projects[1].IncludePatterns)
+}
+
+func TestListOrganizationProjectsExcludeAndClosed(t *testing.T) {
+	client := &mockGraphqlDiscoveryClient{pages: []getOrganizationProjectsResponse{
+		getDiscoveryPage(false,
+			OrganizationProject{Number: 1, Title: "Team Alpha"},
+			OrganizationProject{Number: 2, Title: "Team Alpha (archive)", Closed: true},
+			OrganizationProject{Number: 3, Title: "Sandbox"},
+		),
+	}}
+
+	projects, err := listOrganizationProjects(context.Background(), client, models.JobConfigItem{
+		OrgName:         "org",
+		Token:           "token",
+		Discover:        true,
+		ExcludePatterns: "sandbox, scratch *",
+		IncludeClosed:   true,
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "1", projects[0].Project)
+	assert.Equal(t, "2", projects[1].Project)
+}
+
+func TestDiscoverProjects(t *testing.T) {
+	client := &mockGraphqlDiscoveryClient{pages: []getOrganizationProjectsResponse{
+		getDiscoveryPage(false, OrganizationProject{Number: 1, Title: "Team Alpha"}, OrganizationProject{Number: 2, Title: "Team Beta"}),
+	}}
+	dataPullJob := getDiscoveryJob(client, models.JobConfigItem{OrgName: "org", Token: "token", Discover: true})
+
+	dataPullJob.discoverProjects(context.Background())
+
+	projects, clients := dataPullJob.getProjects()
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "org/2", projects[0].GetUniqueName())
+	// the configured project takes precedence over the discovered one
+	assert.Equal(t, "Stage", projects[0].Fields.Status)
+	assert.Equal(t, "org/1", projects[1].GetUniqueName())
+	assert.Contains(t, clients, "org/1")
+}
+
+func TestDiscoverProjectsKeepsProjectsOnError(t *testing.T) {
+	client := &mockGraphqlDiscoveryClient{pages: []getOrganizationProjectsResponse{
+		getDiscoveryPage(false, OrganizationProject{Number: 1, Title: "Team Alpha"}),
+	}}
+	dataPullJob := getDiscoveryJob(client, models.JobConfigItem{OrgName: "org", Token: "token", Discover: true})
+	dataPullJob.discoverProjects(context.Background())
+
+	client.err = errors.New("Bad gateway")
+	dataPullJob.discoverProjects(context.Background())
+
+	projects, _ := dataPullJob.getProjects()
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "org/1", projects[1].GetUniqueName())
+}
+
+func TestDiscoverProjectsRemovedSource(t *testing.T) {
+	client := &mockGraphqlDiscoveryClient{pages: []getOrganizationProjectsResponse{
+		getDiscoveryPage(false, OrganizationProject{Number: 1, Title: "Team Alpha"}),
+	}}
+	dataPullJob := getDiscoveryJob(client, models.JobConfigItem{OrgName: "org", Token: "token", Discover: true})
+	dataPullJob.discoverProjects(context.Background())
+
+	err := dataPullJob.SetProjects([]models.JobConfigItem{{OrgName: "org", Project: "2", Token: "token"}})
+
+	assert.Nil(t, err)
+	projects, _ := dataPullJob.getProjects()
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "org/2", projects[0].GetUniqueName())
+}
@@ -0,0 +1,162 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+var defaultFieldMapping = models.FieldMapping{
+	Status:    "Status",
+	Effort:    "Effort",
+	Remaining: "RemainingHours",
+	Iteration: "Iteration",
+	Priority:  "Priority",
+}
+
+type namedField interface {
+	GetId() string
+	GetName() string
+}
+
+type resolvedFields struct {
+	status      *ProjectFieldProjectV2SingleSelectField
+	iteration   *ProjectFieldProjectV2IterationField
+	effortId    string
+	remainingId string
+
+	priorityId    string
+	priorityRanks map[string]int
+}
+
+// resolveFields matches the configured field mapping against the fields
+// defined in the project. Fields can be referenced by name or node ID. Status
+// and iteration are always required while effort and remaining are only
+// required when they are explicitly configured, like priority.
+func resolveFields(fields []ProjectField, mapping models.FieldMapping) (*resolvedFields, error) {
+	result := &resolvedFields{}
+
+	statusField, err := findField(fields, "status", mapping.Status, defaultFieldMapping.Status, true)
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := statusField.(*ProjectFieldProjectV2SingleSelectField)
+	if !ok {
+		return nil, fmt.Errorf("status field %q is not a single select field", statusField.(namedField).GetName())
+	}
+	result.status = status
+
+	iterationField, err := findField(fields, "iteration", mapping.Iteration, defaultFieldMapping.Iteration, true)
+	if err != nil {
+		return nil, err
+	}
+
+	iteration, ok := iterationField.(*ProjectFieldProjectV2IterationField)
+	if !ok {
+		return nil, fmt.Errorf("iteration field %q is not an iteration field", iterationField.(namedField).GetName())
+	}
+	result.iteration = iteration
+
+	result.effortId, err = findNumberFieldId(fields, "effort", mapping.Effort, defaultFieldMapping.Effort)
+	if err != nil {
+		return nil, err
+	}
+
+	result.remainingId, err = findNumberFieldId(fields, "remaining", mapping.Remaining, defaultFieldMapping.Remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	result.priorityId, result.priorityRanks, err = findPriorityField(fields, mapping.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func findNumberFieldId(fields []ProjectField, role string, configured string, fallback string) (string, error) {
+	field, err := findField(fields, role, configured, fallback, configured != "")
+	if err != nil || field == nil {
+		return "", err
+	}
+
+	if !isNumberField(field) {
+		// a field that only shares the default name is not meant for this role
+		if configured == "" {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("%s field %q is not a number field", role, field.(namedField).GetName())
+	}
+
+	return field.(namedField).GetId(), nil
+}
+
+func isNumberField(field ProjectField) bool {
+	numberField, ok := field.(*ProjectFieldProjectV2Field)
+	return ok && numberField.DataType == ProjectV2FieldTypeNumber
+}
+
+// findPriorityField resolves a single select or number priority field. The
+// options of a single select field are ranked by their order in the project
+// starting at 0, so the first option is the highest priority.
+func findPriorityField(fields []ProjectField, configured string) (string, map[string]int, error) {
+	field, err := findField(fields, "priority", configured, defaultFieldMapping.Priority, configured != "")
+	if err != nil || field == nil {
+		return "", nil, err
+	}
+
+	if single, ok := field.(*ProjectFieldProjectV2SingleSelectField); ok {
+		ranks := make(map[string]int)
+		for index, option := range single.Options {
+			ranks[option.Name] = index
+		}
+
+		return single.Id, ranks, nil
+	}
+
+	if isNumberField(field) {
+		return field.(namedField).GetId(), nil, nil
+	}
+
+	// a field that only shares the default name is not meant for priority
+	if configured == "" {
+		return "", nil, nil
+	}
+
+	return "", nil, fmt.Errorf("priority field %q is not a single select or number field", field.(namedField).GetName())
+}
+
+func findField(fields []ProjectField, role string, configured string, fallback string, required bool) (ProjectField, error) {
+	nameOrId := configured
+	if nameOrId == "" {
+		nameOrId = fallback
+	}
+
+	for _, field := range fields {
+		named, ok := field.(namedField)
+		if !ok {
+			continue
+		}
+
+		if named.GetId() == nameOrId || named.GetName() == nameOrId {
+			return field, nil
+		}
+	}
+
+	if required {
+		return nil, fmt.Errorf("%s field %q does not exist in project", role, nameOrId)
+	}
+
+	return nil, nil
+}
+
+func fieldValueFieldId(field ProjectItemFieldReference) string {
+	if named, ok := field.(namedField); ok {
+		return named.GetId()
+	}
+
+	return ""
+}
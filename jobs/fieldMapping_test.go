@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestFields() []ProjectField {
+	return []ProjectField{
+		&ProjectFieldProjectV2SingleSelectField{Id: "stage", Name: "Stage"},
+		&ProjectFieldProjectV2IterationField{Id: "sprint", Name: "Sprint"},
+		&ProjectFieldProjectV2Field{Id: "points", Name: "Story Points", DataType: ProjectV2FieldTypeNumber},
+		&ProjectFieldProjectV2Field{Id: "hours", Name: "Hours Left", DataType: ProjectV2FieldTypeNumber},
+	}
+}
+
+func TestResolveFieldsByName(t *testing.T) {
+	fields, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "Stage",
+		Effort:    "Story Points",
+		Remaining: "Hours Left",
+		Iteration: "Sprint",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "stage", fields.status.Id)
+	assert.Equal(t, "sprint", fields.iteration.Id)
+	assert.Equal(t, "points", fields.effortId)
+	assert.Equal(t, "hours", fields.remainingId)
+}
+
+func TestResolveFieldsById(t *testing.T) {
+	fields, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "stage",
+		Effort:    "points",
+		Iteration: "sprint",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "stage", fields.status.Id)
+	assert.Equal(t, "sprint", fields.iteration.Id)
+	assert.Equal(t, "points", fields.effortId)
+	assert.Empty(t, fields.remainingId)
+}
+
+func TestResolveFieldsMissingRequired(t *testing.T) {
+	_, err := resolveFields(getTestFields(), models.FieldMapping{
+		Iteration: "Sprint",
+	})
+
+	assert.EqualError(t, err, `status field "Status" does not exist in project`)
+}
+
+func TestResolveFieldsMissingConfigured(t *testing.T) {
+	_, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "Stage",
+		Effort:    "Size",
+		Iteration: "Sprint",
+	})
+
+	assert.EqualError(t, err, `effort field "Size" does not exist in project`)
+}
+
+func TestResolveFieldsWrongType(t *testing.T) {
+	_, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "Sprint",
+		Iteration: "Sprint",
+	})
+
+	assert.EqualError(t, err, `status field "Sprint" is not a single select field`)
+}
+
+func TestResolveFieldsPrioritySingleSelect(t *testing.T) {
+	fields, err := resolveFields(append(getTestFields(), &ProjectFieldProjectV2SingleSelectField{
+		Id:   "priority",
+		Name: "Priority",
+		Options: []ProjectFieldOptionsProjectV2SingleSelectFieldOption{
+			{Name: "P0"}, {Name: "P1"}, {Name: "P2"},
+		},
+	}), models.FieldMapping{
+		Status:    "Stage",
+		Iteration: "Sprint",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "priority", fields.priorityId)
+	assert.Equal(t, map[string]int{"P0": 0, "P1": 1, "P2": 2}, fields.priorityRanks)
+}
+
+func TestResolveFieldsPriorityNumber(t *testing.T) {
+	fields, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "Stage",
+		Iteration: "Sprint",
+		Priority:  "Story Points",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "points", fields.priorityId)
+	assert.Nil(t, fields.priorityRanks)
+}
+
+func TestResolveFieldsPriorityWrongType(t *testing.T) {
+	_, err := resolveFields(getTestFields(), models.FieldMapping{
+		Status:    "Stage",
+		Iteration: "Sprint",
+		Priority:  "Sprint",
+	})
+
+	assert.EqualError(t, err, `priority field "Sprint" is not a single select or number field`)
+}
+
+func TestResolveFieldsNumberWrongType(t *testing.T) {
+	_, err := resolveFields(append(getTestFields(), &ProjectFieldProjectV2Field{Id: "size", Name: "Size", DataType: ProjectV2FieldTypeText}), models.FieldMapping{
+		Status:    "Stage",
+		Effort:    "Size",
+		Iteration: "Sprint",
+	})
+
+	assert.EqualError(t, err, `effort field "Size" is not a number field`)
+}
+
+func TestResolveFieldsDefaultNumberWrongType(t *testing.T) {
+	fields, err := resolveFields(append(getTestFields(), &ProjectFieldProjectV2Field{Id: "effort", Name: "Effort", DataType: ProjectV2FieldTypeText}), models.FieldMapping{
+		Status:    "Stage",
+		Iteration: "Sprint",
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, fields.effortId)
+}
+
+func TestResolveFieldsDefaultPriorityWrongType(t *testing.T) {
+	fields, err := resolveFields(append(getTestFields(), &ProjectFieldProjectV2Field{Id: "priority", Name: "Priority", DataType: ProjectV2FieldTypeText}), models.FieldMapping{
+		Status:    "Stage",
+		Iteration: "Sprint",
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, fields.priorityId)
+	assert.Nil(t, fields.priorityRanks)
+}
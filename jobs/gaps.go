@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// FillHistoryGaps carries the last known state of each pulled project forward
+// into the days the job did not run, up to yesterday in the time zone of the
+// project. The carried snapshots are inferred so that a later pull of the
+// same day replaces them.
+func (c *DataPullJob) FillHistoryGaps() {
+	c.refreshProjects()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.pullTimeout)
+	defer cancel()
+
+	projects, _ := c.getProjects()
+	names := []string{}
+	projectsByName := make(map[string]models.JobConfigItem)
+	for _, project := range projects {
+		names = append(names, project.GetUniqueName())
+		projectsByName[project.GetUniqueName()] = project
+	}
+
+	// projects are only known by id once a sync saved them
+	synced, err := c.queries.GetSyncedProjectIds(ctx, names)
+	if err != nil {
+		slog.Error("Error on GetSyncedProjectIds", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, project := range synced {
+		config := projectsByName[project.ProjectName]
+		yesterday := config.Today(now).AddDate(0, 0, -1)
+		days, err := c.queries.FillWorkItemHistoryGaps(ctx, db.FillWorkItemHistoryGapsParams{
+			ProjectID: project.ProjectID,
+			EndDate:   pgtype.Timestamp{Time: yesterday, Valid: true},
+		})
+
+		if err != nil {
+			slog.Error("Error on FillWorkItemHistoryGaps", "project", project.ProjectName, "error", err)
+			continue
+		}
+
+		if len(days) > 0 {
+			slog.Info("Filled missing days", "project", project.ProjectName, "days", len(days), "from", days[0].Time.Format(time.DateOnly), "to", days[len(days)-1].Time.Format(time.DateOnly))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func getGapsJob(querier *MockQuerier) *DataPullJob {
+	dataPullJob, _ := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{OrgName: "org", Project: "1", Token: "token"},
+		{RepoOwner: "owner", RepoName: "repo", Project: "2", Token: "token"},
+	})
+
+	return dataPullJob
+}
+
+func TestFillHistoryGaps(t *testing.T) {
+	querier := &MockQuerier{
+		GetSyncedProjectIdsResult: []db.GetSyncedProjectIdsRow{
+			{ProjectName: "org/1", ProjectID: 10},
+			{ProjectName: "owner/repo/2", ProjectID: 20},
+		},
+		FillWorkItemHistoryGapsResult: []pgtype.Date{
+			{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+		},
+	}
+
+	getGapsJob(querier).FillHistoryGaps()
+
+	yesterday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	assert.ElementsMatch(t, []string{"org/1", "owner/repo/2"}, querier.GetSyncedProjectIdsValue)
+	assert.Equal(t, []db.FillWorkItemHistoryGapsParams{
+		{ProjectID: 10, EndDate: pgtype.Timestamp{Time: yesterday, Valid: true}},
+		{ProjectID: 20, EndDate: pgtype.Timestamp{Time: yesterday, Valid: true}},
+	}, querier.FillWorkItemHistoryGapsValue)
+}
+
+func TestFillHistoryGapsSyncedProjectsError(t *testing.T) {
+	querier := &MockQuerier{GetSyncedProjectIdsError: errors.New("error")}
+
+	getGapsJob(querier).FillHistoryGaps()
+
+	assert.Empty(t, querier.FillWorkItemHistoryGapsValue)
+}
+
+func TestFillHistoryGapsContinuesOnError(t *testing.T) {
+	querier := &MockQuerier{
+		GetSyncedProjectIdsResult: []db.GetSyncedProjectIdsRow{
+			{ProjectName: "org/1", ProjectID: 10},
+			{ProjectName: "owner/repo/2", ProjectID: 20},
+		},
+		FillWorkItemHistoryGapsError: errors.New("error"),
+	}
+
+	getGapsJob(querier).FillHistoryGaps()
+
+	assert.Len(t, querier.FillWorkItemHistoryGapsValue, 2)
+}
+
+func TestFillHistoryGapsProjectTimeZone(t *testing.T) {
+	querier := &MockQuerier{
+		GetSyncedProjectIdsResult: []db.GetSyncedProjectIdsRow{{ProjectName: "org/1", ProjectID: 10}},
+	}
+	dataPullJob, _ := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{OrgName: "org", Project: "1", Token: "token", TimeZone: "Pacific/Kiritimati"},
+	})
+
+	dataPullJob.FillHistoryGaps()
+
+	location, _ := time.LoadLocation("Pacific/Kiritimati")
+	year, month, day := time.Now().In(location).AddDate(0, 0, -1).Date()
+	assert.Equal(t, time.Date(year, month, day, 0, 0, 0, 0, time.UTC), querier.FillWorkItemHistoryGapsValue[0].EndDate.Time)
+}
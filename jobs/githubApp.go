@@ -0,0 +1,163 @@
+package jobs
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// installation tokens are refreshed this long before they expire so that a
+// project pull never starts with a token about to become invalid
+const tokenRefreshMargin = 5 * time.Minute
+
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+type appTokenSource struct {
+	appId          string
+	installationId string
+	privateKey     *rsa.PrivateKey
+	baseUrl        string
+	httpClient     *http.Client
+	now            func() time.Time
+
+	mutex     sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+type installationTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func newAppTokenSource(appId string, installationId string, privateKeyFile string, baseUrl string, httpClient *http.Client) (*appTokenSource, error) {
+	privateKey, err := readPrivateKey(privateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appTokenSource{
+		appId:          appId,
+		installationId: installationId,
+		privateKey:     privateKey,
+		baseUrl:        baseUrl,
+		httpClient:     httpClient,
+		now:            time.Now,
+	}, nil
+}
+
+func readPrivateKey(privateKeyFile string) (*rsa.PrivateKey, error) {
+	content, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read GitHub App private key: %w", err)
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("GitHub App private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse GitHub App private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// Token returns a cached installation token, exchanging a new app JWT for a
+// fresh one when the cached token is missing or close to expiring.
+func (s *appTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" && s.now().Add(tokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	jwt, err := s.createJWT()
+	if err != nil {
+		return "", err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%s/access_tokens", s.baseUrl, s.installationId)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to create installation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("unable to create installation token: unexpected status %d", resp.StatusCode)
+	}
+
+	result := installationTokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("unable to read installation token: %w", err)
+	}
+
+	s.token = result.Token
+	s.expiresAt = result.ExpiresAt
+
+	return s.token, nil
+}
+
+func (s *appTokenSource) createJWT() (string, error) {
+	now := s.now()
+	header, _ := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	// iat is backdated to tolerate clock drift, GitHub rejects exp over 10 minutes
+	claims, _ := json.Marshal(map[string]any{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": s.appId,
+	})
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", fmt.Errorf("unable to sign GitHub App JWT: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,123 @@
+package jobs
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestPrivateKey(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	file := filepath.Join(t.TempDir(), "app.pem")
+	content := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	assert.Nil(t, os.WriteFile(file, content, 0600))
+
+	return key, file
+}
+
+func startTokenServer(t *testing.T, key *rsa.PublicKey, status int, calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/app/installations/99/access_tokens", r.URL.Path)
+
+		jwt, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		assert.True(t, ok)
+
+		parts := strings.Split(jwt, ".")
+		assert.Len(t, parts, 3)
+
+		hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+		assert.Nil(t, rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature))
+
+		claimsJson, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		claims := map[string]any{}
+		assert.Nil(t, json.Unmarshal(claimsJson, &claims))
+		assert.Equal(t, "12", claims["iss"])
+
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(installationTokenResponse{
+			Token:     fmt.Sprintf("token-%d", *calls),
+			ExpiresAt: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+		})
+	}))
+}
+
+func TestAppTokenSourceCachesToken(t *testing.T) {
+	key, file := writeTestPrivateKey(t)
+	calls := 0
+	server := startTokenServer(t, &key.PublicKey, http.StatusCreated, &calls)
+	defer server.Close()
+
+	source, err := newAppTokenSource("12", "99", file, server.URL, server.Client())
+	assert.Nil(t, err)
+	source.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	first, err := source.Token(context.Background())
+	assert.Nil(t, err)
+	second, err := source.Token(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, "token-1", first)
+	assert.Equal(t, "token-1", second)
+	assert.Equal(t, 1, calls)
+}
+
+func TestAppTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	key, file := writeTestPrivateKey(t)
+	calls := 0
+	server := startTokenServer(t, &key.PublicKey, http.StatusCreated, &calls)
+	defer server.Close()
+
+	source, err := newAppTokenSource("12", "99", file, server.URL, server.Client())
+	assert.Nil(t, err)
+	source.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	first, _ := source.Token(context.Background())
+	source.now = func() time.Time { return time.Date(2024, 1, 1, 0, 56, 0, 0, time.UTC) }
+	second, err := source.Token(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", first)
+	assert.Equal(t, "token-2", second)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAppTokenSourceError(t *testing.T) {
+	key, file := writeTestPrivateKey(t)
+	calls := 0
+	server := startTokenServer(t, &key.PublicKey, http.StatusUnauthorized, &calls)
+	defer server.Close()
+
+	source, err := newAppTokenSource("12", "99", file, server.URL, server.Client())
+	assert.Nil(t, err)
+
+	_, err = source.Token(context.Background())
+
+	assert.EqualError(t, err, "unable to create installation token: unexpected status 401")
+}
+
+func TestAppTokenSourceMissingKey(t *testing.T) {
+	_, err := newAppTokenSource("12", "99", filepath.Join(t.TempDir(), "missing.pem"), "", http.DefaultClient)
+
+	assert.ErrorContains(t, err, "unable to read GitHub App private key")
+}
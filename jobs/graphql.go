@@ -11,1120 +11,895 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
-// ProjectFields includes the GraphQL fields of ProjectV2 requested by the fragment ProjectFields.
+// IssueTimelineEvent includes the requested fields of the GraphQL interface IssueTimelineItems.
+//
+// IssueTimelineEvent is implemented by the following types:
+// IssueTimelineEventAddedToProjectEvent
+// IssueTimelineEventAssignedEvent
+// IssueTimelineEventClosedEvent
+// IssueTimelineEventCommentDeletedEvent
+// IssueTimelineEventConnectedEvent
+// IssueTimelineEventConvertedNoteToIssueEvent
+// IssueTimelineEventConvertedToDiscussionEvent
+// IssueTimelineEventCrossReferencedEvent
+// IssueTimelineEventDemilestonedEvent
+// IssueTimelineEventDisconnectedEvent
+// IssueTimelineEventIssueComment
+// IssueTimelineEventLabeledEvent
+// IssueTimelineEventLockedEvent
+// IssueTimelineEventMarkedAsDuplicateEvent
+// IssueTimelineEventMentionedEvent
+// IssueTimelineEventMilestonedEvent
+// IssueTimelineEventMovedColumnsInProjectEvent
+// IssueTimelineEventPinnedEvent
+// IssueTimelineEventReferencedEvent
+// IssueTimelineEventRemovedFromProjectEvent
+// IssueTimelineEventRenamedTitleEvent
+// IssueTimelineEventReopenedEvent
+// IssueTimelineEventSubscribedEvent
+// IssueTimelineEventTransferredEvent
+// IssueTimelineEventUnassignedEvent
+// IssueTimelineEventUnlabeledEvent
+// IssueTimelineEventUnlockedEvent
+// IssueTimelineEventUnmarkedAsDuplicateEvent
+// IssueTimelineEventUnpinnedEvent
+// IssueTimelineEventUnsubscribedEvent
+// IssueTimelineEventUserBlockedEvent
 // The GraphQL type's documentation follows.
 //
-// New projects that manage issues, pull requests and drafts using tables and boards.
-type ProjectFields struct {
-	// The Node ID of the ProjectV2 object
-	Id string `json:"id"`
-	// The project's name.
-	Title string `json:"title"`
-	// A field of the project
-	Status ProjectFieldsStatusProjectV2FieldConfiguration `json:"-"`
-	// A field of the project
-	Iteration ProjectFieldsIterationProjectV2FieldConfiguration `json:"-"`
-	// List of items in the project
-	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
-}
-
-// GetId returns ProjectFields.Id, and is useful for accessing the field via an interface.
-func (v *ProjectFields) GetId() string { return v.Id }
-
-// GetTitle returns ProjectFields.Title, and is useful for accessing the field via an interface.
-func (v *ProjectFields) GetTitle() string { return v.Title }
-
-// GetStatus returns ProjectFields.Status, and is useful for accessing the field via an interface.
-func (v *ProjectFields) GetStatus() ProjectFieldsStatusProjectV2FieldConfiguration { return v.Status }
-
-// GetIteration returns ProjectFields.Iteration, and is useful for accessing the field via an interface.
-func (v *ProjectFields) GetIteration() ProjectFieldsIterationProjectV2FieldConfiguration {
-	return v.Iteration
+// An item in an issue timeline
+type IssueTimelineEvent interface {
+	implementsGraphQLInterfaceIssueTimelineEvent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-// GetItems returns ProjectFields.Items, and is useful for accessing the field via an interface.
-func (v *ProjectFields) GetItems() ProjectFieldsItemsProjectV2ItemConnection { return v.Items }
-
-func (v *ProjectFields) UnmarshalJSON(b []byte) error {
-
+func (v *IssueTimelineEventAddedToProjectEvent) implementsGraphQLInterfaceIssueTimelineEvent() {}
+func (v *IssueTimelineEventAssignedEvent) implementsGraphQLInterfaceIssueTimelineEvent()       {}
+func (v *IssueTimelineEventClosedEvent) implementsGraphQLInterfaceIssueTimelineEvent()         {}
+func (v *IssueTimelineEventCommentDeletedEvent) implementsGraphQLInterfaceIssueTimelineEvent() {}
+func (v *IssueTimelineEventConnectedEvent) implementsGraphQLInterfaceIssueTimelineEvent()      {}
+func (v *IssueTimelineEventConvertedNoteToIssueEvent) implementsGraphQLInterfaceIssueTimelineEvent() {
+}
+func (v *IssueTimelineEventConvertedToDiscussionEvent) implementsGraphQLInterfaceIssueTimelineEvent() {
+}
+func (v *IssueTimelineEventCrossReferencedEvent) implementsGraphQLInterfaceIssueTimelineEvent()   {}
+func (v *IssueTimelineEventDemilestonedEvent) implementsGraphQLInterfaceIssueTimelineEvent()      {}
+func (v *IssueTimelineEventDisconnectedEvent) implementsGraphQLInterfaceIssueTimelineEvent()      {}
+func (v *IssueTimelineEventIssueComment) implementsGraphQLInterfaceIssueTimelineEvent()           {}
+func (v *IssueTimelineEventLabeledEvent) implementsGraphQLInterfaceIssueTimelineEvent()           {}
+func (v *IssueTimelineEventLockedEvent) implementsGraphQLInterfaceIssueTimelineEvent()            {}
+func (v *IssueTimelineEventMarkedAsDuplicateEvent) implementsGraphQLInterfaceIssueTimelineEvent() {}
+func (v *IssueTimelineEventMentionedEvent) implementsGraphQLInterfaceIssueTimelineEvent()         {}
+func (v *IssueTimelineEventMilestonedEvent) implementsGraphQLInterfaceIssueTimelineEvent()        {}
+func (v *IssueTimelineEventMovedColumnsInProjectEvent) implementsGraphQLInterfaceIssueTimelineEvent() {
+}
+func (v *IssueTimelineEventPinnedEvent) implementsGraphQLInterfaceIssueTimelineEvent()              {}
+func (v *IssueTimelineEventReferencedEvent) implementsGraphQLInterfaceIssueTimelineEvent()          {}
+func (v *IssueTimelineEventRemovedFromProjectEvent) implementsGraphQLInterfaceIssueTimelineEvent()  {}
+func (v *IssueTimelineEventRenamedTitleEvent) implementsGraphQLInterfaceIssueTimelineEvent()        {}
+func (v *IssueTimelineEventReopenedEvent) implementsGraphQLInterfaceIssueTimelineEvent()            {}
+func (v *IssueTimelineEventSubscribedEvent) implementsGraphQLInterfaceIssueTimelineEvent()          {}
+func (v *IssueTimelineEventTransferredEvent) implementsGraphQLInterfaceIssueTimelineEvent()         {}
+func (v *IssueTimelineEventUnassignedEvent) implementsGraphQLInterfaceIssueTimelineEvent()          {}
+func (v *IssueTimelineEventUnlabeledEvent) implementsGraphQLInterfaceIssueTimelineEvent()           {}
+func (v *IssueTimelineEventUnlockedEvent) implementsGraphQLInterfaceIssueTimelineEvent()            {}
+func (v *IssueTimelineEventUnmarkedAsDuplicateEvent) implementsGraphQLInterfaceIssueTimelineEvent() {}
+func (v *IssueTimelineEventUnpinnedEvent) implementsGraphQLInterfaceIssueTimelineEvent()            {}
+func (v *IssueTimelineEventUnsubscribedEvent) implementsGraphQLInterfaceIssueTimelineEvent()        {}
+func (v *IssueTimelineEventUserBlockedEvent) implementsGraphQLInterfaceIssueTimelineEvent()         {}
+
+func __unmarshalIssueTimelineEvent(b []byte, v *IssueTimelineEvent) error {
 	if string(b) == "null" {
 		return nil
 	}
 
-	var firstPass struct {
-		*ProjectFields
-		Status    json.RawMessage `json:"status"`
-		Iteration json.RawMessage `json:"iteration"`
-		graphql.NoUnmarshalJSON
+	var tn struct {
+		TypeName string `json:"__typename"`
 	}
-	firstPass.ProjectFields = v
-
-	err := json.Unmarshal(b, &firstPass)
+	err := json.Unmarshal(b, &tn)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.Status
-		src := firstPass.Status
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsStatusProjectV2FieldConfiguration(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFields.Status: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.Iteration
-		src := firstPass.Iteration
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsIterationProjectV2FieldConfiguration(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFields.Iteration: %w", err)
-			}
-		}
+	switch tn.TypeName {
+	case "AddedToProjectEvent":
+		*v = new(IssueTimelineEventAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(IssueTimelineEventAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(IssueTimelineEventClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(IssueTimelineEventCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(IssueTimelineEventConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(IssueTimelineEventConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(IssueTimelineEventConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(IssueTimelineEventCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(IssueTimelineEventDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(IssueTimelineEventDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(IssueTimelineEventIssueComment)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(IssueTimelineEventLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(IssueTimelineEventLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(IssueTimelineEventMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(IssueTimelineEventMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(IssueTimelineEventMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(IssueTimelineEventMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(IssueTimelineEventPinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(IssueTimelineEventReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(IssueTimelineEventRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(IssueTimelineEventRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(IssueTimelineEventReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(IssueTimelineEventSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(IssueTimelineEventTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(IssueTimelineEventUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(IssueTimelineEventUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(IssueTimelineEventUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(IssueTimelineEventUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(IssueTimelineEventUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(IssueTimelineEventUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(IssueTimelineEventUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing IssueTimelineItems.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for IssueTimelineEvent: "%v"`, tn.TypeName)
 	}
-	return nil
 }
 
-type __premarshalProjectFields struct {
-	Id string `json:"id"`
-
-	Title string `json:"title"`
+func __marshalIssueTimelineEvent(v *IssueTimelineEvent) ([]byte, error) {
 
-	Status json.RawMessage `json:"status"`
+	var typename string
+	switch v := (*v).(type) {
+	case *IssueTimelineEventAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
 
-	Iteration json.RawMessage `json:"iteration"`
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventAssignedEvent:
+		typename = "AssignedEvent"
 
-	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventClosedEvent:
+		typename = "ClosedEvent"
 
-func (v *ProjectFields) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
 
-func (v *ProjectFields) __premarshalJSON() (*__premarshalProjectFields, error) {
-	var retval __premarshalProjectFields
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventConnectedEvent:
+		typename = "ConnectedEvent"
 
-	retval.Id = v.Id
-	retval.Title = v.Title
-	{
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
 
-		dst := &retval.Status
-		src := v.Status
-		var err error
-		*dst, err = __marshalProjectFieldsStatusProjectV2FieldConfiguration(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFields.Status: %w", err)
-		}
-	}
-	{
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
 
-		dst := &retval.Iteration
-		src := v.Iteration
-		var err error
-		*dst, err = __marshalProjectFieldsIterationProjectV2FieldConfiguration(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFields.Iteration: %w", err)
-		}
-	}
-	retval.Items = v.Items
-	return &retval, nil
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
 
-// ProjectFieldsItemsProjectV2ItemConnection includes the requested fields of the GraphQL type ProjectV2ItemConnection.
-// The GraphQL type's documentation follows.
-//
-// The connection type for ProjectV2Item.
-type ProjectFieldsItemsProjectV2ItemConnection struct {
-	// Information to aid in pagination.
-	PageInfo ProjectFieldsItemsProjectV2ItemConnectionPageInfo `json:"pageInfo"`
-	// A list of nodes.
-	Nodes []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item `json:"nodes"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventDemilestonedEvent:
+		typename = "DemilestonedEvent"
 
-// GetPageInfo returns ProjectFieldsItemsProjectV2ItemConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnection) GetPageInfo() ProjectFieldsItemsProjectV2ItemConnectionPageInfo {
-	return v.PageInfo
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventDisconnectedEvent:
+		typename = "DisconnectedEvent"
 
-// GetNodes returns ProjectFieldsItemsProjectV2ItemConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnection) GetNodes() []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item {
-	return v.Nodes
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventIssueComment:
+		typename = "IssueComment"
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item includes the requested fields of the GraphQL type ProjectV2Item.
-// The GraphQL type's documentation follows.
-//
-// An item within a Project.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item struct {
-	// The Node ID of the ProjectV2Item object
-	Id string `json:"id"`
-	// The field value of the first project field which matches the 'name' argument that is set on the item.
-	Status ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue `json:"-"`
-	// The field value of the first project field which matches the 'name' argument that is set on the item.
-	Effort ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue `json:"-"`
-	// The field value of the first project field which matches the 'name' argument that is set on the item.
-	Remaining ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue `json:"-"`
-	// The field value of the first project field which matches the 'name' argument that is set on the item.
-	Iteration ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue `json:"-"`
-	// The content of the referenced draft issue, issue, or pull request
-	Content ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent `json:"-"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventLabeledEvent:
+		typename = "LabeledEvent"
 
-// GetId returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Id, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetId() string { return v.Id }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventLockedEvent:
+		typename = "LockedEvent"
 
-// GetStatus returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Status, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetStatus() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue {
-	return v.Status
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
 
-// GetEffort returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Effort, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetEffort() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue {
-	return v.Effort
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventMentionedEvent:
+		typename = "MentionedEvent"
 
-// GetRemaining returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Remaining, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetRemaining() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue {
-	return v.Remaining
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventMilestonedEvent:
+		typename = "MilestonedEvent"
 
-// GetIteration returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Iteration, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetIteration() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue {
-	return v.Iteration
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
 
-// GetContent returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Content, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) GetContent() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent {
-	return v.Content
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventPinnedEvent:
+		typename = "PinnedEvent"
 
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) UnmarshalJSON(b []byte) error {
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventPinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventReferencedEvent:
+		typename = "ReferencedEvent"
 
-	if string(b) == "null" {
-		return nil
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
 
-	var firstPass struct {
-		*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item
-		Status    json.RawMessage `json:"status"`
-		Effort    json.RawMessage `json:"effort"`
-		Remaining json.RawMessage `json:"remaining"`
-		Iteration json.RawMessage `json:"iteration"`
-		Content   json.RawMessage `json:"content"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item = v
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventReopenedEvent:
+		typename = "ReopenedEvent"
 
-	{
-		dst := &v.Status
-		src := firstPass.Status
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Status: %w", err)
-			}
-		}
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventSubscribedEvent:
+		typename = "SubscribedEvent"
 
-	{
-		dst := &v.Effort
-		src := firstPass.Effort
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Effort: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.Remaining
-		src := firstPass.Remaining
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Remaining: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.Iteration
-		src := firstPass.Iteration
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Iteration: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.Content
-		src := firstPass.Content
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Content: %w", err)
-			}
-		}
-	}
-	return nil
-}
-
-type __premarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item struct {
-	Id string `json:"id"`
-
-	Status json.RawMessage `json:"status"`
-
-	Effort json.RawMessage `json:"effort"`
-
-	Remaining json.RawMessage `json:"remaining"`
-
-	Iteration json.RawMessage `json:"iteration"`
-
-	Content json.RawMessage `json:"content"`
-}
-
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item) __premarshalJSON() (*__premarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item, error) {
-	var retval __premarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item
-
-	retval.Id = v.Id
-	{
-
-		dst := &retval.Status
-		src := v.Status
-		var err error
-		*dst, err = __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Status: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.Effort
-		src := v.Effort
-		var err error
-		*dst, err = __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Effort: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.Remaining
-		src := v.Remaining
-		var err error
-		*dst, err = __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Remaining: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.Iteration
-		src := v.Iteration
-		var err error
-		*dst, err = __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Iteration: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.Content
-		src := v.Content
-		var err error
-		*dst, err = __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2Item.Content: %w", err)
-		}
-	}
-	return &retval, nil
-}
-
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent includes the requested fields of the GraphQL interface ProjectV2ItemContent.
-//
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent is implemented by the following types:
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest
-// The GraphQL type's documentation follows.
-//
-// Types that can be inside Project Items.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent interface {
-	implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
-}
-
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent() {
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventTransferredEvent:
+		typename = "TransferredEvent"
 
-func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent(b []byte, v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent) error {
-	if string(b) == "null" {
-		return nil
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventUnassignedEvent:
+		typename = "UnassignedEvent"
 
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventUnlabeledEvent:
+		typename = "UnlabeledEvent"
 
-	switch tn.TypeName {
-	case "DraftIssue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue)
-		return json.Unmarshal(b, *v)
-	case "Issue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue)
-		return json.Unmarshal(b, *v)
-	case "PullRequest":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing ProjectV2ItemContent.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent: "%v"`, tn.TypeName)
-	}
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventUnlockedEvent:
+		typename = "UnlockedEvent"
 
-func __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent(v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent) ([]byte, error) {
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
 
-	var typename string
-	switch v := (*v).(type) {
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue:
-		typename = "DraftIssue"
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IssueTimelineEventUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *IssueTimelineEventUnpinnedEvent:
+		typename = "UnpinnedEvent"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue
+			*IssueTimelineEventUnpinnedEvent
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue:
-		typename = "Issue"
+	case *IssueTimelineEventUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue
+			*IssueTimelineEventUnsubscribedEvent
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest:
-		typename = "PullRequest"
+	case *IssueTimelineEventUserBlockedEvent:
+		typename = "UserBlockedEvent"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest
+			*IssueTimelineEventUserBlockedEvent
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContent: "%T"`, v)
+			`unexpected concrete type for IssueTimelineEvent: "%T"`, v)
 	}
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// IssueTimelineEventAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
 // The GraphQL type's documentation follows.
 //
-// A draft issue within a project.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue struct {
+// Represents a 'added_to_project' event on a given issue or pull request.
+type IssueTimelineEventAddedToProjectEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentDraftIssue) GetTypename() string {
-	return v.Typename
+// GetTypename returns IssueTimelineEventAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// IssueTimelineEventAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type IssueTimelineEventAssignedEvent struct {
+	Typename string `json:"__typename"`
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue includes the requested fields of the GraphQL type Issue.
+// GetTypename returns IssueTimelineEventAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventAssignedEvent) GetTypename() string { return v.Typename }
+
+// IssueTimelineEventClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
 // The GraphQL type's documentation follows.
 //
-// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue struct {
+// Represents a 'closed' event on any `Closable`.
+type IssueTimelineEventClosedEvent struct {
 	Typename string `json:"__typename"`
-	// Identifies the issue title.
-	Title string `json:"title"`
 	// Identifies the date and time when the object was created.
 	CreatedAt time.Time `json:"createdAt"`
-	// Identifies the date and time when the object was closed.
-	ClosedAt time.Time `json:"closedAt"`
-	// A list of labels associated with the object.
-	Labels ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection `json:"labels"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) GetTypename() string {
-	return v.Typename
-}
-
-// GetTitle returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue.Title, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) GetTitle() string {
-	return v.Title
-}
+// GetTypename returns IssueTimelineEventClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventClosedEvent) GetTypename() string { return v.Typename }
 
-// GetCreatedAt returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+// GetCreatedAt returns IssueTimelineEventClosedEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventClosedEvent) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetClosedAt returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue.ClosedAt, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) GetClosedAt() time.Time {
-	return v.ClosedAt
+// IssueTimelineEventCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type IssueTimelineEventCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
 }
 
-// GetLabels returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue.Labels, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssue) GetLabels() ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection {
-	return v.Labels
-}
+// GetTypename returns IssueTimelineEventCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventCommentDeletedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
+// IssueTimelineEventConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
 // The GraphQL type's documentation follows.
 //
-// The connection type for Label.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection struct {
-	// A list of nodes.
-	Nodes []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel `json:"nodes"`
+// Represents a 'connected' event on a given issue or pull request.
+type IssueTimelineEventConnectedEvent struct {
+	Typename string `json:"__typename"`
 }
 
-// GetNodes returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnection) GetNodes() []ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel {
-	return v.Nodes
-}
+// GetTypename returns IssueTimelineEventConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventConnectedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
+// IssueTimelineEventConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
 // The GraphQL type's documentation follows.
 //
-// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel struct {
-	// Identifies the label name.
-	Name string `json:"name"`
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type IssueTimelineEventConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentIssueLabelsLabelConnectionNodesLabel) GetName() string {
-	return v.Name
-}
+// GetTypename returns IssueTimelineEventConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventConvertedNoteToIssueEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest includes the requested fields of the GraphQL type PullRequest.
+// IssueTimelineEventConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
 // The GraphQL type's documentation follows.
 //
-// A repository pull request.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest struct {
+// Represents a 'converted_to_discussion' event on a given issue.
+type IssueTimelineEventConvertedToDiscussionEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemContentPullRequest) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventConvertedToDiscussionEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// IssueTimelineEventCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a date field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue struct {
+// Represents a mention made by one issue or pull request to another.
+type IssueTimelineEventCrossReferencedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventCrossReferencedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// IssueTimelineEventDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of an iteration field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue struct {
+// Represents a 'demilestoned' event on a given issue or pull request.
+type IssueTimelineEventDemilestonedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventDemilestonedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue includes the requested fields of the GraphQL type ProjectV2ItemFieldLabelValue.
+// IssueTimelineEventDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of the labels field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue struct {
+// Represents a 'disconnected' event on a given issue or pull request.
+type IssueTimelineEventDisconnectedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventDisconnectedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue includes the requested fields of the GraphQL type ProjectV2ItemFieldMilestoneValue.
+// IssueTimelineEventIssueComment includes the requested fields of the GraphQL type IssueComment.
 // The GraphQL type's documentation follows.
 //
-// The value of a milestone field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue struct {
+// Represents a comment on an Issue.
+type IssueTimelineEventIssueComment struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns IssueTimelineEventIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventIssueComment) GetTypename() string { return v.Typename }
+
+// IssueTimelineEventLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type IssueTimelineEventLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// GetName returns IssueTimelineEventLabel.Name, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventLabel) GetName() string { return v.Name }
+
+// IssueTimelineEventLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a number field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue struct {
+// Represents a 'labeled' event on a given issue or pull request.
+type IssueTimelineEventLabeledEvent struct {
 	Typename string `json:"__typename"`
-	// Number as a float(8)
-	Number float64 `json:"number"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the label associated with the 'labeled' event.
+	Label IssueTimelineEventLabel `json:"label"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventLabeledEvent) GetTypename() string { return v.Typename }
 
-// GetNumber returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue.Number, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue) GetNumber() float64 {
-	return v.Number
-}
+// GetCreatedAt returns IssueTimelineEventLabeledEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventLabeledEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetLabel returns IssueTimelineEventLabeledEvent.Label, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventLabeledEvent) GetLabel() IssueTimelineEventLabel { return v.Label }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue includes the requested fields of the GraphQL type ProjectV2ItemFieldPullRequestValue.
+// IssueTimelineEventLockedEvent includes the requested fields of the GraphQL type LockedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a pull request field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue struct {
+// Represents a 'locked' event on a given issue or pull request.
+type IssueTimelineEventLockedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventLockedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue includes the requested fields of the GraphQL type ProjectV2ItemFieldRepositoryValue.
+// IssueTimelineEventMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a repository field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue struct {
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type IssueTimelineEventMarkedAsDuplicateEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventMarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue includes the requested fields of the GraphQL type ProjectV2ItemFieldReviewerValue.
+// IssueTimelineEventMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a reviewers field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue struct {
+// Represents a 'mentioned' event on a given issue or pull request.
+type IssueTimelineEventMentionedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventMentionedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// IssueTimelineEventMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a single select field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue struct {
+// Represents a 'milestoned' event on a given issue or pull request.
+type IssueTimelineEventMilestonedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventMilestonedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// IssueTimelineEventMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a text field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue struct {
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type IssueTimelineEventMovedColumnsInProjectEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventMovedColumnsInProjectEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue includes the requested fields of the GraphQL type ProjectV2ItemFieldUserValue.
+// IssueTimelineEventPinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a user field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue struct {
+// Represents a 'pinned' event on a given issue or pull request.
+type IssueTimelineEventPinnedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventPinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventPinnedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue includes the requested fields of the GraphQL interface ProjectV2ItemFieldValue.
-//
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue is implemented by the following types:
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue
+// IssueTimelineEventReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
 // The GraphQL type's documentation follows.
 //
-// Project field values
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue interface {
-	implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type IssueTimelineEventReferencedEvent struct {
+	Typename string `json:"__typename"`
 }
 
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue() {
-}
-
-func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue(b []byte, v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue) error {
-	if string(b) == "null" {
-		return nil
-	}
-
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
+// GetTypename returns IssueTimelineEventReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventReferencedEvent) GetTypename() string { return v.Typename }
 
-	switch tn.TypeName {
-	case "ProjectV2ItemFieldDateValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldIterationValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldLabelValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldMilestoneValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldNumberValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldPullRequestValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldRepositoryValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldReviewerValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldSingleSelectValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldTextValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldUserValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing ProjectV2ItemFieldValue.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue: "%v"`, tn.TypeName)
-	}
+// IssueTimelineEventRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type IssueTimelineEventRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
 }
 
-func __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue(v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue) ([]byte, error) {
-
-	var typename string
-	switch v := (*v).(type) {
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue:
-		typename = "ProjectV2ItemFieldDateValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldDateValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue:
-		typename = "ProjectV2ItemFieldIterationValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldIterationValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue:
-		typename = "ProjectV2ItemFieldLabelValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldLabelValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue:
-		typename = "ProjectV2ItemFieldMilestoneValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldMilestoneValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue:
-		typename = "ProjectV2ItemFieldNumberValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldNumberValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue:
-		typename = "ProjectV2ItemFieldPullRequestValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldPullRequestValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue:
-		typename = "ProjectV2ItemFieldRepositoryValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldRepositoryValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue:
-		typename = "ProjectV2ItemFieldReviewerValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldReviewerValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue:
-		typename = "ProjectV2ItemFieldSingleSelectValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldSingleSelectValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue:
-		typename = "ProjectV2ItemFieldTextValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldTextValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue:
-		typename = "ProjectV2ItemFieldUserValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldUserValue
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemEffortProjectV2ItemFieldValue: "%T"`, v)
-	}
-}
+// GetTypename returns IssueTimelineEventRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventRemovedFromProjectEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// IssueTimelineEventRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a date field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue struct {
+// Represents a 'renamed' event on a given issue or pull request
+type IssueTimelineEventRenamedTitleEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventRenamedTitleEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// IssueTimelineEventReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of an iteration field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue struct {
+// Represents a 'reopened' event on any `Closable`.
+type IssueTimelineEventReopenedEvent struct {
 	Typename string `json:"__typename"`
-	// The ID of the iteration.
-	IterationId string `json:"iterationId"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventReopenedEvent) GetTypename() string { return v.Typename }
 
-// GetIterationId returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue.IterationId, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue) GetIterationId() string {
-	return v.IterationId
-}
+// GetCreatedAt returns IssueTimelineEventReopenedEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventReopenedEvent) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue includes the requested fields of the GraphQL type ProjectV2ItemFieldLabelValue.
+// IssueTimelineEventSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of the labels field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue struct {
+// Represents a 'subscribed' event on a given `Subscribable`.
+type IssueTimelineEventSubscribedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventSubscribedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue includes the requested fields of the GraphQL type ProjectV2ItemFieldMilestoneValue.
+// IssueTimelineEventTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a milestone field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue struct {
+// Represents a 'transferred' event on a given issue or pull request.
+type IssueTimelineEventTransferredEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventTransferredEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// IssueTimelineEventUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a number field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue struct {
+// Represents an 'unassigned' event on any assignable object.
+type IssueTimelineEventUnassignedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnassignedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue includes the requested fields of the GraphQL type ProjectV2ItemFieldPullRequestValue.
+// IssueTimelineEventUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a pull request field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue struct {
+// Represents an 'unlabeled' event on a given issue or pull request.
+type IssueTimelineEventUnlabeledEvent struct {
 	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the label associated with the 'unlabeled' event.
+	Label IssueTimelineEventLabel `json:"label"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// GetCreatedAt returns IssueTimelineEventUnlabeledEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnlabeledEvent) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue includes the requested fields of the GraphQL type ProjectV2ItemFieldRepositoryValue.
+// GetLabel returns IssueTimelineEventUnlabeledEvent.Label, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnlabeledEvent) GetLabel() IssueTimelineEventLabel { return v.Label }
+
+// IssueTimelineEventUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a repository field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue struct {
+// Represents an 'unlocked' event on a given issue or pull request.
+type IssueTimelineEventUnlockedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnlockedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue includes the requested fields of the GraphQL type ProjectV2ItemFieldReviewerValue.
+// IssueTimelineEventUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a reviewers field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue struct {
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type IssueTimelineEventUnmarkedAsDuplicateEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnmarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// IssueTimelineEventUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a single select field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue struct {
+// Represents an 'unpinned' event on a given issue or pull request.
+type IssueTimelineEventUnpinnedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnpinnedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// IssueTimelineEventUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a text field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue struct {
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type IssueTimelineEventUnsubscribedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns IssueTimelineEventUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUnsubscribedEvent) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue includes the requested fields of the GraphQL type ProjectV2ItemFieldUserValue.
+// IssueTimelineEventUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
 // The GraphQL type's documentation follows.
 //
-// The value of a user field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue struct {
+// Represents a 'user_blocked' event on a given user.
+type IssueTimelineEventUserBlockedEvent struct {
 	Typename string `json:"__typename"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns IssueTimelineEventUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *IssueTimelineEventUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// The possible states of a milestone.
+type MilestoneState string
+
+const (
+	// A milestone that has been closed.
+	MilestoneStateClosed MilestoneState = "CLOSED"
+	// A milestone that is still open.
+	MilestoneStateOpen MilestoneState = "OPEN"
+)
+
+// OrganizationProject includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type OrganizationProject struct {
+	// The project's number.
+	Number int `json:"number"`
+	// The project's name.
+	Title string `json:"title"`
+	// Returns true if the project is closed.
+	Closed bool `json:"closed"`
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue includes the requested fields of the GraphQL interface ProjectV2ItemFieldValue.
+// GetNumber returns OrganizationProject.Number, and is useful for accessing the field via an interface.
+func (v *OrganizationProject) GetNumber() int { return v.Number }
+
+// GetTitle returns OrganizationProject.Title, and is useful for accessing the field via an interface.
+func (v *OrganizationProject) GetTitle() string { return v.Title }
+
+// GetClosed returns OrganizationProject.Closed, and is useful for accessing the field via an interface.
+func (v *OrganizationProject) GetClosed() bool { return v.Closed }
+
+// ProjectField includes the requested fields of the GraphQL interface ProjectV2FieldConfiguration.
 //
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue is implemented by the following types:
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue
+// ProjectField is implemented by the following types:
+// ProjectFieldProjectV2Field
+// ProjectFieldProjectV2IterationField
+// ProjectFieldProjectV2SingleSelectField
 // The GraphQL type's documentation follows.
 //
-// Project field values
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue interface {
-	implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue()
+// Configurations for project fields.
+type ProjectField interface {
+	implementsGraphQLInterfaceProjectField()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 }
 
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue() {
-}
+func (v *ProjectFieldProjectV2Field) implementsGraphQLInterfaceProjectField()             {}
+func (v *ProjectFieldProjectV2IterationField) implementsGraphQLInterfaceProjectField()    {}
+func (v *ProjectFieldProjectV2SingleSelectField) implementsGraphQLInterfaceProjectField() {}
 
-func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue(b []byte, v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue) error {
+func __unmarshalProjectField(b []byte, v *ProjectField) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -1138,697 +913,799 @@ func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemItera
 	}
 
 	switch tn.TypeName {
-	case "ProjectV2ItemFieldDateValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldIterationValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldLabelValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldMilestoneValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldNumberValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldPullRequestValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldRepositoryValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldReviewerValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldSingleSelectValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue)
+	case "ProjectV2Field":
+		*v = new(ProjectFieldProjectV2Field)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldTextValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue)
+	case "ProjectV2IterationField":
+		*v = new(ProjectFieldProjectV2IterationField)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldUserValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue)
+	case "ProjectV2SingleSelectField":
+		*v = new(ProjectFieldProjectV2SingleSelectField)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing ProjectV2ItemFieldValue.__typename")
+			"response was missing ProjectV2FieldConfiguration.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue: "%v"`, tn.TypeName)
+			`unexpected concrete type for ProjectField: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue(v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue) ([]byte, error) {
+func __marshalProjectField(v *ProjectField) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue:
-		typename = "ProjectV2ItemFieldDateValue"
+	case *ProjectFieldProjectV2Field:
+		typename = "ProjectV2Field"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldDateValue
+			*ProjectFieldProjectV2Field
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue:
-		typename = "ProjectV2ItemFieldIterationValue"
+	case *ProjectFieldProjectV2IterationField:
+		typename = "ProjectV2IterationField"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldIterationValue
+			*ProjectFieldProjectV2IterationField
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue:
-		typename = "ProjectV2ItemFieldLabelValue"
+	case *ProjectFieldProjectV2SingleSelectField:
+		typename = "ProjectV2SingleSelectField"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldLabelValue
+			*ProjectFieldProjectV2SingleSelectField
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue:
-		typename = "ProjectV2ItemFieldMilestoneValue"
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ProjectField: "%T"`, v)
+	}
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldMilestoneValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue:
-		typename = "ProjectV2ItemFieldNumberValue"
+// ProjectFieldConfigurationProjectV2IterationFieldConfiguration includes the requested fields of the GraphQL type ProjectV2IterationFieldConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Iteration field configuration for a project.
+type ProjectFieldConfigurationProjectV2IterationFieldConfiguration struct {
+	// The iteration's iterations
+	Iterations []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration `json:"iterations"`
+	// The iteration's completed iterations
+	CompletedIterations []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration `json:"completedIterations"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldNumberValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue:
-		typename = "ProjectV2ItemFieldPullRequestValue"
+// GetIterations returns ProjectFieldConfigurationProjectV2IterationFieldConfiguration.Iterations, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfiguration) GetIterations() []ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration {
+	return v.Iterations
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldPullRequestValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue:
-		typename = "ProjectV2ItemFieldRepositoryValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldRepositoryValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue:
-		typename = "ProjectV2ItemFieldReviewerValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldReviewerValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue:
-		typename = "ProjectV2ItemFieldSingleSelectValue"
+// GetCompletedIterations returns ProjectFieldConfigurationProjectV2IterationFieldConfiguration.CompletedIterations, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfiguration) GetCompletedIterations() []ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration {
+	return v.CompletedIterations
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldSingleSelectValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue:
-		typename = "ProjectV2ItemFieldTextValue"
+// ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration includes the requested fields of the GraphQL type ProjectV2IterationFieldIteration.
+// The GraphQL type's documentation follows.
+//
+// Iteration field iteration settings for a project.
+type ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration struct {
+	// The iteration's ID.
+	Id string `json:"id"`
+	// The iteration's title.
+	Title string `json:"title"`
+	// The iteration's start date
+	StartDate string `json:"startDate"`
+	// The iteration's duration in days
+	Duration int `json:"duration"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldTextValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue:
-		typename = "ProjectV2ItemFieldUserValue"
+// GetId returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetId() string {
+	return v.Id
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldUserValue
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemIterationProjectV2ItemFieldValue: "%T"`, v)
-	}
+// GetTitle returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Title, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetTitle() string {
+	return v.Title
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
-// The GraphQL type's documentation follows.
-//
-// The value of a date field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue struct {
-	Typename string `json:"__typename"`
+// GetStartDate returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetStartDate() string {
+	return v.StartDate
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue) GetTypename() string {
-	return v.Typename
+// GetDuration returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Duration, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetDuration() int {
+	return v.Duration
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration includes the requested fields of the GraphQL type ProjectV2IterationFieldIteration.
 // The GraphQL type's documentation follows.
 //
-// The value of an iteration field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue struct {
-	Typename string `json:"__typename"`
+// Iteration field iteration settings for a project.
+type ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration struct {
+	// The iteration's ID.
+	Id string `json:"id"`
+	// The iteration's title.
+	Title string `json:"title"`
+	// The iteration's start date
+	StartDate string `json:"startDate"`
+	// The iteration's duration in days
+	Duration int `json:"duration"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue) GetTypename() string {
-	return v.Typename
+// GetId returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetId() string {
+	return v.Id
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue includes the requested fields of the GraphQL type ProjectV2ItemFieldLabelValue.
-// The GraphQL type's documentation follows.
-//
-// The value of the labels field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue struct {
-	Typename string `json:"__typename"`
+// GetTitle returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Title, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetTitle() string {
+	return v.Title
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue) GetTypename() string {
-	return v.Typename
+// GetStartDate returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetStartDate() string {
+	return v.StartDate
+}
+
+// GetDuration returns ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Duration, and is useful for accessing the field via an interface.
+func (v *ProjectFieldConfigurationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetDuration() int {
+	return v.Duration
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue includes the requested fields of the GraphQL type ProjectV2ItemFieldMilestoneValue.
+// ProjectFieldDefinitions includes the GraphQL fields of ProjectV2 requested by the fragment ProjectFieldDefinitions.
 // The GraphQL type's documentation follows.
 //
-// The value of a milestone field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue struct {
-	Typename string `json:"__typename"`
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type ProjectFieldDefinitions struct {
+	// The Node ID of the ProjectV2 object
+	Id string `json:"id"`
+	// The project's name.
+	Title string `json:"title"`
+	// List of fields and their constraints in the project
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue) GetTypename() string {
-	return v.Typename
+// GetId returns ProjectFieldDefinitions.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldDefinitions) GetId() string { return v.Id }
+
+// GetTitle returns ProjectFieldDefinitions.Title, and is useful for accessing the field via an interface.
+func (v *ProjectFieldDefinitions) GetTitle() string { return v.Title }
+
+// GetFields returns ProjectFieldDefinitions.Fields, and is useful for accessing the field via an interface.
+func (v *ProjectFieldDefinitions) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.Fields
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection includes the requested fields of the GraphQL type ProjectV2FieldConfigurationConnection.
 // The GraphQL type's documentation follows.
 //
-// The value of a number field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue struct {
-	Typename string `json:"__typename"`
-	// Number as a float(8)
-	Number float64 `json:"number"`
+// The connection type for ProjectV2FieldConfiguration.
+type ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection struct {
+	// A list of nodes.
+	Nodes []ProjectField `json:"-"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue) GetTypename() string {
-	return v.Typename
+// GetNodes returns ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection) GetNodes() []ProjectField {
+	return v.Nodes
 }
 
-// GetNumber returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue.Number, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue) GetNumber() float64 {
-	return v.Number
-}
+func (v *ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection) UnmarshalJSON(b []byte) error {
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue includes the requested fields of the GraphQL type ProjectV2ItemFieldPullRequestValue.
-// The GraphQL type's documentation follows.
-//
-// The value of a pull request field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue struct {
-	Typename string `json:"__typename"`
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]ProjectField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalProjectField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue) GetTypename() string {
-	return v.Typename
+type __premarshalProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue includes the requested fields of the GraphQL type ProjectV2ItemFieldRepositoryValue.
-// The GraphQL type's documentation follows.
-//
-// The value of a repository field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue struct {
-	Typename string `json:"__typename"`
+func (v *ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue) GetTypename() string {
-	return v.Typename
+func (v *ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection) __premarshalJSON() (*__premarshalProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection, error) {
+	var retval __premarshalProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalProjectField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue includes the requested fields of the GraphQL type ProjectV2ItemFieldReviewerValue.
+// ProjectFieldOptionsProjectV2SingleSelectFieldOption includes the requested fields of the GraphQL type ProjectV2SingleSelectFieldOption.
 // The GraphQL type's documentation follows.
 //
-// The value of a reviewers field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue struct {
-	Typename string `json:"__typename"`
+// Single select field option for a configuration for a project.
+type ProjectFieldOptionsProjectV2SingleSelectFieldOption struct {
+	// The option's name.
+	Name string `json:"name"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue) GetTypename() string {
-	return v.Typename
-}
+// GetName returns ProjectFieldOptionsProjectV2SingleSelectFieldOption.Name, and is useful for accessing the field via an interface.
+func (v *ProjectFieldOptionsProjectV2SingleSelectFieldOption) GetName() string { return v.Name }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// ProjectFieldProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
 // The GraphQL type's documentation follows.
 //
-// The value of a single select field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue struct {
+// A field inside a project.
+type ProjectFieldProjectV2Field struct {
 	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2Field object
+	Id string `json:"id"`
+	// The project field's name.
+	Name string `json:"name"`
+	// The field's type.
+	DataType ProjectV2FieldType `json:"dataType"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns ProjectFieldProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2Field) GetTypename() string { return v.Typename }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// GetId returns ProjectFieldProjectV2Field.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2Field) GetId() string { return v.Id }
+
+// GetName returns ProjectFieldProjectV2Field.Name, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2Field) GetName() string { return v.Name }
+
+// GetDataType returns ProjectFieldProjectV2Field.DataType, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2Field) GetDataType() ProjectV2FieldType { return v.DataType }
+
+// ProjectFieldProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
 // The GraphQL type's documentation follows.
 //
-// The value of a text field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue struct {
+// An iteration field inside a project.
+type ProjectFieldProjectV2IterationField struct {
 	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2IterationField object
+	Id string `json:"id"`
+	// The project field's name.
+	Name string `json:"name"`
+	// Iteration configuration settings
+	Configuration ProjectFieldConfigurationProjectV2IterationFieldConfiguration `json:"configuration"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns ProjectFieldProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2IterationField) GetTypename() string { return v.Typename }
+
+// GetId returns ProjectFieldProjectV2IterationField.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2IterationField) GetId() string { return v.Id }
+
+// GetName returns ProjectFieldProjectV2IterationField.Name, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2IterationField) GetName() string { return v.Name }
+
+// GetConfiguration returns ProjectFieldProjectV2IterationField.Configuration, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2IterationField) GetConfiguration() ProjectFieldConfigurationProjectV2IterationFieldConfiguration {
+	return v.Configuration
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue includes the requested fields of the GraphQL type ProjectV2ItemFieldUserValue.
+// ProjectFieldProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
 // The GraphQL type's documentation follows.
 //
-// The value of a user field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue struct {
+// A single select field inside a project.
+type ProjectFieldProjectV2SingleSelectField struct {
 	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2SingleSelectField object
+	Id string `json:"id"`
+	// The project field's name.
+	Name string `json:"name"`
+	// Options for the single select field
+	Options []ProjectFieldOptionsProjectV2SingleSelectFieldOption `json:"options"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns ProjectFieldProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2SingleSelectField) GetTypename() string { return v.Typename }
+
+// GetId returns ProjectFieldProjectV2SingleSelectField.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2SingleSelectField) GetId() string { return v.Id }
+
+// GetName returns ProjectFieldProjectV2SingleSelectField.Name, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2SingleSelectField) GetName() string { return v.Name }
+
+// GetOptions returns ProjectFieldProjectV2SingleSelectField.Options, and is useful for accessing the field via an interface.
+func (v *ProjectFieldProjectV2SingleSelectField) GetOptions() []ProjectFieldOptionsProjectV2SingleSelectFieldOption {
+	return v.Options
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue includes the requested fields of the GraphQL interface ProjectV2ItemFieldValue.
-//
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue is implemented by the following types:
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue
+// ProjectFields includes the GraphQL fields of ProjectV2 requested by the fragment ProjectFields.
 // The GraphQL type's documentation follows.
 //
-// Project field values
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue interface {
-	implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type ProjectFields struct {
+	ProjectFieldDefinitions `json:"-"`
+	// List of items in the project
+	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
 }
 
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue() {
+// GetItems returns ProjectFields.Items, and is useful for accessing the field via an interface.
+func (v *ProjectFields) GetItems() ProjectFieldsItemsProjectV2ItemConnection { return v.Items }
+
+// GetId returns ProjectFields.Id, and is useful for accessing the field via an interface.
+func (v *ProjectFields) GetId() string { return v.ProjectFieldDefinitions.Id }
+
+// GetTitle returns ProjectFields.Title, and is useful for accessing the field via an interface.
+func (v *ProjectFields) GetTitle() string { return v.ProjectFieldDefinitions.Title }
+
+// GetFields returns ProjectFields.Fields, and is useful for accessing the field via an interface.
+func (v *ProjectFields) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFieldDefinitions.Fields
 }
 
-func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue(b []byte, v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue) error {
+func (v *ProjectFields) UnmarshalJSON(b []byte) error {
+
 	if string(b) == "null" {
 		return nil
 	}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
+	var firstPass struct {
+		*ProjectFields
+		graphql.NoUnmarshalJSON
 	}
-	err := json.Unmarshal(b, &tn)
+	firstPass.ProjectFields = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	switch tn.TypeName {
-	case "ProjectV2ItemFieldDateValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldIterationValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldLabelValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldMilestoneValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldNumberValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldPullRequestValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldRepositoryValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldReviewerValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldSingleSelectValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldTextValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldUserValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing ProjectV2ItemFieldValue.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue: "%v"`, tn.TypeName)
+	err = json.Unmarshal(
+		b, &v.ProjectFieldDefinitions)
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
-func __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue(v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue) ([]byte, error) {
+type __premarshalProjectFields struct {
+	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
 
-	var typename string
-	switch v := (*v).(type) {
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue:
-		typename = "ProjectV2ItemFieldDateValue"
+	Id string `json:"id"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldDateValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue:
-		typename = "ProjectV2ItemFieldIterationValue"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldIterationValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue:
-		typename = "ProjectV2ItemFieldLabelValue"
+	Title string `json:"title"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldLabelValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue:
-		typename = "ProjectV2ItemFieldMilestoneValue"
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldMilestoneValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue:
-		typename = "ProjectV2ItemFieldNumberValue"
+func (v *ProjectFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldNumberValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue:
-		typename = "ProjectV2ItemFieldPullRequestValue"
+func (v *ProjectFields) __premarshalJSON() (*__premarshalProjectFields, error) {
+	var retval __premarshalProjectFields
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldPullRequestValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue:
-		typename = "ProjectV2ItemFieldRepositoryValue"
+	retval.Items = v.Items
+	retval.Id = v.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldRepositoryValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue:
-		typename = "ProjectV2ItemFieldReviewerValue"
+// ProjectFieldsItemsProjectV2ItemConnection includes the requested fields of the GraphQL type ProjectV2ItemConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ProjectV2Item.
+type ProjectFieldsItemsProjectV2ItemConnection struct {
+	// Information to aid in pagination.
+	PageInfo ProjectFieldsItemsProjectV2ItemConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []ProjectItem `json:"nodes"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldReviewerValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue:
-		typename = "ProjectV2ItemFieldSingleSelectValue"
+// GetPageInfo returns ProjectFieldsItemsProjectV2ItemConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ProjectFieldsItemsProjectV2ItemConnection) GetPageInfo() ProjectFieldsItemsProjectV2ItemConnectionPageInfo {
+	return v.PageInfo
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldSingleSelectValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue:
-		typename = "ProjectV2ItemFieldTextValue"
+// GetNodes returns ProjectFieldsItemsProjectV2ItemConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectFieldsItemsProjectV2ItemConnection) GetNodes() []ProjectItem { return v.Nodes }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldTextValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue:
-		typename = "ProjectV2ItemFieldUserValue"
+// ProjectFieldsItemsProjectV2ItemConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type ProjectFieldsItemsProjectV2ItemConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldUserValue
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemRemainingProjectV2ItemFieldValue: "%T"`, v)
-	}
+// GetHasNextPage returns ProjectFieldsItemsProjectV2ItemConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ProjectFieldsItemsProjectV2ItemConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// GetEndCursor returns ProjectFieldsItemsProjectV2ItemConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ProjectFieldsItemsProjectV2ItemConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// ProjectItem includes the GraphQL fields of ProjectV2Item requested by the fragment ProjectItem.
 // The GraphQL type's documentation follows.
 //
-// The value of a date field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue struct {
-	Typename string `json:"__typename"`
+// An item within a Project.
+type ProjectItem struct {
+	// The Node ID of the ProjectV2Item object
+	Id string `json:"id"`
+	// Whether the item is archived.
+	IsArchived bool `json:"isArchived"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The field values that are set on the item.
+	FieldValues ProjectItemFieldValuesProjectV2ItemFieldValueConnection `json:"fieldValues"`
+	// The content of the referenced draft issue, issue, or pull request
+	Content ProjectItemContentProjectV2ItemContent `json:"-"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue) GetTypename() string {
-	return v.Typename
+// GetId returns ProjectItem.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItem) GetId() string { return v.Id }
+
+// GetIsArchived returns ProjectItem.IsArchived, and is useful for accessing the field via an interface.
+func (v *ProjectItem) GetIsArchived() bool { return v.IsArchived }
+
+// GetUpdatedAt returns ProjectItem.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItem) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetFieldValues returns ProjectItem.FieldValues, and is useful for accessing the field via an interface.
+func (v *ProjectItem) GetFieldValues() ProjectItemFieldValuesProjectV2ItemFieldValueConnection {
+	return v.FieldValues
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
-// The GraphQL type's documentation follows.
-//
-// The value of an iteration field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue struct {
-	Typename string `json:"__typename"`
+// GetContent returns ProjectItem.Content, and is useful for accessing the field via an interface.
+func (v *ProjectItem) GetContent() ProjectItemContentProjectV2ItemContent { return v.Content }
+
+func (v *ProjectItem) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItem
+		Content json.RawMessage `json:"content"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItem = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Content
+		src := firstPass.Content
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemContentProjectV2ItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItem.Content: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue) GetTypename() string {
-	return v.Typename
+type __premarshalProjectItem struct {
+	Id string `json:"id"`
+
+	IsArchived bool `json:"isArchived"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	FieldValues ProjectItemFieldValuesProjectV2ItemFieldValueConnection `json:"fieldValues"`
+
+	Content json.RawMessage `json:"content"`
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue includes the requested fields of the GraphQL type ProjectV2ItemFieldLabelValue.
-// The GraphQL type's documentation follows.
-//
-// The value of the labels field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue struct {
-	Typename string `json:"__typename"`
+func (v *ProjectItem) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue) GetTypename() string {
-	return v.Typename
+func (v *ProjectItem) __premarshalJSON() (*__premarshalProjectItem, error) {
+	var retval __premarshalProjectItem
+
+	retval.Id = v.Id
+	retval.IsArchived = v.IsArchived
+	retval.UpdatedAt = v.UpdatedAt
+	retval.FieldValues = v.FieldValues
+	{
+
+		dst := &retval.Content
+		src := v.Content
+		var err error
+		*dst, err = __marshalProjectItemContentProjectV2ItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ProjectItem.Content: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue includes the requested fields of the GraphQL type ProjectV2ItemFieldMilestoneValue.
+// ProjectItemContentDraftIssue includes the requested fields of the GraphQL type DraftIssue.
 // The GraphQL type's documentation follows.
 //
-// The value of a milestone field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue struct {
+// A draft issue within a project.
+type ProjectItemContentDraftIssue struct {
 	Typename string `json:"__typename"`
+	// The title of the draft issue
+	Title string `json:"title"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// A list of users to assigned to this draft issue.
+	Assignees ProjectItemContentDraftIssueAssigneesUserConnection `json:"assignees"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns ProjectItemContentDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssue) GetTypename() string { return v.Typename }
+
+// GetTitle returns ProjectItemContentDraftIssue.Title, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssue) GetTitle() string { return v.Title }
+
+// GetCreatedAt returns ProjectItemContentDraftIssue.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssue) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns ProjectItemContentDraftIssue.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssue) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetAssignees returns ProjectItemContentDraftIssue.Assignees, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssue) GetAssignees() ProjectItemContentDraftIssueAssigneesUserConnection {
+	return v.Assignees
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// ProjectItemContentDraftIssueAssigneesUserConnection includes the requested fields of the GraphQL type UserConnection.
 // The GraphQL type's documentation follows.
 //
-// The value of a number field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue struct {
-	Typename string `json:"__typename"`
+// A list of users.
+type ProjectItemContentDraftIssueAssigneesUserConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser `json:"nodes"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue) GetTypename() string {
-	return v.Typename
+// GetNodes returns ProjectItemContentDraftIssueAssigneesUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssueAssigneesUserConnection) GetNodes() []ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser {
+	return v.Nodes
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue includes the requested fields of the GraphQL type ProjectV2ItemFieldPullRequestValue.
+// ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
-// The value of a pull request field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue struct {
-	Typename string `json:"__typename"`
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser struct {
+	// The username used to login.
+	Login string `json:"login"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue) GetTypename() string {
-	return v.Typename
+// GetLogin returns ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser.Login, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentDraftIssueAssigneesUserConnectionNodesUser) GetLogin() string {
+	return v.Login
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue includes the requested fields of the GraphQL type ProjectV2ItemFieldRepositoryValue.
+// ProjectItemContentIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
-// The value of a repository field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue struct {
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type ProjectItemContentIssue struct {
 	Typename string `json:"__typename"`
+	// The Node ID of the Issue object
+	Id string `json:"id"`
+	// Identifies the issue title.
+	Title string `json:"title"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the date and time when the object was closed.
+	ClosedAt time.Time `json:"closedAt"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The parent entity of the issue.
+	Parent *ProjectItemParent `json:"parent"`
+	// A list of issues that track this issue
+	TrackedInIssues ProjectItemContentIssueTrackedInIssuesIssueConnection `json:"trackedInIssues"`
+	// Identifies the milestone associated with the issue.
+	Milestone *ProjectItemMilestone `json:"milestone"`
+	// A list of labels associated with the object.
+	Labels ProjectItemContentIssueLabelsLabelConnection `json:"labels"`
+	// A list of Users assigned to this object.
+	Assignees ProjectItemContentIssueAssigneesUserConnection `json:"assignees"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue) GetTypename() string {
-	return v.Typename
+// GetTypename returns ProjectItemContentIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetTypename() string { return v.Typename }
+
+// GetId returns ProjectItemContentIssue.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetId() string { return v.Id }
+
+// GetTitle returns ProjectItemContentIssue.Title, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetTitle() string { return v.Title }
+
+// GetCreatedAt returns ProjectItemContentIssue.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetClosedAt returns ProjectItemContentIssue.ClosedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetClosedAt() time.Time { return v.ClosedAt }
+
+// GetUpdatedAt returns ProjectItemContentIssue.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetParent returns ProjectItemContentIssue.Parent, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetParent() *ProjectItemParent { return v.Parent }
+
+// GetTrackedInIssues returns ProjectItemContentIssue.TrackedInIssues, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetTrackedInIssues() ProjectItemContentIssueTrackedInIssuesIssueConnection {
+	return v.TrackedInIssues
+}
+
+// GetMilestone returns ProjectItemContentIssue.Milestone, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetMilestone() *ProjectItemMilestone { return v.Milestone }
+
+// GetLabels returns ProjectItemContentIssue.Labels, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetLabels() ProjectItemContentIssueLabelsLabelConnection {
+	return v.Labels
+}
+
+// GetAssignees returns ProjectItemContentIssue.Assignees, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssue) GetAssignees() ProjectItemContentIssueAssigneesUserConnection {
+	return v.Assignees
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue includes the requested fields of the GraphQL type ProjectV2ItemFieldReviewerValue.
+// ProjectItemContentIssueAssigneesUserConnection includes the requested fields of the GraphQL type UserConnection.
 // The GraphQL type's documentation follows.
 //
-// The value of a reviewers field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue struct {
-	Typename string `json:"__typename"`
+// A list of users.
+type ProjectItemContentIssueAssigneesUserConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemContentIssueAssigneesUserConnectionNodesUser `json:"nodes"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue) GetTypename() string {
-	return v.Typename
+// GetNodes returns ProjectItemContentIssueAssigneesUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssueAssigneesUserConnection) GetNodes() []ProjectItemContentIssueAssigneesUserConnectionNodesUser {
+	return v.Nodes
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// ProjectItemContentIssueAssigneesUserConnectionNodesUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
-// The value of a single select field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue struct {
-	Typename string `json:"__typename"`
-	// The name of the selected single select option.
-	Name string `json:"name"`
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type ProjectItemContentIssueAssigneesUserConnectionNodesUser struct {
+	// The username used to login.
+	Login string `json:"login"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue) GetTypename() string {
-	return v.Typename
+// GetLogin returns ProjectItemContentIssueAssigneesUserConnectionNodesUser.Login, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssueAssigneesUserConnectionNodesUser) GetLogin() string { return v.Login }
+
+// ProjectItemContentIssueLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Label.
+type ProjectItemContentIssueLabelsLabelConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemContentIssueLabelsLabelConnectionNodesLabel `json:"nodes"`
 }
 
-// GetName returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue.Name, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue) GetName() string {
-	return v.Name
+// GetNodes returns ProjectItemContentIssueLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssueLabelsLabelConnection) GetNodes() []ProjectItemContentIssueLabelsLabelConnectionNodesLabel {
+	return v.Nodes
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// ProjectItemContentIssueLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
 // The GraphQL type's documentation follows.
 //
-// The value of a text field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue struct {
-	Typename string `json:"__typename"`
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type ProjectItemContentIssueLabelsLabelConnectionNodesLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue) GetTypename() string {
-	return v.Typename
-}
+// GetName returns ProjectItemContentIssueLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssueLabelsLabelConnectionNodesLabel) GetName() string { return v.Name }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue includes the requested fields of the GraphQL type ProjectV2ItemFieldUserValue.
+// ProjectItemContentIssueTrackedInIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
 // The GraphQL type's documentation follows.
 //
-// The value of a user field in a Project item.
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue struct {
-	Typename string `json:"__typename"`
+// The connection type for Issue.
+type ProjectItemContentIssueTrackedInIssuesIssueConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemParent `json:"nodes"`
 }
 
-// GetTypename returns ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue) GetTypename() string {
-	return v.Typename
+// GetNodes returns ProjectItemContentIssueTrackedInIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentIssueTrackedInIssuesIssueConnection) GetNodes() []ProjectItemParent {
+	return v.Nodes
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue includes the requested fields of the GraphQL interface ProjectV2ItemFieldValue.
+// ProjectItemContentProjectV2ItemContent includes the requested fields of the GraphQL interface ProjectV2ItemContent.
 //
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue is implemented by the following types:
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue
-// ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue
+// ProjectItemContentProjectV2ItemContent is implemented by the following types:
+// ProjectItemContentDraftIssue
+// ProjectItemContentIssue
+// ProjectItemContentPullRequest
 // The GraphQL type's documentation follows.
 //
-// Project field values
-type ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue interface {
-	implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue()
+// Types that can be inside Project Items.
+type ProjectItemContentProjectV2ItemContent interface {
+	implementsGraphQLInterfaceProjectItemContentProjectV2ItemContent()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 }
 
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
+func (v *ProjectItemContentDraftIssue) implementsGraphQLInterfaceProjectItemContentProjectV2ItemContent() {
 }
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
+func (v *ProjectItemContentIssue) implementsGraphQLInterfaceProjectItemContentProjectV2ItemContent() {
 }
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
-}
-func (v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue) implementsGraphQLInterfaceProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue() {
+func (v *ProjectItemContentPullRequest) implementsGraphQLInterfaceProjectItemContentProjectV2ItemContent() {
 }
 
-func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue(b []byte, v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue) error {
+func __unmarshalProjectItemContentProjectV2ItemContent(b []byte, v *ProjectItemContentProjectV2ItemContent) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -1842,201 +1719,189 @@ func __unmarshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatu
 	}
 
 	switch tn.TypeName {
-	case "ProjectV2ItemFieldDateValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue)
+	case "DraftIssue":
+		*v = new(ProjectItemContentDraftIssue)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldIterationValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue)
+	case "Issue":
+		*v = new(ProjectItemContentIssue)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldLabelValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldMilestoneValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldNumberValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldPullRequestValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldRepositoryValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldReviewerValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldSingleSelectValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldTextValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue)
-		return json.Unmarshal(b, *v)
-	case "ProjectV2ItemFieldUserValue":
-		*v = new(ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue)
+	case "PullRequest":
+		*v = new(ProjectItemContentPullRequest)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing ProjectV2ItemFieldValue.__typename")
+			"response was missing ProjectV2ItemContent.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue: "%v"`, tn.TypeName)
+			`unexpected concrete type for ProjectItemContentProjectV2ItemContent: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue(v *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue) ([]byte, error) {
+func __marshalProjectItemContentProjectV2ItemContent(v *ProjectItemContentProjectV2ItemContent) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue:
-		typename = "ProjectV2ItemFieldDateValue"
+	case *ProjectItemContentDraftIssue:
+		typename = "DraftIssue"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldDateValue
+			*ProjectItemContentDraftIssue
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue:
-		typename = "ProjectV2ItemFieldIterationValue"
+	case *ProjectItemContentIssue:
+		typename = "Issue"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldIterationValue
+			*ProjectItemContentIssue
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue:
-		typename = "ProjectV2ItemFieldLabelValue"
+	case *ProjectItemContentPullRequest:
+		typename = "PullRequest"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldLabelValue
+			*ProjectItemContentPullRequest
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue:
-		typename = "ProjectV2ItemFieldMilestoneValue"
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ProjectItemContentProjectV2ItemContent: "%T"`, v)
+	}
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldMilestoneValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue:
-		typename = "ProjectV2ItemFieldNumberValue"
+// ProjectItemContentPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type ProjectItemContentPullRequest struct {
+	Typename string `json:"__typename"`
+	// Identifies the pull request title.
+	Title string `json:"title"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the date and time when the object was closed.
+	ClosedAt time.Time `json:"closedAt"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Identifies the milestone associated with the pull request.
+	Milestone *ProjectItemMilestone `json:"milestone"`
+	// A list of labels associated with the object.
+	Labels ProjectItemContentPullRequestLabelsLabelConnection `json:"labels"`
+	// A list of Users assigned to this object.
+	Assignees ProjectItemContentPullRequestAssigneesUserConnection `json:"assignees"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldNumberValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue:
-		typename = "ProjectV2ItemFieldPullRequestValue"
+// GetTypename returns ProjectItemContentPullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetTypename() string { return v.Typename }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldPullRequestValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue:
-		typename = "ProjectV2ItemFieldRepositoryValue"
+// GetTitle returns ProjectItemContentPullRequest.Title, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetTitle() string { return v.Title }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldRepositoryValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue:
-		typename = "ProjectV2ItemFieldReviewerValue"
+// GetCreatedAt returns ProjectItemContentPullRequest.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetCreatedAt() time.Time { return v.CreatedAt }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldReviewerValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue:
-		typename = "ProjectV2ItemFieldSingleSelectValue"
+// GetClosedAt returns ProjectItemContentPullRequest.ClosedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetClosedAt() time.Time { return v.ClosedAt }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldSingleSelectValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue:
-		typename = "ProjectV2ItemFieldTextValue"
+// GetUpdatedAt returns ProjectItemContentPullRequest.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldTextValue
-		}{typename, v}
-		return json.Marshal(result)
-	case *ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue:
-		typename = "ProjectV2ItemFieldUserValue"
+// GetMilestone returns ProjectItemContentPullRequest.Milestone, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetMilestone() *ProjectItemMilestone { return v.Milestone }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldUserValue
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsItemsProjectV2ItemConnectionNodesProjectV2ItemStatusProjectV2ItemFieldValue: "%T"`, v)
-	}
+// GetLabels returns ProjectItemContentPullRequest.Labels, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetLabels() ProjectItemContentPullRequestLabelsLabelConnection {
+	return v.Labels
 }
 
-// ProjectFieldsItemsProjectV2ItemConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// GetAssignees returns ProjectItemContentPullRequest.Assignees, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequest) GetAssignees() ProjectItemContentPullRequestAssigneesUserConnection {
+	return v.Assignees
+}
+
+// ProjectItemContentPullRequestAssigneesUserConnection includes the requested fields of the GraphQL type UserConnection.
 // The GraphQL type's documentation follows.
 //
-// Information about pagination in a connection.
-type ProjectFieldsItemsProjectV2ItemConnectionPageInfo struct {
-	// When paginating forwards, are there more items?
-	HasNextPage bool `json:"hasNextPage"`
-	// When paginating forwards, the cursor to continue.
-	EndCursor string `json:"endCursor"`
+// A list of users.
+type ProjectItemContentPullRequestAssigneesUserConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemContentPullRequestAssigneesUserConnectionNodesUser `json:"nodes"`
 }
 
-// GetHasNextPage returns ProjectFieldsItemsProjectV2ItemConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionPageInfo) GetHasNextPage() bool {
-	return v.HasNextPage
+// GetNodes returns ProjectItemContentPullRequestAssigneesUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequestAssigneesUserConnection) GetNodes() []ProjectItemContentPullRequestAssigneesUserConnectionNodesUser {
+	return v.Nodes
 }
 
-// GetEndCursor returns ProjectFieldsItemsProjectV2ItemConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsItemsProjectV2ItemConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+// ProjectItemContentPullRequestAssigneesUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type ProjectItemContentPullRequestAssigneesUserConnectionNodesUser struct {
+	// The username used to login.
+	Login string `json:"login"`
+}
+
+// GetLogin returns ProjectItemContentPullRequestAssigneesUserConnectionNodesUser.Login, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequestAssigneesUserConnectionNodesUser) GetLogin() string {
+	return v.Login
+}
 
-// ProjectFieldsIterationProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
+// ProjectItemContentPullRequestLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
 // The GraphQL type's documentation follows.
 //
-// A field inside a project.
-type ProjectFieldsIterationProjectV2Field struct {
-	Typename string `json:"__typename"`
+// The connection type for Label.
+type ProjectItemContentPullRequestLabelsLabelConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel `json:"nodes"`
+}
+
+// GetNodes returns ProjectItemContentPullRequestLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequestLabelsLabelConnection) GetNodes() []ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel {
+	return v.Nodes
+}
+
+// ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
 }
 
-// GetTypename returns ProjectFieldsIterationProjectV2Field.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2Field) GetTypename() string { return v.Typename }
+// GetName returns ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemContentPullRequestLabelsLabelConnectionNodesLabel) GetName() string {
+	return v.Name
+}
 
-// ProjectFieldsIterationProjectV2FieldConfiguration includes the requested fields of the GraphQL interface ProjectV2FieldConfiguration.
+// ProjectItemFieldReference includes the requested fields of the GraphQL interface ProjectV2FieldConfiguration.
 //
-// ProjectFieldsIterationProjectV2FieldConfiguration is implemented by the following types:
-// ProjectFieldsIterationProjectV2Field
-// ProjectFieldsIterationProjectV2IterationField
-// ProjectFieldsIterationProjectV2SingleSelectField
+// ProjectItemFieldReference is implemented by the following types:
+// ProjectItemFieldReferenceProjectV2Field
+// ProjectItemFieldReferenceProjectV2IterationField
+// ProjectItemFieldReferenceProjectV2SingleSelectField
 // The GraphQL type's documentation follows.
 //
 // Configurations for project fields.
-type ProjectFieldsIterationProjectV2FieldConfiguration interface {
-	implementsGraphQLInterfaceProjectFieldsIterationProjectV2FieldConfiguration()
+type ProjectItemFieldReference interface {
+	implementsGraphQLInterfaceProjectItemFieldReference()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 }
 
-func (v *ProjectFieldsIterationProjectV2Field) implementsGraphQLInterfaceProjectFieldsIterationProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldReferenceProjectV2Field) implementsGraphQLInterfaceProjectItemFieldReference() {
 }
-func (v *ProjectFieldsIterationProjectV2IterationField) implementsGraphQLInterfaceProjectFieldsIterationProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldReferenceProjectV2IterationField) implementsGraphQLInterfaceProjectItemFieldReference() {
 }
-func (v *ProjectFieldsIterationProjectV2SingleSelectField) implementsGraphQLInterfaceProjectFieldsIterationProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldReferenceProjectV2SingleSelectField) implementsGraphQLInterfaceProjectItemFieldReference() {
 }
 
-func __unmarshalProjectFieldsIterationProjectV2FieldConfiguration(b []byte, v *ProjectFieldsIterationProjectV2FieldConfiguration) error {
+func __unmarshalProjectItemFieldReference(b []byte, v *ProjectItemFieldReference) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -2051,218 +1916,169 @@ func __unmarshalProjectFieldsIterationProjectV2FieldConfiguration(b []byte, v *P
 
 	switch tn.TypeName {
 	case "ProjectV2Field":
-		*v = new(ProjectFieldsIterationProjectV2Field)
+		*v = new(ProjectItemFieldReferenceProjectV2Field)
 		return json.Unmarshal(b, *v)
 	case "ProjectV2IterationField":
-		*v = new(ProjectFieldsIterationProjectV2IterationField)
+		*v = new(ProjectItemFieldReferenceProjectV2IterationField)
 		return json.Unmarshal(b, *v)
 	case "ProjectV2SingleSelectField":
-		*v = new(ProjectFieldsIterationProjectV2SingleSelectField)
+		*v = new(ProjectItemFieldReferenceProjectV2SingleSelectField)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing ProjectV2FieldConfiguration.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsIterationProjectV2FieldConfiguration: "%v"`, tn.TypeName)
+			`unexpected concrete type for ProjectItemFieldReference: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalProjectFieldsIterationProjectV2FieldConfiguration(v *ProjectFieldsIterationProjectV2FieldConfiguration) ([]byte, error) {
+func __marshalProjectItemFieldReference(v *ProjectItemFieldReference) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *ProjectFieldsIterationProjectV2Field:
+	case *ProjectItemFieldReferenceProjectV2Field:
 		typename = "ProjectV2Field"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsIterationProjectV2Field
+			*ProjectItemFieldReferenceProjectV2Field
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsIterationProjectV2IterationField:
+	case *ProjectItemFieldReferenceProjectV2IterationField:
 		typename = "ProjectV2IterationField"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsIterationProjectV2IterationField
+			*ProjectItemFieldReferenceProjectV2IterationField
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsIterationProjectV2SingleSelectField:
+	case *ProjectItemFieldReferenceProjectV2SingleSelectField:
 		typename = "ProjectV2SingleSelectField"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsIterationProjectV2SingleSelectField
+			*ProjectItemFieldReferenceProjectV2SingleSelectField
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsIterationProjectV2FieldConfiguration: "%T"`, v)
+			`unexpected concrete type for ProjectItemFieldReference: "%T"`, v)
 	}
 }
 
-// ProjectFieldsIterationProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
+// ProjectItemFieldReferenceProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
 // The GraphQL type's documentation follows.
 //
-// An iteration field inside a project.
-type ProjectFieldsIterationProjectV2IterationField struct {
+// A field inside a project.
+type ProjectItemFieldReferenceProjectV2Field struct {
 	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2FieldCommon object
+	Id string `json:"id"`
 	// The project field's name.
 	Name string `json:"name"`
-	// Iteration configuration settings
-	Configuration ProjectFieldsIterationProjectV2IterationFieldConfiguration `json:"configuration"`
 }
 
-// GetTypename returns ProjectFieldsIterationProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationField) GetTypename() string { return v.Typename }
+// GetTypename returns ProjectItemFieldReferenceProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2Field) GetTypename() string { return v.Typename }
 
-// GetName returns ProjectFieldsIterationProjectV2IterationField.Name, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationField) GetName() string { return v.Name }
+// GetId returns ProjectItemFieldReferenceProjectV2Field.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2Field) GetId() string { return v.Id }
 
-// GetConfiguration returns ProjectFieldsIterationProjectV2IterationField.Configuration, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationField) GetConfiguration() ProjectFieldsIterationProjectV2IterationFieldConfiguration {
-	return v.Configuration
-}
+// GetName returns ProjectItemFieldReferenceProjectV2Field.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2Field) GetName() string { return v.Name }
 
-// ProjectFieldsIterationProjectV2IterationFieldConfiguration includes the requested fields of the GraphQL type ProjectV2IterationFieldConfiguration.
+// ProjectItemFieldReferenceProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
 // The GraphQL type's documentation follows.
 //
-// Iteration field configuration for a project.
-type ProjectFieldsIterationProjectV2IterationFieldConfiguration struct {
-	// The iteration's iterations
-	Iterations []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration `json:"iterations"`
-	// The iteration's completed iterations
-	CompletedIterations []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration `json:"completedIterations"`
+// An iteration field inside a project.
+type ProjectItemFieldReferenceProjectV2IterationField struct {
+	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2FieldCommon object
+	Id string `json:"id"`
+	// The project field's name.
+	Name string `json:"name"`
 }
 
-// GetIterations returns ProjectFieldsIterationProjectV2IterationFieldConfiguration.Iterations, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfiguration) GetIterations() []ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration {
-	return v.Iterations
-}
+// GetTypename returns ProjectItemFieldReferenceProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2IterationField) GetTypename() string { return v.Typename }
 
-// GetCompletedIterations returns ProjectFieldsIterationProjectV2IterationFieldConfiguration.CompletedIterations, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfiguration) GetCompletedIterations() []ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration {
-	return v.CompletedIterations
-}
+// GetId returns ProjectItemFieldReferenceProjectV2IterationField.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2IterationField) GetId() string { return v.Id }
+
+// GetName returns ProjectItemFieldReferenceProjectV2IterationField.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2IterationField) GetName() string { return v.Name }
 
-// ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration includes the requested fields of the GraphQL type ProjectV2IterationFieldIteration.
+// ProjectItemFieldReferenceProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
 // The GraphQL type's documentation follows.
 //
-// Iteration field iteration settings for a project.
-type ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration struct {
-	// The iteration's ID.
+// A single select field inside a project.
+type ProjectItemFieldReferenceProjectV2SingleSelectField struct {
+	Typename string `json:"__typename"`
+	// The Node ID of the ProjectV2FieldCommon object
 	Id string `json:"id"`
-	// The iteration's title.
-	Title string `json:"title"`
-	// The iteration's start date
-	StartDate string `json:"startDate"`
-	// The iteration's duration in days
-	Duration int `json:"duration"`
-}
-
-// GetId returns ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Id, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetId() string {
-	return v.Id
+	// The project field's name.
+	Name string `json:"name"`
 }
 
-// GetTitle returns ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Title, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetTitle() string {
-	return v.Title
-}
+// GetTypename returns ProjectItemFieldReferenceProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2SingleSelectField) GetTypename() string { return v.Typename }
 
-// GetStartDate returns ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.StartDate, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetStartDate() string {
-	return v.StartDate
-}
+// GetId returns ProjectItemFieldReferenceProjectV2SingleSelectField.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2SingleSelectField) GetId() string { return v.Id }
 
-// GetDuration returns ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration.Duration, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationCompletedIterationsProjectV2IterationFieldIteration) GetDuration() int {
-	return v.Duration
-}
+// GetName returns ProjectItemFieldReferenceProjectV2SingleSelectField.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldReferenceProjectV2SingleSelectField) GetName() string { return v.Name }
 
-// ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration includes the requested fields of the GraphQL type ProjectV2IterationFieldIteration.
+// ProjectItemFieldValue includes the requested fields of the GraphQL interface ProjectV2ItemFieldValue.
+//
+// ProjectItemFieldValue is implemented by the following types:
+// ProjectItemFieldValueProjectV2ItemFieldDateValue
+// ProjectItemFieldValueProjectV2ItemFieldIterationValue
+// ProjectItemFieldValueProjectV2ItemFieldLabelValue
+// ProjectItemFieldValueProjectV2ItemFieldMilestoneValue
+// ProjectItemFieldValueProjectV2ItemFieldNumberValue
+// ProjectItemFieldValueProjectV2ItemFieldPullRequestValue
+// ProjectItemFieldValueProjectV2ItemFieldRepositoryValue
+// ProjectItemFieldValueProjectV2ItemFieldReviewerValue
+// ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue
+// ProjectItemFieldValueProjectV2ItemFieldTextValue
+// ProjectItemFieldValueProjectV2ItemFieldUserValue
 // The GraphQL type's documentation follows.
 //
-// Iteration field iteration settings for a project.
-type ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration struct {
-	// The iteration's ID.
-	Id string `json:"id"`
-	// The iteration's title.
-	Title string `json:"title"`
-	// The iteration's start date
-	StartDate string `json:"startDate"`
-	// The iteration's duration in days
-	Duration int `json:"duration"`
+// Project field values
+type ProjectItemFieldValue interface {
+	implementsGraphQLInterfaceProjectItemFieldValue()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-// GetId returns ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Id, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetId() string {
-	return v.Id
+func (v *ProjectItemFieldValueProjectV2ItemFieldDateValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// GetTitle returns ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Title, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetTitle() string {
-	return v.Title
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// GetStartDate returns ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.StartDate, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetStartDate() string {
-	return v.StartDate
+func (v *ProjectItemFieldValueProjectV2ItemFieldLabelValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// GetDuration returns ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration.Duration, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2IterationFieldConfigurationIterationsProjectV2IterationFieldIteration) GetDuration() int {
-	return v.Duration
+func (v *ProjectItemFieldValueProjectV2ItemFieldMilestoneValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// ProjectFieldsIterationProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
-// The GraphQL type's documentation follows.
-//
-// A single select field inside a project.
-type ProjectFieldsIterationProjectV2SingleSelectField struct {
-	Typename string `json:"__typename"`
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// GetTypename returns ProjectFieldsIterationProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsIterationProjectV2SingleSelectField) GetTypename() string { return v.Typename }
-
-// ProjectFieldsStatusProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
-// The GraphQL type's documentation follows.
-//
-// A field inside a project.
-type ProjectFieldsStatusProjectV2Field struct {
-	Typename string `json:"__typename"`
+func (v *ProjectItemFieldValueProjectV2ItemFieldPullRequestValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-// GetTypename returns ProjectFieldsStatusProjectV2Field.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2Field) GetTypename() string { return v.Typename }
-
-// ProjectFieldsStatusProjectV2FieldConfiguration includes the requested fields of the GraphQL interface ProjectV2FieldConfiguration.
-//
-// ProjectFieldsStatusProjectV2FieldConfiguration is implemented by the following types:
-// ProjectFieldsStatusProjectV2Field
-// ProjectFieldsStatusProjectV2IterationField
-// ProjectFieldsStatusProjectV2SingleSelectField
-// The GraphQL type's documentation follows.
-//
-// Configurations for project fields.
-type ProjectFieldsStatusProjectV2FieldConfiguration interface {
-	implementsGraphQLInterfaceProjectFieldsStatusProjectV2FieldConfiguration()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+func (v *ProjectItemFieldValueProjectV2ItemFieldRepositoryValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-
-func (v *ProjectFieldsStatusProjectV2Field) implementsGraphQLInterfaceProjectFieldsStatusProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldValueProjectV2ItemFieldReviewerValue) implementsGraphQLInterfaceProjectItemFieldValue() {
+}
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-func (v *ProjectFieldsStatusProjectV2IterationField) implementsGraphQLInterfaceProjectFieldsStatusProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldValueProjectV2ItemFieldTextValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
-func (v *ProjectFieldsStatusProjectV2SingleSelectField) implementsGraphQLInterfaceProjectFieldsStatusProjectV2FieldConfiguration() {
+func (v *ProjectItemFieldValueProjectV2ItemFieldUserValue) implementsGraphQLInterfaceProjectItemFieldValue() {
 }
 
-func __unmarshalProjectFieldsStatusProjectV2FieldConfiguration(b []byte, v *ProjectFieldsStatusProjectV2FieldConfiguration) error {
+func __unmarshalProjectItemFieldValue(b []byte, v *ProjectItemFieldValue) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -2276,237 +2092,356 @@ func __unmarshalProjectFieldsStatusProjectV2FieldConfiguration(b []byte, v *Proj
 	}
 
 	switch tn.TypeName {
-	case "ProjectV2Field":
-		*v = new(ProjectFieldsStatusProjectV2Field)
+	case "ProjectV2ItemFieldDateValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldDateValue)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2IterationField":
-		*v = new(ProjectFieldsStatusProjectV2IterationField)
+	case "ProjectV2ItemFieldIterationValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldIterationValue)
 		return json.Unmarshal(b, *v)
-	case "ProjectV2SingleSelectField":
-		*v = new(ProjectFieldsStatusProjectV2SingleSelectField)
+	case "ProjectV2ItemFieldLabelValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldLabelValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldMilestoneValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldMilestoneValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldNumberValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldNumberValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldPullRequestValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldPullRequestValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldRepositoryValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldRepositoryValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldReviewerValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldReviewerValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldTextValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldTextValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldUserValue":
+		*v = new(ProjectItemFieldValueProjectV2ItemFieldUserValue)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing ProjectV2FieldConfiguration.__typename")
+			"response was missing ProjectV2ItemFieldValue.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsStatusProjectV2FieldConfiguration: "%v"`, tn.TypeName)
+			`unexpected concrete type for ProjectItemFieldValue: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalProjectFieldsStatusProjectV2FieldConfiguration(v *ProjectFieldsStatusProjectV2FieldConfiguration) ([]byte, error) {
+func __marshalProjectItemFieldValue(v *ProjectItemFieldValue) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *ProjectFieldsStatusProjectV2Field:
-		typename = "ProjectV2Field"
+	case *ProjectItemFieldValueProjectV2ItemFieldDateValue:
+		typename = "ProjectV2ItemFieldDateValue"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsStatusProjectV2Field
+			*ProjectItemFieldValueProjectV2ItemFieldDateValue
 		}{typename, v}
 		return json.Marshal(result)
-	case *ProjectFieldsStatusProjectV2IterationField:
-		typename = "ProjectV2IterationField"
+	case *ProjectItemFieldValueProjectV2ItemFieldIterationValue:
+		typename = "ProjectV2ItemFieldIterationValue"
 
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsStatusProjectV2IterationField
-		}{typename, v}
+			*__premarshalProjectItemFieldValueProjectV2ItemFieldIterationValue
+		}{typename, premarshaled}
 		return json.Marshal(result)
-	case *ProjectFieldsStatusProjectV2SingleSelectField:
-		typename = "ProjectV2SingleSelectField"
+	case *ProjectItemFieldValueProjectV2ItemFieldLabelValue:
+		typename = "ProjectV2ItemFieldLabelValue"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*ProjectFieldsStatusProjectV2SingleSelectField
+			*ProjectItemFieldValueProjectV2ItemFieldLabelValue
 		}{typename, v}
 		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for ProjectFieldsStatusProjectV2FieldConfiguration: "%T"`, v)
-	}
-}
+	case *ProjectItemFieldValueProjectV2ItemFieldMilestoneValue:
+		typename = "ProjectV2ItemFieldMilestoneValue"
 
-// ProjectFieldsStatusProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
-// The GraphQL type's documentation follows.
-//
-// An iteration field inside a project.
-type ProjectFieldsStatusProjectV2IterationField struct {
-	Typename string `json:"__typename"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldMilestoneValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldNumberValue:
+		typename = "ProjectV2ItemFieldNumberValue"
 
-// GetTypename returns ProjectFieldsStatusProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2IterationField) GetTypename() string { return v.Typename }
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalProjectItemFieldValueProjectV2ItemFieldNumberValue
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldPullRequestValue:
+		typename = "ProjectV2ItemFieldPullRequestValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldPullRequestValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldRepositoryValue:
+		typename = "ProjectV2ItemFieldRepositoryValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldRepositoryValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldReviewerValue:
+		typename = "ProjectV2ItemFieldReviewerValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldReviewerValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue:
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalProjectItemFieldValueProjectV2ItemFieldSingleSelectValue
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldTextValue:
+		typename = "ProjectV2ItemFieldTextValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldTextValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemFieldValueProjectV2ItemFieldUserValue:
+		typename = "ProjectV2ItemFieldUserValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemFieldValueProjectV2ItemFieldUserValue
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ProjectItemFieldValue: "%T"`, v)
+	}
+}
 
-// ProjectFieldsStatusProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
+// ProjectItemFieldValueProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
 // The GraphQL type's documentation follows.
 //
-// A single select field inside a project.
-type ProjectFieldsStatusProjectV2SingleSelectField struct {
+// The value of a date field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldDateValue struct {
 	Typename string `json:"__typename"`
-	// The project field's name.
-	Name string `json:"name"`
-	// Options for the single select field
-	Options []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption `json:"options"`
 }
 
-// GetTypename returns ProjectFieldsStatusProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2SingleSelectField) GetTypename() string { return v.Typename }
-
-// GetName returns ProjectFieldsStatusProjectV2SingleSelectField.Name, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2SingleSelectField) GetName() string { return v.Name }
-
-// GetOptions returns ProjectFieldsStatusProjectV2SingleSelectField.Options, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2SingleSelectField) GetOptions() []ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption {
-	return v.Options
-}
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldDateValue) GetTypename() string { return v.Typename }
 
-// ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption includes the requested fields of the GraphQL type ProjectV2SingleSelectFieldOption.
+// ProjectItemFieldValueProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
 // The GraphQL type's documentation follows.
 //
-// Single select field option for a configuration for a project.
-type ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption struct {
-	// The option's name.
-	Name string `json:"name"`
+// The value of an iteration field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
+	// The ID of the iteration.
+	IterationId string `json:"iterationId"`
+	// The project field that contains this value.
+	Field ProjectItemFieldReference `json:"-"`
 }
 
-// GetName returns ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption.Name, and is useful for accessing the field via an interface.
-func (v *ProjectFieldsStatusProjectV2SingleSelectFieldOptionsProjectV2SingleSelectFieldOption) GetName() string {
-	return v.Name
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) GetTypename() string {
+	return v.Typename
 }
 
-// __getOrganizationProjectInput is used internally by genqlient
-type __getOrganizationProjectInput struct {
-	Organization_name      string `json:"organization_name"`
-	Project_number         int    `json:"project_number"`
-	Labels_per_issue_count int    `json:"labels_per_issue_count"`
-	Cursor                 string `json:"cursor"`
+// GetIterationId returns ProjectItemFieldValueProjectV2ItemFieldIterationValue.IterationId, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) GetIterationId() string {
+	return v.IterationId
 }
 
-// GetOrganization_name returns __getOrganizationProjectInput.Organization_name, and is useful for accessing the field via an interface.
-func (v *__getOrganizationProjectInput) GetOrganization_name() string { return v.Organization_name }
+// GetField returns ProjectItemFieldValueProjectV2ItemFieldIterationValue.Field, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) GetField() ProjectItemFieldReference {
+	return v.Field
+}
 
-// GetProject_number returns __getOrganizationProjectInput.Project_number, and is useful for accessing the field via an interface.
-func (v *__getOrganizationProjectInput) GetProject_number() int { return v.Project_number }
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) UnmarshalJSON(b []byte) error {
 
-// GetLabels_per_issue_count returns __getOrganizationProjectInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
-func (v *__getOrganizationProjectInput) GetLabels_per_issue_count() int {
-	return v.Labels_per_issue_count
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetCursor returns __getOrganizationProjectInput.Cursor, and is useful for accessing the field via an interface.
-func (v *__getOrganizationProjectInput) GetCursor() string { return v.Cursor }
+	var firstPass struct {
+		*ProjectItemFieldValueProjectV2ItemFieldIterationValue
+		Field json.RawMessage `json:"field"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemFieldValueProjectV2ItemFieldIterationValue = v
 
-// __getRepositoryProjectInput is used internally by genqlient
-type __getRepositoryProjectInput struct {
-	Repo_owner             string `json:"repo_owner"`
-	Repo_name              string `json:"repo_name"`
-	Project_number         int    `json:"project_number"`
-	Labels_per_issue_count int    `json:"labels_per_issue_count"`
-	Cursor                 string `json:"cursor"`
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Field
+		src := firstPass.Field
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemFieldReference(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItemFieldValueProjectV2ItemFieldIterationValue.Field: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetRepo_owner returns __getRepositoryProjectInput.Repo_owner, and is useful for accessing the field via an interface.
-func (v *__getRepositoryProjectInput) GetRepo_owner() string { return v.Repo_owner }
+type __premarshalProjectItemFieldValueProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
 
-// GetRepo_name returns __getRepositoryProjectInput.Repo_name, and is useful for accessing the field via an interface.
-func (v *__getRepositoryProjectInput) GetRepo_name() string { return v.Repo_name }
+	IterationId string `json:"iterationId"`
 
-// GetProject_number returns __getRepositoryProjectInput.Project_number, and is useful for accessing the field via an interface.
-func (v *__getRepositoryProjectInput) GetProject_number() int { return v.Project_number }
+	Field json.RawMessage `json:"field"`
+}
 
-// GetLabels_per_issue_count returns __getRepositoryProjectInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
-func (v *__getRepositoryProjectInput) GetLabels_per_issue_count() int {
-	return v.Labels_per_issue_count
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetCursor returns __getRepositoryProjectInput.Cursor, and is useful for accessing the field via an interface.
-func (v *__getRepositoryProjectInput) GetCursor() string { return v.Cursor }
+func (v *ProjectItemFieldValueProjectV2ItemFieldIterationValue) __premarshalJSON() (*__premarshalProjectItemFieldValueProjectV2ItemFieldIterationValue, error) {
+	var retval __premarshalProjectItemFieldValueProjectV2ItemFieldIterationValue
 
-// getOrganizationProjectOrganization includes the requested fields of the GraphQL type Organization.
-// The GraphQL type's documentation follows.
-//
-// An account on GitHub, with one or more owners, that has repositories, members and teams.
-type getOrganizationProjectOrganization struct {
-	// Find a project by number.
-	ProjectV2 getOrganizationProjectOrganizationProjectV2 `json:"projectV2"`
-}
+	retval.Typename = v.Typename
+	retval.IterationId = v.IterationId
+	{
 
-// GetProjectV2 returns getOrganizationProjectOrganization.ProjectV2, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganization) GetProjectV2() getOrganizationProjectOrganizationProjectV2 {
-	return v.ProjectV2
+		dst := &retval.Field
+		src := v.Field
+		var err error
+		*dst, err = __marshalProjectItemFieldReference(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ProjectItemFieldValueProjectV2ItemFieldIterationValue.Field: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// getOrganizationProjectOrganizationProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// ProjectItemFieldValueProjectV2ItemFieldLabelValue includes the requested fields of the GraphQL type ProjectV2ItemFieldLabelValue.
 // The GraphQL type's documentation follows.
 //
-// New projects that manage issues, pull requests and drafts using tables and boards.
-type getOrganizationProjectOrganizationProjectV2 struct {
-	ProjectFields `json:"-"`
+// The value of the labels field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldLabelValue struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns getOrganizationProjectOrganizationProjectV2.Id, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganizationProjectV2) GetId() string { return v.ProjectFields.Id }
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldLabelValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldLabelValue) GetTypename() string { return v.Typename }
 
-// GetTitle returns getOrganizationProjectOrganizationProjectV2.Title, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganizationProjectV2) GetTitle() string { return v.ProjectFields.Title }
+// ProjectItemFieldValueProjectV2ItemFieldMilestoneValue includes the requested fields of the GraphQL type ProjectV2ItemFieldMilestoneValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a milestone field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldMilestoneValue struct {
+	Typename string `json:"__typename"`
+}
 
-// GetStatus returns getOrganizationProjectOrganizationProjectV2.Status, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganizationProjectV2) GetStatus() ProjectFieldsStatusProjectV2FieldConfiguration {
-	return v.ProjectFields.Status
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldMilestoneValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldMilestoneValue) GetTypename() string {
+	return v.Typename
 }
 
-// GetIteration returns getOrganizationProjectOrganizationProjectV2.Iteration, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganizationProjectV2) GetIteration() ProjectFieldsIterationProjectV2FieldConfiguration {
-	return v.ProjectFields.Iteration
+// ProjectItemFieldValueProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a number field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
+	// Number as a float(8)
+	Number float64 `json:"number"`
+	// The project field that contains this value.
+	Field ProjectItemFieldReference `json:"-"`
 }
 
-// GetItems returns getOrganizationProjectOrganizationProjectV2.Items, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectOrganizationProjectV2) GetItems() ProjectFieldsItemsProjectV2ItemConnection {
-	return v.ProjectFields.Items
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) GetTypename() string { return v.Typename }
+
+// GetNumber returns ProjectItemFieldValueProjectV2ItemFieldNumberValue.Number, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) GetNumber() float64 { return v.Number }
+
+// GetField returns ProjectItemFieldValueProjectV2ItemFieldNumberValue.Field, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) GetField() ProjectItemFieldReference {
+	return v.Field
 }
 
-func (v *getOrganizationProjectOrganizationProjectV2) UnmarshalJSON(b []byte) error {
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getOrganizationProjectOrganizationProjectV2
+		*ProjectItemFieldValueProjectV2ItemFieldNumberValue
+		Field json.RawMessage `json:"field"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getOrganizationProjectOrganizationProjectV2 = v
+	firstPass.ProjectItemFieldValueProjectV2ItemFieldNumberValue = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.ProjectFields)
-	if err != nil {
-		return err
+	{
+		dst := &v.Field
+		src := firstPass.Field
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemFieldReference(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItemFieldValueProjectV2ItemFieldNumberValue.Field: %w", err)
+			}
+		}
 	}
 	return nil
 }
 
-type __premarshalgetOrganizationProjectOrganizationProjectV2 struct {
-	Id string `json:"id"`
-
-	Title string `json:"title"`
-
-	Status json.RawMessage `json:"status"`
+type __premarshalProjectItemFieldValueProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
 
-	Iteration json.RawMessage `json:"iteration"`
+	Number float64 `json:"number"`
 
-	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
+	Field json.RawMessage `json:"field"`
 }
 
-func (v *getOrganizationProjectOrganizationProjectV2) MarshalJSON() ([]byte, error) {
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2514,265 +2449,17406 @@ func (v *getOrganizationProjectOrganizationProjectV2) MarshalJSON() ([]byte, err
 	return json.Marshal(premarshaled)
 }
 
-func (v *getOrganizationProjectOrganizationProjectV2) __premarshalJSON() (*__premarshalgetOrganizationProjectOrganizationProjectV2, error) {
-	var retval __premarshalgetOrganizationProjectOrganizationProjectV2
-
-	retval.Id = v.ProjectFields.Id
-	retval.Title = v.ProjectFields.Title
-	{
+func (v *ProjectItemFieldValueProjectV2ItemFieldNumberValue) __premarshalJSON() (*__premarshalProjectItemFieldValueProjectV2ItemFieldNumberValue, error) {
+	var retval __premarshalProjectItemFieldValueProjectV2ItemFieldNumberValue
 
-		dst := &retval.Status
-		src := v.ProjectFields.Status
-		var err error
-		*dst, err = __marshalProjectFieldsStatusProjectV2FieldConfiguration(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getOrganizationProjectOrganizationProjectV2.ProjectFields.Status: %w", err)
-		}
-	}
+	retval.Typename = v.Typename
+	retval.Number = v.Number
 	{
 
-		dst := &retval.Iteration
-		src := v.ProjectFields.Iteration
+		dst := &retval.Field
+		src := v.Field
 		var err error
-		*dst, err = __marshalProjectFieldsIterationProjectV2FieldConfiguration(
+		*dst, err = __marshalProjectItemFieldReference(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal getOrganizationProjectOrganizationProjectV2.ProjectFields.Iteration: %w", err)
+				"unable to marshal ProjectItemFieldValueProjectV2ItemFieldNumberValue.Field: %w", err)
 		}
 	}
-	retval.Items = v.ProjectFields.Items
 	return &retval, nil
 }
 
-// getOrganizationProjectResponse is returned by getOrganizationProject on success.
-type getOrganizationProjectResponse struct {
-	// Lookup a organization by login.
-	Organization getOrganizationProjectOrganization `json:"organization"`
+// ProjectItemFieldValueProjectV2ItemFieldPullRequestValue includes the requested fields of the GraphQL type ProjectV2ItemFieldPullRequestValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a pull request field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldPullRequestValue struct {
+	Typename string `json:"__typename"`
 }
 
-// GetOrganization returns getOrganizationProjectResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getOrganizationProjectResponse) GetOrganization() getOrganizationProjectOrganization {
-	return v.Organization
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldPullRequestValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldPullRequestValue) GetTypename() string {
+	return v.Typename
 }
 
-// getRepositoryProjectRepository includes the requested fields of the GraphQL type Repository.
+// ProjectItemFieldValueProjectV2ItemFieldRepositoryValue includes the requested fields of the GraphQL type ProjectV2ItemFieldRepositoryValue.
 // The GraphQL type's documentation follows.
 //
-// A repository contains the content for a project.
-type getRepositoryProjectRepository struct {
-	// Finds and returns the Project according to the provided Project number.
-	ProjectV2 getRepositoryProjectRepositoryProjectV2 `json:"projectV2"`
+// The value of a repository field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldRepositoryValue struct {
+	Typename string `json:"__typename"`
 }
 
-// GetProjectV2 returns getRepositoryProjectRepository.ProjectV2, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepository) GetProjectV2() getRepositoryProjectRepositoryProjectV2 {
-	return v.ProjectV2
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldRepositoryValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldRepositoryValue) GetTypename() string {
+	return v.Typename
 }
 
-// getRepositoryProjectRepositoryProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// ProjectItemFieldValueProjectV2ItemFieldReviewerValue includes the requested fields of the GraphQL type ProjectV2ItemFieldReviewerValue.
 // The GraphQL type's documentation follows.
 //
-// New projects that manage issues, pull requests and drafts using tables and boards.
-type getRepositoryProjectRepositoryProjectV2 struct {
-	ProjectFields `json:"-"`
+// The value of a reviewers field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldReviewerValue struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns getRepositoryProjectRepositoryProjectV2.Id, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepositoryProjectV2) GetId() string { return v.ProjectFields.Id }
-
-// GetTitle returns getRepositoryProjectRepositoryProjectV2.Title, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepositoryProjectV2) GetTitle() string { return v.ProjectFields.Title }
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldReviewerValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldReviewerValue) GetTypename() string {
+	return v.Typename
+}
 
-// GetStatus returns getRepositoryProjectRepositoryProjectV2.Status, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepositoryProjectV2) GetStatus() ProjectFieldsStatusProjectV2FieldConfiguration {
-	return v.ProjectFields.Status
+// ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a single select field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+	// The name of the selected single select option.
+	Name string `json:"name"`
+	// The project field that contains this value.
+	Field ProjectItemFieldReference `json:"-"`
 }
 
-// GetIteration returns getRepositoryProjectRepositoryProjectV2.Iteration, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepositoryProjectV2) GetIteration() ProjectFieldsIterationProjectV2FieldConfiguration {
-	return v.ProjectFields.Iteration
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) GetTypename() string {
+	return v.Typename
 }
 
-// GetItems returns getRepositoryProjectRepositoryProjectV2.Items, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectRepositoryProjectV2) GetItems() ProjectFieldsItemsProjectV2ItemConnection {
-	return v.ProjectFields.Items
+// GetName returns ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue.Name, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) GetName() string { return v.Name }
+
+// GetField returns ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue.Field, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) GetField() ProjectItemFieldReference {
+	return v.Field
 }
 
-func (v *getRepositoryProjectRepositoryProjectV2) UnmarshalJSON(b []byte) error {
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getRepositoryProjectRepositoryProjectV2
+		*ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue
+		Field json.RawMessage `json:"field"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getRepositoryProjectRepositoryProjectV2 = v
+	firstPass.ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.ProjectFields)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-type __premarshalgetRepositoryProjectRepositoryProjectV2 struct {
-	Id string `json:"id"`
+	{
+		dst := &v.Field
+		src := firstPass.Field
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemFieldReference(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue.Field: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemFieldValueProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Field json.RawMessage `json:"field"`
+}
+
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue) __premarshalJSON() (*__premarshalProjectItemFieldValueProjectV2ItemFieldSingleSelectValue, error) {
+	var retval __premarshalProjectItemFieldValueProjectV2ItemFieldSingleSelectValue
+
+	retval.Typename = v.Typename
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Field
+		src := v.Field
+		var err error
+		*dst, err = __marshalProjectItemFieldReference(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue.Field: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ProjectItemFieldValueProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a text field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldTextValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldTextValue) GetTypename() string { return v.Typename }
+
+// ProjectItemFieldValueProjectV2ItemFieldUserValue includes the requested fields of the GraphQL type ProjectV2ItemFieldUserValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a user field in a Project item.
+type ProjectItemFieldValueProjectV2ItemFieldUserValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns ProjectItemFieldValueProjectV2ItemFieldUserValue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValueProjectV2ItemFieldUserValue) GetTypename() string { return v.Typename }
+
+// ProjectItemFieldValuesProjectV2ItemFieldValueConnection includes the requested fields of the GraphQL type ProjectV2ItemFieldValueConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ProjectV2ItemFieldValue.
+type ProjectItemFieldValuesProjectV2ItemFieldValueConnection struct {
+	// A list of nodes.
+	Nodes []ProjectItemFieldValue `json:"-"`
+}
+
+// GetNodes returns ProjectItemFieldValuesProjectV2ItemFieldValueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemFieldValuesProjectV2ItemFieldValueConnection) GetNodes() []ProjectItemFieldValue {
+	return v.Nodes
+}
+
+func (v *ProjectItemFieldValuesProjectV2ItemFieldValueConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemFieldValuesProjectV2ItemFieldValueConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemFieldValuesProjectV2ItemFieldValueConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]ProjectItemFieldValue,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalProjectItemFieldValue(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ProjectItemFieldValuesProjectV2ItemFieldValueConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemFieldValuesProjectV2ItemFieldValueConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *ProjectItemFieldValuesProjectV2ItemFieldValueConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemFieldValuesProjectV2ItemFieldValueConnection) __premarshalJSON() (*__premarshalProjectItemFieldValuesProjectV2ItemFieldValueConnection, error) {
+	var retval __premarshalProjectItemFieldValuesProjectV2ItemFieldValueConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalProjectItemFieldValue(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ProjectItemFieldValuesProjectV2ItemFieldValueConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
 
+// ProjectItemMilestone includes the requested fields of the GraphQL type Milestone.
+// The GraphQL type's documentation follows.
+//
+// Represents a Milestone object on a given repository.
+type ProjectItemMilestone struct {
+	// The Node ID of the Milestone object
+	Id string `json:"id"`
+	// Identifies the title of the milestone.
 	Title string `json:"title"`
+	// Identifies the state of the milestone.
+	State MilestoneState `json:"state"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the due date of the milestone.
+	DueOn time.Time `json:"dueOn"`
+}
 
-	Status json.RawMessage `json:"status"`
+// GetId returns ProjectItemMilestone.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemMilestone) GetId() string { return v.Id }
 
-	Iteration json.RawMessage `json:"iteration"`
+// GetTitle returns ProjectItemMilestone.Title, and is useful for accessing the field via an interface.
+func (v *ProjectItemMilestone) GetTitle() string { return v.Title }
 
-	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
+// GetState returns ProjectItemMilestone.State, and is useful for accessing the field via an interface.
+func (v *ProjectItemMilestone) GetState() MilestoneState { return v.State }
+
+// GetCreatedAt returns ProjectItemMilestone.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemMilestone) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetDueOn returns ProjectItemMilestone.DueOn, and is useful for accessing the field via an interface.
+func (v *ProjectItemMilestone) GetDueOn() time.Time { return v.DueOn }
+
+// ProjectItemParent includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type ProjectItemParent struct {
+	// The Node ID of the Issue object
+	Id string `json:"id"`
 }
 
-func (v *getRepositoryProjectRepositoryProjectV2) MarshalJSON() ([]byte, error) {
+// GetId returns ProjectItemParent.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemParent) GetId() string { return v.Id }
+
+// ProjectItemTimeline includes the GraphQL fields of ProjectV2Item requested by the fragment ProjectItemTimeline.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type ProjectItemTimeline struct {
+	// The Node ID of the ProjectV2Item object
+	Id string `json:"id"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The content of the referenced draft issue, issue, or pull request
+	Content ProjectItemTimelineContentProjectV2ItemContent `json:"-"`
+}
+
+// GetId returns ProjectItemTimeline.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimeline) GetId() string { return v.Id }
+
+// GetCreatedAt returns ProjectItemTimeline.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimeline) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetContent returns ProjectItemTimeline.Content, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimeline) GetContent() ProjectItemTimelineContentProjectV2ItemContent {
+	return v.Content
+}
+
+func (v *ProjectItemTimeline) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemTimeline
+		Content json.RawMessage `json:"content"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemTimeline = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Content
+		src := firstPass.Content
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemTimelineContentProjectV2ItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItemTimeline.Content: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemTimeline struct {
+	Id string `json:"id"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	Content json.RawMessage `json:"content"`
+}
+
+func (v *ProjectItemTimeline) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemTimeline) __premarshalJSON() (*__premarshalProjectItemTimeline, error) {
+	var retval __premarshalProjectItemTimeline
+
+	retval.Id = v.Id
+	retval.CreatedAt = v.CreatedAt
+	{
+
+		dst := &retval.Content
+		src := v.Content
+		var err error
+		*dst, err = __marshalProjectItemTimelineContentProjectV2ItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ProjectItemTimeline.Content: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ProjectItemTimelineContentDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type ProjectItemTimelineContentDraftIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns ProjectItemTimelineContentDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentDraftIssue) GetTypename() string { return v.Typename }
+
+// ProjectItemTimelineContentIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type ProjectItemTimelineContentIssue struct {
+	Typename string `json:"__typename"`
+	// A list of events, comments, commits, etc. associated with the issue.
+	TimelineItems ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection `json:"timelineItems"`
+}
+
+// GetTypename returns ProjectItemTimelineContentIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentIssue) GetTypename() string { return v.Typename }
+
+// GetTimelineItems returns ProjectItemTimelineContentIssue.TimelineItems, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentIssue) GetTimelineItems() ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection {
+	return v.TimelineItems
+}
+
+// ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection includes the requested fields of the GraphQL type IssueTimelineItemsConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for IssueTimelineItems.
+type ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection struct {
+	// A list of nodes.
+	Nodes []IssueTimelineEvent `json:"-"`
+}
+
+// GetNodes returns ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection) GetNodes() []IssueTimelineEvent {
+	return v.Nodes
+}
+
+func (v *ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]IssueTimelineEvent,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalIssueTimelineEvent(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
 	}
-	return json.Marshal(premarshaled)
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection) __premarshalJSON() (*__premarshalProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection, error) {
+	var retval __premarshalProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalIssueTimelineEvent(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ProjectItemTimelineContentIssueTimelineItemsIssueTimelineItemsConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// ProjectItemTimelineContentProjectV2ItemContent includes the requested fields of the GraphQL interface ProjectV2ItemContent.
+//
+// ProjectItemTimelineContentProjectV2ItemContent is implemented by the following types:
+// ProjectItemTimelineContentDraftIssue
+// ProjectItemTimelineContentIssue
+// ProjectItemTimelineContentPullRequest
+// The GraphQL type's documentation follows.
+//
+// Types that can be inside Project Items.
+type ProjectItemTimelineContentProjectV2ItemContent interface {
+	implementsGraphQLInterfaceProjectItemTimelineContentProjectV2ItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *ProjectItemTimelineContentDraftIssue) implementsGraphQLInterfaceProjectItemTimelineContentProjectV2ItemContent() {
+}
+func (v *ProjectItemTimelineContentIssue) implementsGraphQLInterfaceProjectItemTimelineContentProjectV2ItemContent() {
+}
+func (v *ProjectItemTimelineContentPullRequest) implementsGraphQLInterfaceProjectItemTimelineContentProjectV2ItemContent() {
+}
+
+func __unmarshalProjectItemTimelineContentProjectV2ItemContent(b []byte, v *ProjectItemTimelineContentProjectV2ItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "DraftIssue":
+		*v = new(ProjectItemTimelineContentDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(ProjectItemTimelineContentIssue)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(ProjectItemTimelineContentPullRequest)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing ProjectV2ItemContent.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ProjectItemTimelineContentProjectV2ItemContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalProjectItemTimelineContentProjectV2ItemContent(v *ProjectItemTimelineContentProjectV2ItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ProjectItemTimelineContentDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemTimelineContentDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemTimelineContentIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemTimelineContentIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemTimelineContentPullRequest:
+		typename = "PullRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemTimelineContentPullRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ProjectItemTimelineContentProjectV2ItemContent: "%T"`, v)
+	}
+}
+
+// ProjectItemTimelineContentPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type ProjectItemTimelineContentPullRequest struct {
+	Typename string `json:"__typename"`
+	// A list of events, comments, commits, etc. associated with the pull request.
+	TimelineItems ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection `json:"timelineItems"`
+}
+
+// GetTypename returns ProjectItemTimelineContentPullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentPullRequest) GetTypename() string { return v.Typename }
+
+// GetTimelineItems returns ProjectItemTimelineContentPullRequest.TimelineItems, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentPullRequest) GetTimelineItems() ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection {
+	return v.TimelineItems
+}
+
+// ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection includes the requested fields of the GraphQL type PullRequestTimelineItemsConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestTimelineItems.
+type ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection struct {
+	// A list of nodes.
+	Nodes []PullRequestTimelineEvent `json:"-"`
+}
+
+// GetNodes returns ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection) GetNodes() []PullRequestTimelineEvent {
+	return v.Nodes
+}
+
+func (v *ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]PullRequestTimelineEvent,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalPullRequestTimelineEvent(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection) __premarshalJSON() (*__premarshalProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection, error) {
+	var retval __premarshalProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalPullRequestTimelineEvent(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ProjectItemTimelineContentPullRequestTimelineItemsPullRequestTimelineItemsConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// ProjectItemVersion includes the GraphQL fields of ProjectV2Item requested by the fragment ProjectItemVersion.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type ProjectItemVersion struct {
+	// The Node ID of the ProjectV2Item object
+	Id string `json:"id"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The content of the referenced draft issue, issue, or pull request
+	Content ProjectItemVersionContentProjectV2ItemContent `json:"-"`
+}
+
+// GetId returns ProjectItemVersion.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersion) GetId() string { return v.Id }
+
+// GetUpdatedAt returns ProjectItemVersion.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersion) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetContent returns ProjectItemVersion.Content, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersion) GetContent() ProjectItemVersionContentProjectV2ItemContent {
+	return v.Content
+}
+
+func (v *ProjectItemVersion) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemVersion
+		Content json.RawMessage `json:"content"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemVersion = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Content
+		src := firstPass.Content
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalProjectItemVersionContentProjectV2ItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ProjectItemVersion.Content: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalProjectItemVersion struct {
+	Id string `json:"id"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Content json.RawMessage `json:"content"`
+}
+
+func (v *ProjectItemVersion) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemVersion) __premarshalJSON() (*__premarshalProjectItemVersion, error) {
+	var retval __premarshalProjectItemVersion
+
+	retval.Id = v.Id
+	retval.UpdatedAt = v.UpdatedAt
+	{
+
+		dst := &retval.Content
+		src := v.Content
+		var err error
+		*dst, err = __marshalProjectItemVersionContentProjectV2ItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ProjectItemVersion.Content: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ProjectItemVersionContentDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type ProjectItemVersionContentDraftIssue struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetTypename returns ProjectItemVersionContentDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentDraftIssue) GetTypename() string { return v.Typename }
+
+// GetUpdatedAt returns ProjectItemVersionContentDraftIssue.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentDraftIssue) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// ProjectItemVersionContentIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type ProjectItemVersionContentIssue struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetTypename returns ProjectItemVersionContentIssue.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentIssue) GetTypename() string { return v.Typename }
+
+// GetUpdatedAt returns ProjectItemVersionContentIssue.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentIssue) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// ProjectItemVersionContentProjectV2ItemContent includes the requested fields of the GraphQL interface ProjectV2ItemContent.
+//
+// ProjectItemVersionContentProjectV2ItemContent is implemented by the following types:
+// ProjectItemVersionContentDraftIssue
+// ProjectItemVersionContentIssue
+// ProjectItemVersionContentPullRequest
+// The GraphQL type's documentation follows.
+//
+// Types that can be inside Project Items.
+type ProjectItemVersionContentProjectV2ItemContent interface {
+	implementsGraphQLInterfaceProjectItemVersionContentProjectV2ItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *ProjectItemVersionContentDraftIssue) implementsGraphQLInterfaceProjectItemVersionContentProjectV2ItemContent() {
+}
+func (v *ProjectItemVersionContentIssue) implementsGraphQLInterfaceProjectItemVersionContentProjectV2ItemContent() {
+}
+func (v *ProjectItemVersionContentPullRequest) implementsGraphQLInterfaceProjectItemVersionContentProjectV2ItemContent() {
+}
+
+func __unmarshalProjectItemVersionContentProjectV2ItemContent(b []byte, v *ProjectItemVersionContentProjectV2ItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "DraftIssue":
+		*v = new(ProjectItemVersionContentDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(ProjectItemVersionContentIssue)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(ProjectItemVersionContentPullRequest)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing ProjectV2ItemContent.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ProjectItemVersionContentProjectV2ItemContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalProjectItemVersionContentProjectV2ItemContent(v *ProjectItemVersionContentProjectV2ItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ProjectItemVersionContentDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemVersionContentDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemVersionContentIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemVersionContentIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *ProjectItemVersionContentPullRequest:
+		typename = "PullRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ProjectItemVersionContentPullRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ProjectItemVersionContentProjectV2ItemContent: "%T"`, v)
+	}
+}
+
+// ProjectItemVersionContentPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type ProjectItemVersionContentPullRequest struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetTypename returns ProjectItemVersionContentPullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentPullRequest) GetTypename() string { return v.Typename }
+
+// GetUpdatedAt returns ProjectItemVersionContentPullRequest.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionContentPullRequest) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// ProjectItemVersions includes the GraphQL fields of ProjectV2 requested by the fragment ProjectItemVersions.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type ProjectItemVersions struct {
+	ProjectFieldDefinitions `json:"-"`
+	// List of items in the project
+	Items ProjectItemVersionsItemsProjectV2ItemConnection `json:"items"`
+}
+
+// GetItems returns ProjectItemVersions.Items, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersions) GetItems() ProjectItemVersionsItemsProjectV2ItemConnection {
+	return v.Items
+}
+
+// GetId returns ProjectItemVersions.Id, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersions) GetId() string { return v.ProjectFieldDefinitions.Id }
+
+// GetTitle returns ProjectItemVersions.Title, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersions) GetTitle() string { return v.ProjectFieldDefinitions.Title }
+
+// GetFields returns ProjectItemVersions.Fields, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersions) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFieldDefinitions.Fields
+}
+
+func (v *ProjectItemVersions) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectItemVersions
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectItemVersions = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectFieldDefinitions)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalProjectItemVersions struct {
+	Items ProjectItemVersionsItemsProjectV2ItemConnection `json:"items"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *ProjectItemVersions) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectItemVersions) __premarshalJSON() (*__premarshalProjectItemVersions, error) {
+	var retval __premarshalProjectItemVersions
+
+	retval.Items = v.Items
+	retval.Id = v.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// ProjectItemVersionsItemsProjectV2ItemConnection includes the requested fields of the GraphQL type ProjectV2ItemConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ProjectV2Item.
+type ProjectItemVersionsItemsProjectV2ItemConnection struct {
+	// Information to aid in pagination.
+	PageInfo ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []ProjectItemVersion `json:"nodes"`
+}
+
+// GetPageInfo returns ProjectItemVersionsItemsProjectV2ItemConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionsItemsProjectV2ItemConnection) GetPageInfo() ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns ProjectItemVersionsItemsProjectV2ItemConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionsItemsProjectV2ItemConnection) GetNodes() []ProjectItemVersion {
+	return v.Nodes
+}
+
+// ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ProjectItemVersionsItemsProjectV2ItemConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ProjectItemsTimeline includes the GraphQL fields of ProjectV2 requested by the fragment ProjectItemsTimeline.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type ProjectItemsTimeline struct {
+	// List of items in the project
+	Items ProjectItemsTimelineItemsProjectV2ItemConnection `json:"items"`
+}
+
+// GetItems returns ProjectItemsTimeline.Items, and is useful for accessing the field via an interface.
+func (v *ProjectItemsTimeline) GetItems() ProjectItemsTimelineItemsProjectV2ItemConnection {
+	return v.Items
+}
+
+// ProjectItemsTimelineItemsProjectV2ItemConnection includes the requested fields of the GraphQL type ProjectV2ItemConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ProjectV2Item.
+type ProjectItemsTimelineItemsProjectV2ItemConnection struct {
+	// Information to aid in pagination.
+	PageInfo ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []ProjectItemTimeline `json:"nodes"`
+}
+
+// GetPageInfo returns ProjectItemsTimelineItemsProjectV2ItemConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ProjectItemsTimelineItemsProjectV2ItemConnection) GetPageInfo() ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns ProjectItemsTimelineItemsProjectV2ItemConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectItemsTimelineItemsProjectV2ItemConnection) GetNodes() []ProjectItemTimeline {
+	return v.Nodes
+}
+
+// ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ProjectItemsTimelineItemsProjectV2ItemConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ProjectSettings includes the GraphQL fields of ProjectV2 requested by the fragment ProjectSettings.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type ProjectSettings struct {
+	ProjectFieldDefinitions `json:"-"`
+}
+
+// GetId returns ProjectSettings.Id, and is useful for accessing the field via an interface.
+func (v *ProjectSettings) GetId() string { return v.ProjectFieldDefinitions.Id }
+
+// GetTitle returns ProjectSettings.Title, and is useful for accessing the field via an interface.
+func (v *ProjectSettings) GetTitle() string { return v.ProjectFieldDefinitions.Title }
+
+// GetFields returns ProjectSettings.Fields, and is useful for accessing the field via an interface.
+func (v *ProjectSettings) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFieldDefinitions.Fields
+}
+
+func (v *ProjectSettings) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProjectSettings
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProjectSettings = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectFieldDefinitions)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalProjectSettings struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *ProjectSettings) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProjectSettings) __premarshalJSON() (*__premarshalProjectSettings, error) {
+	var retval __premarshalProjectSettings
+
+	retval.Id = v.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// The type of a project field.
+type ProjectV2FieldType string
+
+const (
+	// Assignees
+	ProjectV2FieldTypeAssignees ProjectV2FieldType = "ASSIGNEES"
+	// Date
+	ProjectV2FieldTypeDate ProjectV2FieldType = "DATE"
+	// Iteration
+	ProjectV2FieldTypeIteration ProjectV2FieldType = "ITERATION"
+	// Labels
+	ProjectV2FieldTypeLabels ProjectV2FieldType = "LABELS"
+	// Linked Pull Requests
+	ProjectV2FieldTypeLinkedPullRequests ProjectV2FieldType = "LINKED_PULL_REQUESTS"
+	// Milestone
+	ProjectV2FieldTypeMilestone ProjectV2FieldType = "MILESTONE"
+	// Number
+	ProjectV2FieldTypeNumber ProjectV2FieldType = "NUMBER"
+	// Repository
+	ProjectV2FieldTypeRepository ProjectV2FieldType = "REPOSITORY"
+	// Reviewers
+	ProjectV2FieldTypeReviewers ProjectV2FieldType = "REVIEWERS"
+	// Single Select
+	ProjectV2FieldTypeSingleSelect ProjectV2FieldType = "SINGLE_SELECT"
+	// Text
+	ProjectV2FieldTypeText ProjectV2FieldType = "TEXT"
+	// Title
+	ProjectV2FieldTypeTitle ProjectV2FieldType = "TITLE"
+	// Tracked by
+	ProjectV2FieldTypeTrackedBy ProjectV2FieldType = "TRACKED_BY"
+	// Tracks
+	ProjectV2FieldTypeTracks ProjectV2FieldType = "TRACKS"
+)
+
+// PullRequestTimelineEvent includes the requested fields of the GraphQL interface PullRequestTimelineItems.
+//
+// PullRequestTimelineEvent is implemented by the following types:
+// PullRequestTimelineEventAddedToMergeQueueEvent
+// PullRequestTimelineEventAddedToProjectEvent
+// PullRequestTimelineEventAssignedEvent
+// PullRequestTimelineEventAutoMergeDisabledEvent
+// PullRequestTimelineEventAutoMergeEnabledEvent
+// PullRequestTimelineEventAutoRebaseEnabledEvent
+// PullRequestTimelineEventAutoSquashEnabledEvent
+// PullRequestTimelineEventAutomaticBaseChangeFailedEvent
+// PullRequestTimelineEventAutomaticBaseChangeSucceededEvent
+// PullRequestTimelineEventBaseRefChangedEvent
+// PullRequestTimelineEventBaseRefDeletedEvent
+// PullRequestTimelineEventBaseRefForcePushedEvent
+// PullRequestTimelineEventClosedEvent
+// PullRequestTimelineEventCommentDeletedEvent
+// PullRequestTimelineEventConnectedEvent
+// PullRequestTimelineEventConvertToDraftEvent
+// PullRequestTimelineEventConvertedNoteToIssueEvent
+// PullRequestTimelineEventConvertedToDiscussionEvent
+// PullRequestTimelineEventCrossReferencedEvent
+// PullRequestTimelineEventDemilestonedEvent
+// PullRequestTimelineEventDeployedEvent
+// PullRequestTimelineEventDeploymentEnvironmentChangedEvent
+// PullRequestTimelineEventDisconnectedEvent
+// PullRequestTimelineEventHeadRefDeletedEvent
+// PullRequestTimelineEventHeadRefForcePushedEvent
+// PullRequestTimelineEventHeadRefRestoredEvent
+// PullRequestTimelineEventIssueComment
+// PullRequestTimelineEventLabeledEvent
+// PullRequestTimelineEventLockedEvent
+// PullRequestTimelineEventMarkedAsDuplicateEvent
+// PullRequestTimelineEventMentionedEvent
+// PullRequestTimelineEventMergedEvent
+// PullRequestTimelineEventMilestonedEvent
+// PullRequestTimelineEventMovedColumnsInProjectEvent
+// PullRequestTimelineEventPinnedEvent
+// PullRequestTimelineEventPullRequestCommit
+// PullRequestTimelineEventPullRequestCommitCommentThread
+// PullRequestTimelineEventPullRequestReview
+// PullRequestTimelineEventPullRequestReviewThread
+// PullRequestTimelineEventPullRequestRevisionMarker
+// PullRequestTimelineEventReadyForReviewEvent
+// PullRequestTimelineEventReferencedEvent
+// PullRequestTimelineEventRemovedFromMergeQueueEvent
+// PullRequestTimelineEventRemovedFromProjectEvent
+// PullRequestTimelineEventRenamedTitleEvent
+// PullRequestTimelineEventReopenedEvent
+// PullRequestTimelineEventReviewDismissedEvent
+// PullRequestTimelineEventReviewRequestRemovedEvent
+// PullRequestTimelineEventReviewRequestedEvent
+// PullRequestTimelineEventSubscribedEvent
+// PullRequestTimelineEventTransferredEvent
+// PullRequestTimelineEventUnassignedEvent
+// PullRequestTimelineEventUnlabeledEvent
+// PullRequestTimelineEventUnlockedEvent
+// PullRequestTimelineEventUnmarkedAsDuplicateEvent
+// PullRequestTimelineEventUnpinnedEvent
+// PullRequestTimelineEventUnsubscribedEvent
+// PullRequestTimelineEventUserBlockedEvent
+// The GraphQL type's documentation follows.
+//
+// An item in a pull request timeline
+type PullRequestTimelineEvent interface {
+	implementsGraphQLInterfacePullRequestTimelineEvent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *PullRequestTimelineEventAddedToMergeQueueEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAddedToProjectEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAssignedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutoMergeDisabledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutoMergeEnabledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutoRebaseEnabledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutoSquashEnabledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutomaticBaseChangeFailedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventAutomaticBaseChangeSucceededEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventBaseRefChangedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventBaseRefDeletedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventBaseRefForcePushedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventClosedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {}
+func (v *PullRequestTimelineEventCommentDeletedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventConnectedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventConvertToDraftEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventConvertedNoteToIssueEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventConvertedToDiscussionEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventCrossReferencedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventDemilestonedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventDeployedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventDeploymentEnvironmentChangedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventDisconnectedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventHeadRefDeletedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventHeadRefForcePushedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventHeadRefRestoredEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventIssueComment) implementsGraphQLInterfacePullRequestTimelineEvent() {}
+func (v *PullRequestTimelineEventLabeledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {}
+func (v *PullRequestTimelineEventLockedEvent) implementsGraphQLInterfacePullRequestTimelineEvent()  {}
+func (v *PullRequestTimelineEventMarkedAsDuplicateEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventMentionedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventMergedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {}
+func (v *PullRequestTimelineEventMilestonedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventMovedColumnsInProjectEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventPinnedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {}
+func (v *PullRequestTimelineEventPullRequestCommit) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventPullRequestCommitCommentThread) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventPullRequestReview) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventPullRequestReviewThread) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventPullRequestRevisionMarker) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReadyForReviewEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReferencedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventRemovedFromMergeQueueEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventRemovedFromProjectEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventRenamedTitleEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReopenedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReviewDismissedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReviewRequestRemovedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventReviewRequestedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventSubscribedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventTransferredEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnassignedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnlabeledEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnlockedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnmarkedAsDuplicateEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnpinnedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUnsubscribedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+func (v *PullRequestTimelineEventUserBlockedEvent) implementsGraphQLInterfacePullRequestTimelineEvent() {
+}
+
+func __unmarshalPullRequestTimelineEvent(b []byte, v *PullRequestTimelineEvent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AddedToMergeQueueEvent":
+		*v = new(PullRequestTimelineEventAddedToMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "AddedToProjectEvent":
+		*v = new(PullRequestTimelineEventAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(PullRequestTimelineEventAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeDisabledEvent":
+		*v = new(PullRequestTimelineEventAutoMergeDisabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeEnabledEvent":
+		*v = new(PullRequestTimelineEventAutoMergeEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoRebaseEnabledEvent":
+		*v = new(PullRequestTimelineEventAutoRebaseEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoSquashEnabledEvent":
+		*v = new(PullRequestTimelineEventAutoSquashEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeFailedEvent":
+		*v = new(PullRequestTimelineEventAutomaticBaseChangeFailedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeSucceededEvent":
+		*v = new(PullRequestTimelineEventAutomaticBaseChangeSucceededEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefChangedEvent":
+		*v = new(PullRequestTimelineEventBaseRefChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefDeletedEvent":
+		*v = new(PullRequestTimelineEventBaseRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefForcePushedEvent":
+		*v = new(PullRequestTimelineEventBaseRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(PullRequestTimelineEventClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(PullRequestTimelineEventCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(PullRequestTimelineEventConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertToDraftEvent":
+		*v = new(PullRequestTimelineEventConvertToDraftEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(PullRequestTimelineEventConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(PullRequestTimelineEventConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(PullRequestTimelineEventCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(PullRequestTimelineEventDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeployedEvent":
+		*v = new(PullRequestTimelineEventDeployedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEnvironmentChangedEvent":
+		*v = new(PullRequestTimelineEventDeploymentEnvironmentChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(PullRequestTimelineEventDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefDeletedEvent":
+		*v = new(PullRequestTimelineEventHeadRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefForcePushedEvent":
+		*v = new(PullRequestTimelineEventHeadRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefRestoredEvent":
+		*v = new(PullRequestTimelineEventHeadRefRestoredEvent)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(PullRequestTimelineEventIssueComment)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(PullRequestTimelineEventLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(PullRequestTimelineEventLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(PullRequestTimelineEventMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(PullRequestTimelineEventMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MergedEvent":
+		*v = new(PullRequestTimelineEventMergedEvent)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(PullRequestTimelineEventMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(PullRequestTimelineEventMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(PullRequestTimelineEventPinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommit":
+		*v = new(PullRequestTimelineEventPullRequestCommit)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommitCommentThread":
+		*v = new(PullRequestTimelineEventPullRequestCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReview":
+		*v = new(PullRequestTimelineEventPullRequestReview)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewThread":
+		*v = new(PullRequestTimelineEventPullRequestReviewThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestRevisionMarker":
+		*v = new(PullRequestTimelineEventPullRequestRevisionMarker)
+		return json.Unmarshal(b, *v)
+	case "ReadyForReviewEvent":
+		*v = new(PullRequestTimelineEventReadyForReviewEvent)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(PullRequestTimelineEventReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromMergeQueueEvent":
+		*v = new(PullRequestTimelineEventRemovedFromMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(PullRequestTimelineEventRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(PullRequestTimelineEventRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(PullRequestTimelineEventReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissedEvent":
+		*v = new(PullRequestTimelineEventReviewDismissedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestRemovedEvent":
+		*v = new(PullRequestTimelineEventReviewRequestRemovedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestedEvent":
+		*v = new(PullRequestTimelineEventReviewRequestedEvent)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(PullRequestTimelineEventSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(PullRequestTimelineEventTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(PullRequestTimelineEventUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(PullRequestTimelineEventUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(PullRequestTimelineEventUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(PullRequestTimelineEventUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(PullRequestTimelineEventUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(PullRequestTimelineEventUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(PullRequestTimelineEventUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PullRequestTimelineItems.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for PullRequestTimelineEvent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalPullRequestTimelineEvent(v *PullRequestTimelineEvent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *PullRequestTimelineEventAddedToMergeQueueEvent:
+		typename = "AddedToMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAddedToMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAssignedEvent:
+		typename = "AssignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutoMergeDisabledEvent:
+		typename = "AutoMergeDisabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutoMergeDisabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutoMergeEnabledEvent:
+		typename = "AutoMergeEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutoMergeEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutoRebaseEnabledEvent:
+		typename = "AutoRebaseEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutoRebaseEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutoSquashEnabledEvent:
+		typename = "AutoSquashEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutoSquashEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutomaticBaseChangeFailedEvent:
+		typename = "AutomaticBaseChangeFailedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutomaticBaseChangeFailedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventAutomaticBaseChangeSucceededEvent:
+		typename = "AutomaticBaseChangeSucceededEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventAutomaticBaseChangeSucceededEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventBaseRefChangedEvent:
+		typename = "BaseRefChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventBaseRefChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventBaseRefDeletedEvent:
+		typename = "BaseRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventBaseRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventBaseRefForcePushedEvent:
+		typename = "BaseRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventBaseRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventClosedEvent:
+		typename = "ClosedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventConnectedEvent:
+		typename = "ConnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventConvertToDraftEvent:
+		typename = "ConvertToDraftEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventConvertToDraftEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventDemilestonedEvent:
+		typename = "DemilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventDeployedEvent:
+		typename = "DeployedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventDeployedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventDeploymentEnvironmentChangedEvent:
+		typename = "DeploymentEnvironmentChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventDeploymentEnvironmentChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventDisconnectedEvent:
+		typename = "DisconnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventHeadRefDeletedEvent:
+		typename = "HeadRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventHeadRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventHeadRefForcePushedEvent:
+		typename = "HeadRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventHeadRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventHeadRefRestoredEvent:
+		typename = "HeadRefRestoredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventHeadRefRestoredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventIssueComment:
+		typename = "IssueComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventLabeledEvent:
+		typename = "LabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventLockedEvent:
+		typename = "LockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventMentionedEvent:
+		typename = "MentionedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventMergedEvent:
+		typename = "MergedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventMergedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventMilestonedEvent:
+		typename = "MilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPinnedEvent:
+		typename = "PinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPullRequestCommit:
+		typename = "PullRequestCommit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPullRequestCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPullRequestCommitCommentThread:
+		typename = "PullRequestCommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPullRequestCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPullRequestReview:
+		typename = "PullRequestReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPullRequestReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPullRequestReviewThread:
+		typename = "PullRequestReviewThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPullRequestReviewThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventPullRequestRevisionMarker:
+		typename = "PullRequestRevisionMarker"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventPullRequestRevisionMarker
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReadyForReviewEvent:
+		typename = "ReadyForReviewEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReadyForReviewEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReferencedEvent:
+		typename = "ReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventRemovedFromMergeQueueEvent:
+		typename = "RemovedFromMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventRemovedFromMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReopenedEvent:
+		typename = "ReopenedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReviewDismissedEvent:
+		typename = "ReviewDismissedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReviewDismissedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReviewRequestRemovedEvent:
+		typename = "ReviewRequestRemovedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReviewRequestRemovedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventReviewRequestedEvent:
+		typename = "ReviewRequestedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventReviewRequestedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventSubscribedEvent:
+		typename = "SubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventTransferredEvent:
+		typename = "TransferredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnassignedEvent:
+		typename = "UnassignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnlabeledEvent:
+		typename = "UnlabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnlockedEvent:
+		typename = "UnlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnpinnedEvent:
+		typename = "UnpinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnpinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUnsubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestTimelineEventUserBlockedEvent:
+		typename = "UserBlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestTimelineEventUserBlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for PullRequestTimelineEvent: "%T"`, v)
+	}
+}
+
+// PullRequestTimelineEventAddedToMergeQueueEvent includes the requested fields of the GraphQL type AddedToMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'added_to_merge_queue' event on a given pull request.
+type PullRequestTimelineEventAddedToMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAddedToMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAddedToMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'added_to_project' event on a given issue or pull request.
+type PullRequestTimelineEventAddedToProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type PullRequestTimelineEventAssignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAssignedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAutoMergeDisabledEvent includes the requested fields of the GraphQL type AutoMergeDisabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_disabled' event on a given pull request.
+type PullRequestTimelineEventAutoMergeDisabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutoMergeDisabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutoMergeDisabledEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAutoMergeEnabledEvent includes the requested fields of the GraphQL type AutoMergeEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_enabled' event on a given pull request.
+type PullRequestTimelineEventAutoMergeEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutoMergeEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutoMergeEnabledEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAutoRebaseEnabledEvent includes the requested fields of the GraphQL type AutoRebaseEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_rebase_enabled' event on a given pull request.
+type PullRequestTimelineEventAutoRebaseEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutoRebaseEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutoRebaseEnabledEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAutoSquashEnabledEvent includes the requested fields of the GraphQL type AutoSquashEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_squash_enabled' event on a given pull request.
+type PullRequestTimelineEventAutoSquashEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutoSquashEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutoSquashEnabledEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventAutomaticBaseChangeFailedEvent includes the requested fields of the GraphQL type AutomaticBaseChangeFailedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_failed' event on a given pull request.
+type PullRequestTimelineEventAutomaticBaseChangeFailedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutomaticBaseChangeFailedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutomaticBaseChangeFailedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// PullRequestTimelineEventAutomaticBaseChangeSucceededEvent includes the requested fields of the GraphQL type AutomaticBaseChangeSucceededEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_succeeded' event on a given pull request.
+type PullRequestTimelineEventAutomaticBaseChangeSucceededEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventAutomaticBaseChangeSucceededEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventAutomaticBaseChangeSucceededEvent) GetTypename() string {
+	return v.Typename
+}
+
+// PullRequestTimelineEventBaseRefChangedEvent includes the requested fields of the GraphQL type BaseRefChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_changed' event on a given issue or pull request.
+type PullRequestTimelineEventBaseRefChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventBaseRefChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventBaseRefChangedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventBaseRefDeletedEvent includes the requested fields of the GraphQL type BaseRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_deleted' event on a given pull request.
+type PullRequestTimelineEventBaseRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventBaseRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventBaseRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventBaseRefForcePushedEvent includes the requested fields of the GraphQL type BaseRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_force_pushed' event on a given pull request.
+type PullRequestTimelineEventBaseRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventBaseRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventBaseRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'closed' event on any `Closable`.
+type PullRequestTimelineEventClosedEvent struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetTypename returns PullRequestTimelineEventClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventClosedEvent) GetTypename() string { return v.Typename }
+
+// GetCreatedAt returns PullRequestTimelineEventClosedEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventClosedEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// PullRequestTimelineEventCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type PullRequestTimelineEventCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventCommentDeletedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'connected' event on a given issue or pull request.
+type PullRequestTimelineEventConnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventConnectedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventConvertToDraftEvent includes the requested fields of the GraphQL type ConvertToDraftEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'convert_to_draft' event on a given pull request.
+type PullRequestTimelineEventConvertToDraftEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventConvertToDraftEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventConvertToDraftEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type PullRequestTimelineEventConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventConvertedNoteToIssueEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_to_discussion' event on a given issue.
+type PullRequestTimelineEventConvertedToDiscussionEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventConvertedToDiscussionEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a mention made by one issue or pull request to another.
+type PullRequestTimelineEventCrossReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventCrossReferencedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'demilestoned' event on a given issue or pull request.
+type PullRequestTimelineEventDemilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventDemilestonedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventDeployedEvent includes the requested fields of the GraphQL type DeployedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployed' event on a given pull request.
+type PullRequestTimelineEventDeployedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventDeployedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventDeployedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventDeploymentEnvironmentChangedEvent includes the requested fields of the GraphQL type DeploymentEnvironmentChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployment_environment_changed' event on a given pull request.
+type PullRequestTimelineEventDeploymentEnvironmentChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventDeploymentEnvironmentChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventDeploymentEnvironmentChangedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// PullRequestTimelineEventDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'disconnected' event on a given issue or pull request.
+type PullRequestTimelineEventDisconnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventDisconnectedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventHeadRefDeletedEvent includes the requested fields of the GraphQL type HeadRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_deleted' event on a given pull request.
+type PullRequestTimelineEventHeadRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventHeadRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventHeadRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventHeadRefForcePushedEvent includes the requested fields of the GraphQL type HeadRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_force_pushed' event on a given pull request.
+type PullRequestTimelineEventHeadRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventHeadRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventHeadRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventHeadRefRestoredEvent includes the requested fields of the GraphQL type HeadRefRestoredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_restored' event on a given pull request.
+type PullRequestTimelineEventHeadRefRestoredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventHeadRefRestoredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventHeadRefRestoredEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventIssueComment includes the requested fields of the GraphQL type IssueComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Issue.
+type PullRequestTimelineEventIssueComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventIssueComment) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type PullRequestTimelineEventLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
+}
+
+// GetName returns PullRequestTimelineEventLabel.Name, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventLabel) GetName() string { return v.Name }
+
+// PullRequestTimelineEventLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'labeled' event on a given issue or pull request.
+type PullRequestTimelineEventLabeledEvent struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the label associated with the 'labeled' event.
+	Label PullRequestTimelineEventLabel `json:"label"`
+}
+
+// GetTypename returns PullRequestTimelineEventLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventLabeledEvent) GetTypename() string { return v.Typename }
+
+// GetCreatedAt returns PullRequestTimelineEventLabeledEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventLabeledEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetLabel returns PullRequestTimelineEventLabeledEvent.Label, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventLabeledEvent) GetLabel() PullRequestTimelineEventLabel {
+	return v.Label
+}
+
+// PullRequestTimelineEventLockedEvent includes the requested fields of the GraphQL type LockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'locked' event on a given issue or pull request.
+type PullRequestTimelineEventLockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventLockedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type PullRequestTimelineEventMarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventMarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'mentioned' event on a given issue or pull request.
+type PullRequestTimelineEventMentionedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventMentionedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventMergedEvent includes the requested fields of the GraphQL type MergedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'merged' event on a given pull request.
+type PullRequestTimelineEventMergedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventMergedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventMergedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'milestoned' event on a given issue or pull request.
+type PullRequestTimelineEventMilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventMilestonedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type PullRequestTimelineEventMovedColumnsInProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventMovedColumnsInProjectEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventPinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'pinned' event on a given issue or pull request.
+type PullRequestTimelineEventPinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPinnedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventPullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type PullRequestTimelineEventPullRequestCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPullRequestCommit.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPullRequestCommit) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventPullRequestCommitCommentThread includes the requested fields of the GraphQL type PullRequestCommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit comment thread part of a pull request.
+type PullRequestTimelineEventPullRequestCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPullRequestCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPullRequestCommitCommentThread) GetTypename() string {
+	return v.Typename
+}
+
+// PullRequestTimelineEventPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type PullRequestTimelineEventPullRequestReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPullRequestReview.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPullRequestReview) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type PullRequestTimelineEventPullRequestReviewThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPullRequestReviewThread.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPullRequestReviewThread) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventPullRequestRevisionMarker includes the requested fields of the GraphQL type PullRequestRevisionMarker.
+// The GraphQL type's documentation follows.
+//
+// Represents the latest point in the pull request timeline for which the viewer has seen the pull request's commits.
+type PullRequestTimelineEventPullRequestRevisionMarker struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventPullRequestRevisionMarker.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventPullRequestRevisionMarker) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventReadyForReviewEvent includes the requested fields of the GraphQL type ReadyForReviewEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'ready_for_review' event on a given pull request.
+type PullRequestTimelineEventReadyForReviewEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventReadyForReviewEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReadyForReviewEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type PullRequestTimelineEventReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReferencedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventRemovedFromMergeQueueEvent includes the requested fields of the GraphQL type RemovedFromMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_merge_queue' event on a given pull request.
+type PullRequestTimelineEventRemovedFromMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventRemovedFromMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventRemovedFromMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type PullRequestTimelineEventRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventRemovedFromProjectEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'renamed' event on a given issue or pull request
+type PullRequestTimelineEventRenamedTitleEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventRenamedTitleEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'reopened' event on any `Closable`.
+type PullRequestTimelineEventReopenedEvent struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetTypename returns PullRequestTimelineEventReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReopenedEvent) GetTypename() string { return v.Typename }
+
+// GetCreatedAt returns PullRequestTimelineEventReopenedEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReopenedEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// PullRequestTimelineEventReviewDismissedEvent includes the requested fields of the GraphQL type ReviewDismissedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'review_dismissed' event on a given issue or pull request.
+type PullRequestTimelineEventReviewDismissedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventReviewDismissedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReviewDismissedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventReviewRequestRemovedEvent includes the requested fields of the GraphQL type ReviewRequestRemovedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_request_removed' event on a given pull request.
+type PullRequestTimelineEventReviewRequestRemovedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventReviewRequestRemovedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReviewRequestRemovedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventReviewRequestedEvent includes the requested fields of the GraphQL type ReviewRequestedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_requested' event on a given pull request.
+type PullRequestTimelineEventReviewRequestedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventReviewRequestedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventReviewRequestedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'subscribed' event on a given `Subscribable`.
+type PullRequestTimelineEventSubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventSubscribedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'transferred' event on a given issue or pull request.
+type PullRequestTimelineEventTransferredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventTransferredEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unassigned' event on any assignable object.
+type PullRequestTimelineEventUnassignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnassignedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlabeled' event on a given issue or pull request.
+type PullRequestTimelineEventUnlabeledEvent struct {
+	Typename string `json:"__typename"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the label associated with the 'unlabeled' event.
+	Label PullRequestTimelineEventLabel `json:"label"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// GetCreatedAt returns PullRequestTimelineEventUnlabeledEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnlabeledEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetLabel returns PullRequestTimelineEventUnlabeledEvent.Label, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnlabeledEvent) GetLabel() PullRequestTimelineEventLabel {
+	return v.Label
+}
+
+// PullRequestTimelineEventUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlocked' event on a given issue or pull request.
+type PullRequestTimelineEventUnlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnlockedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type PullRequestTimelineEventUnmarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnmarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unpinned' event on a given issue or pull request.
+type PullRequestTimelineEventUnpinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnpinnedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type PullRequestTimelineEventUnsubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUnsubscribedEvent) GetTypename() string { return v.Typename }
+
+// PullRequestTimelineEventUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'user_blocked' event on a given user.
+type PullRequestTimelineEventUserBlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns PullRequestTimelineEventUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestTimelineEventUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// __getOrganizationProjectInput is used internally by genqlient
+type __getOrganizationProjectInput struct {
+	Organization_name         string `json:"organization_name"`
+	Project_number            int    `json:"project_number"`
+	Labels_per_issue_count    int    `json:"labels_per_issue_count"`
+	Assignees_per_issue_count int    `json:"assignees_per_issue_count"`
+	Cursor                    string `json:"cursor"`
+}
+
+// GetOrganization_name returns __getOrganizationProjectInput.Organization_name, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectInput) GetOrganization_name() string { return v.Organization_name }
+
+// GetProject_number returns __getOrganizationProjectInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectInput) GetProject_number() int { return v.Project_number }
+
+// GetLabels_per_issue_count returns __getOrganizationProjectInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectInput) GetLabels_per_issue_count() int {
+	return v.Labels_per_issue_count
+}
+
+// GetAssignees_per_issue_count returns __getOrganizationProjectInput.Assignees_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectInput) GetAssignees_per_issue_count() int {
+	return v.Assignees_per_issue_count
+}
+
+// GetCursor returns __getOrganizationProjectInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectInput) GetCursor() string { return v.Cursor }
+
+// __getOrganizationProjectItemVersionsInput is used internally by genqlient
+type __getOrganizationProjectItemVersionsInput struct {
+	Organization_name string `json:"organization_name"`
+	Project_number    int    `json:"project_number"`
+	Cursor            string `json:"cursor"`
+}
+
+// GetOrganization_name returns __getOrganizationProjectItemVersionsInput.Organization_name, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectItemVersionsInput) GetOrganization_name() string {
+	return v.Organization_name
+}
+
+// GetProject_number returns __getOrganizationProjectItemVersionsInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectItemVersionsInput) GetProject_number() int { return v.Project_number }
+
+// GetCursor returns __getOrganizationProjectItemVersionsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectItemVersionsInput) GetCursor() string { return v.Cursor }
+
+// __getOrganizationProjectSettingsInput is used internally by genqlient
+type __getOrganizationProjectSettingsInput struct {
+	Organization_name string `json:"organization_name"`
+	Project_number    int    `json:"project_number"`
+}
+
+// GetOrganization_name returns __getOrganizationProjectSettingsInput.Organization_name, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectSettingsInput) GetOrganization_name() string {
+	return v.Organization_name
+}
+
+// GetProject_number returns __getOrganizationProjectSettingsInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectSettingsInput) GetProject_number() int { return v.Project_number }
+
+// __getOrganizationProjectTimelineInput is used internally by genqlient
+type __getOrganizationProjectTimelineInput struct {
+	Organization_name string `json:"organization_name"`
+	Project_number    int    `json:"project_number"`
+	Cursor            string `json:"cursor"`
+}
+
+// GetOrganization_name returns __getOrganizationProjectTimelineInput.Organization_name, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectTimelineInput) GetOrganization_name() string {
+	return v.Organization_name
+}
+
+// GetProject_number returns __getOrganizationProjectTimelineInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectTimelineInput) GetProject_number() int { return v.Project_number }
+
+// GetCursor returns __getOrganizationProjectTimelineInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectTimelineInput) GetCursor() string { return v.Cursor }
+
+// __getOrganizationProjectsInput is used internally by genqlient
+type __getOrganizationProjectsInput struct {
+	Organization_name string `json:"organization_name"`
+	Cursor            string `json:"cursor"`
+}
+
+// GetOrganization_name returns __getOrganizationProjectsInput.Organization_name, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectsInput) GetOrganization_name() string { return v.Organization_name }
+
+// GetCursor returns __getOrganizationProjectsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationProjectsInput) GetCursor() string { return v.Cursor }
+
+// __getProjectItemInput is used internally by genqlient
+type __getProjectItemInput struct {
+	Item_id                   string `json:"item_id"`
+	Labels_per_issue_count    int    `json:"labels_per_issue_count"`
+	Assignees_per_issue_count int    `json:"assignees_per_issue_count"`
+}
+
+// GetItem_id returns __getProjectItemInput.Item_id, and is useful for accessing the field via an interface.
+func (v *__getProjectItemInput) GetItem_id() string { return v.Item_id }
+
+// GetLabels_per_issue_count returns __getProjectItemInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getProjectItemInput) GetLabels_per_issue_count() int { return v.Labels_per_issue_count }
+
+// GetAssignees_per_issue_count returns __getProjectItemInput.Assignees_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getProjectItemInput) GetAssignees_per_issue_count() int {
+	return v.Assignees_per_issue_count
+}
+
+// __getProjectItemsInput is used internally by genqlient
+type __getProjectItemsInput struct {
+	Item_ids                  []string `json:"item_ids"`
+	Labels_per_issue_count    int      `json:"labels_per_issue_count"`
+	Assignees_per_issue_count int      `json:"assignees_per_issue_count"`
+}
+
+// GetItem_ids returns __getProjectItemsInput.Item_ids, and is useful for accessing the field via an interface.
+func (v *__getProjectItemsInput) GetItem_ids() []string { return v.Item_ids }
+
+// GetLabels_per_issue_count returns __getProjectItemsInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getProjectItemsInput) GetLabels_per_issue_count() int { return v.Labels_per_issue_count }
+
+// GetAssignees_per_issue_count returns __getProjectItemsInput.Assignees_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getProjectItemsInput) GetAssignees_per_issue_count() int {
+	return v.Assignees_per_issue_count
+}
+
+// __getRepositoryProjectInput is used internally by genqlient
+type __getRepositoryProjectInput struct {
+	Repo_owner                string `json:"repo_owner"`
+	Repo_name                 string `json:"repo_name"`
+	Project_number            int    `json:"project_number"`
+	Labels_per_issue_count    int    `json:"labels_per_issue_count"`
+	Assignees_per_issue_count int    `json:"assignees_per_issue_count"`
+	Cursor                    string `json:"cursor"`
+}
+
+// GetRepo_owner returns __getRepositoryProjectInput.Repo_owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetRepo_owner() string { return v.Repo_owner }
+
+// GetRepo_name returns __getRepositoryProjectInput.Repo_name, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetRepo_name() string { return v.Repo_name }
+
+// GetProject_number returns __getRepositoryProjectInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetProject_number() int { return v.Project_number }
+
+// GetLabels_per_issue_count returns __getRepositoryProjectInput.Labels_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetLabels_per_issue_count() int {
+	return v.Labels_per_issue_count
+}
+
+// GetAssignees_per_issue_count returns __getRepositoryProjectInput.Assignees_per_issue_count, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetAssignees_per_issue_count() int {
+	return v.Assignees_per_issue_count
+}
+
+// GetCursor returns __getRepositoryProjectInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectInput) GetCursor() string { return v.Cursor }
+
+// __getRepositoryProjectItemVersionsInput is used internally by genqlient
+type __getRepositoryProjectItemVersionsInput struct {
+	Repo_owner     string `json:"repo_owner"`
+	Repo_name      string `json:"repo_name"`
+	Project_number int    `json:"project_number"`
+	Cursor         string `json:"cursor"`
+}
+
+// GetRepo_owner returns __getRepositoryProjectItemVersionsInput.Repo_owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectItemVersionsInput) GetRepo_owner() string { return v.Repo_owner }
+
+// GetRepo_name returns __getRepositoryProjectItemVersionsInput.Repo_name, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectItemVersionsInput) GetRepo_name() string { return v.Repo_name }
+
+// GetProject_number returns __getRepositoryProjectItemVersionsInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectItemVersionsInput) GetProject_number() int { return v.Project_number }
+
+// GetCursor returns __getRepositoryProjectItemVersionsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectItemVersionsInput) GetCursor() string { return v.Cursor }
+
+// __getRepositoryProjectSettingsInput is used internally by genqlient
+type __getRepositoryProjectSettingsInput struct {
+	Repo_owner     string `json:"repo_owner"`
+	Repo_name      string `json:"repo_name"`
+	Project_number int    `json:"project_number"`
+}
+
+// GetRepo_owner returns __getRepositoryProjectSettingsInput.Repo_owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectSettingsInput) GetRepo_owner() string { return v.Repo_owner }
+
+// GetRepo_name returns __getRepositoryProjectSettingsInput.Repo_name, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectSettingsInput) GetRepo_name() string { return v.Repo_name }
+
+// GetProject_number returns __getRepositoryProjectSettingsInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectSettingsInput) GetProject_number() int { return v.Project_number }
+
+// __getRepositoryProjectTimelineInput is used internally by genqlient
+type __getRepositoryProjectTimelineInput struct {
+	Repo_owner     string `json:"repo_owner"`
+	Repo_name      string `json:"repo_name"`
+	Project_number int    `json:"project_number"`
+	Cursor         string `json:"cursor"`
+}
+
+// GetRepo_owner returns __getRepositoryProjectTimelineInput.Repo_owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectTimelineInput) GetRepo_owner() string { return v.Repo_owner }
+
+// GetRepo_name returns __getRepositoryProjectTimelineInput.Repo_name, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectTimelineInput) GetRepo_name() string { return v.Repo_name }
+
+// GetProject_number returns __getRepositoryProjectTimelineInput.Project_number, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectTimelineInput) GetProject_number() int { return v.Project_number }
+
+// GetCursor returns __getRepositoryProjectTimelineInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getRepositoryProjectTimelineInput) GetCursor() string { return v.Cursor }
+
+// getOrganizationProjectItemVersionsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getOrganizationProjectItemVersionsOrganization struct {
+	// Find a project by number.
+	ProjectV2 getOrganizationProjectItemVersionsOrganizationProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getOrganizationProjectItemVersionsOrganization.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsOrganization) GetProjectV2() getOrganizationProjectItemVersionsOrganizationProjectV2 {
+	return v.ProjectV2
+}
+
+// getOrganizationProjectItemVersionsOrganizationProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getOrganizationProjectItemVersionsOrganizationProjectV2 struct {
+	ProjectItemVersions `json:"-"`
+}
+
+// GetItems returns getOrganizationProjectItemVersionsOrganizationProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) GetItems() ProjectItemVersionsItemsProjectV2ItemConnection {
+	return v.ProjectItemVersions.Items
+}
+
+// GetId returns getOrganizationProjectItemVersionsOrganizationProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) GetId() string {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getOrganizationProjectItemVersionsOrganizationProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) GetTitle() string {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getOrganizationProjectItemVersionsOrganizationProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Fields
+}
+
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationProjectItemVersionsOrganizationProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationProjectItemVersionsOrganizationProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItemVersions)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationProjectItemVersionsOrganizationProjectV2 struct {
+	Items ProjectItemVersionsItemsProjectV2ItemConnection `json:"items"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationProjectItemVersionsOrganizationProjectV2) __premarshalJSON() (*__premarshalgetOrganizationProjectItemVersionsOrganizationProjectV2, error) {
+	var retval __premarshalgetOrganizationProjectItemVersionsOrganizationProjectV2
+
+	retval.Items = v.ProjectItemVersions.Items
+	retval.Id = v.ProjectItemVersions.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectItemVersions.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectItemVersions.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getOrganizationProjectItemVersionsResponse is returned by getOrganizationProjectItemVersions on success.
+type getOrganizationProjectItemVersionsResponse struct {
+	// Lookup a organization by login.
+	Organization getOrganizationProjectItemVersionsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationProjectItemVersionsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectItemVersionsResponse) GetOrganization() getOrganizationProjectItemVersionsOrganization {
+	return v.Organization
+}
+
+// getOrganizationProjectOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getOrganizationProjectOrganization struct {
+	// Find a project by number.
+	ProjectV2 getOrganizationProjectOrganizationProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getOrganizationProjectOrganization.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectOrganization) GetProjectV2() getOrganizationProjectOrganizationProjectV2 {
+	return v.ProjectV2
+}
+
+// getOrganizationProjectOrganizationProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getOrganizationProjectOrganizationProjectV2 struct {
+	ProjectFields `json:"-"`
+}
+
+// GetItems returns getOrganizationProjectOrganizationProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectOrganizationProjectV2) GetItems() ProjectFieldsItemsProjectV2ItemConnection {
+	return v.ProjectFields.Items
+}
+
+// GetId returns getOrganizationProjectOrganizationProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectOrganizationProjectV2) GetId() string {
+	return v.ProjectFields.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getOrganizationProjectOrganizationProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectOrganizationProjectV2) GetTitle() string {
+	return v.ProjectFields.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getOrganizationProjectOrganizationProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectOrganizationProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFields.ProjectFieldDefinitions.Fields
+}
+
+func (v *getOrganizationProjectOrganizationProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationProjectOrganizationProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationProjectOrganizationProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationProjectOrganizationProjectV2 struct {
+	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getOrganizationProjectOrganizationProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationProjectOrganizationProjectV2) __premarshalJSON() (*__premarshalgetOrganizationProjectOrganizationProjectV2, error) {
+	var retval __premarshalgetOrganizationProjectOrganizationProjectV2
+
+	retval.Items = v.ProjectFields.Items
+	retval.Id = v.ProjectFields.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFields.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFields.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getOrganizationProjectResponse is returned by getOrganizationProject on success.
+type getOrganizationProjectResponse struct {
+	// Lookup a organization by login.
+	Organization getOrganizationProjectOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationProjectResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectResponse) GetOrganization() getOrganizationProjectOrganization {
+	return v.Organization
+}
+
+// getOrganizationProjectSettingsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getOrganizationProjectSettingsOrganization struct {
+	// Find a project by number.
+	ProjectV2 getOrganizationProjectSettingsOrganizationProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getOrganizationProjectSettingsOrganization.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectSettingsOrganization) GetProjectV2() getOrganizationProjectSettingsOrganizationProjectV2 {
+	return v.ProjectV2
+}
+
+// getOrganizationProjectSettingsOrganizationProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getOrganizationProjectSettingsOrganizationProjectV2 struct {
+	ProjectSettings `json:"-"`
+}
+
+// GetId returns getOrganizationProjectSettingsOrganizationProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) GetId() string {
+	return v.ProjectSettings.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getOrganizationProjectSettingsOrganizationProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) GetTitle() string {
+	return v.ProjectSettings.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getOrganizationProjectSettingsOrganizationProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectSettings.ProjectFieldDefinitions.Fields
+}
+
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationProjectSettingsOrganizationProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationProjectSettingsOrganizationProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectSettings)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationProjectSettingsOrganizationProjectV2 struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationProjectSettingsOrganizationProjectV2) __premarshalJSON() (*__premarshalgetOrganizationProjectSettingsOrganizationProjectV2, error) {
+	var retval __premarshalgetOrganizationProjectSettingsOrganizationProjectV2
+
+	retval.Id = v.ProjectSettings.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectSettings.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectSettings.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getOrganizationProjectSettingsResponse is returned by getOrganizationProjectSettings on success.
+type getOrganizationProjectSettingsResponse struct {
+	// Lookup a organization by login.
+	Organization getOrganizationProjectSettingsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationProjectSettingsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectSettingsResponse) GetOrganization() getOrganizationProjectSettingsOrganization {
+	return v.Organization
+}
+
+// getOrganizationProjectTimelineOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getOrganizationProjectTimelineOrganization struct {
+	// Find a project by number.
+	ProjectV2 getOrganizationProjectTimelineOrganizationProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getOrganizationProjectTimelineOrganization.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectTimelineOrganization) GetProjectV2() getOrganizationProjectTimelineOrganizationProjectV2 {
+	return v.ProjectV2
+}
+
+// getOrganizationProjectTimelineOrganizationProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getOrganizationProjectTimelineOrganizationProjectV2 struct {
+	ProjectItemsTimeline `json:"-"`
+}
+
+// GetItems returns getOrganizationProjectTimelineOrganizationProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectTimelineOrganizationProjectV2) GetItems() ProjectItemsTimelineItemsProjectV2ItemConnection {
+	return v.ProjectItemsTimeline.Items
+}
+
+func (v *getOrganizationProjectTimelineOrganizationProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationProjectTimelineOrganizationProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationProjectTimelineOrganizationProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItemsTimeline)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationProjectTimelineOrganizationProjectV2 struct {
+	Items ProjectItemsTimelineItemsProjectV2ItemConnection `json:"items"`
+}
+
+func (v *getOrganizationProjectTimelineOrganizationProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationProjectTimelineOrganizationProjectV2) __premarshalJSON() (*__premarshalgetOrganizationProjectTimelineOrganizationProjectV2, error) {
+	var retval __premarshalgetOrganizationProjectTimelineOrganizationProjectV2
+
+	retval.Items = v.ProjectItemsTimeline.Items
+	return &retval, nil
+}
+
+// getOrganizationProjectTimelineResponse is returned by getOrganizationProjectTimeline on success.
+type getOrganizationProjectTimelineResponse struct {
+	// Lookup a organization by login.
+	Organization getOrganizationProjectTimelineOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationProjectTimelineResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectTimelineResponse) GetOrganization() getOrganizationProjectTimelineOrganization {
+	return v.Organization
+}
+
+// getOrganizationProjectsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getOrganizationProjectsOrganization struct {
+	// A list of projects under the owner.
+	ProjectsV2 getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection `json:"projectsV2"`
+}
+
+// GetProjectsV2 returns getOrganizationProjectsOrganization.ProjectsV2, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsOrganization) GetProjectsV2() getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection {
+	return v.ProjectsV2
+}
+
+// getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection includes the requested fields of the GraphQL type ProjectV2Connection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for ProjectV2.
+type getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection struct {
+	// Information to aid in pagination.
+	PageInfo getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []OrganizationProject `json:"nodes"`
+}
+
+// GetPageInfo returns getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection) GetPageInfo() getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection.Nodes, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsOrganizationProjectsV2ProjectV2Connection) GetNodes() []OrganizationProject {
+	return v.Nodes
+}
+
+// getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsOrganizationProjectsV2ProjectV2ConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getOrganizationProjectsResponse is returned by getOrganizationProjects on success.
+type getOrganizationProjectsResponse struct {
+	// Lookup a organization by login.
+	Organization getOrganizationProjectsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationProjectsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationProjectsResponse) GetOrganization() getOrganizationProjectsOrganization {
+	return v.Organization
+}
+
+// getProjectItemNode includes the requested fields of the GraphQL interface Node.
+//
+// getProjectItemNode is implemented by the following types:
+// getProjectItemNodeAddedToMergeQueueEvent
+// getProjectItemNodeAddedToProjectEvent
+// getProjectItemNodeApp
+// getProjectItemNodeAssignedEvent
+// getProjectItemNodeAutoMergeDisabledEvent
+// getProjectItemNodeAutoMergeEnabledEvent
+// getProjectItemNodeAutoRebaseEnabledEvent
+// getProjectItemNodeAutoSquashEnabledEvent
+// getProjectItemNodeAutomaticBaseChangeFailedEvent
+// getProjectItemNodeAutomaticBaseChangeSucceededEvent
+// getProjectItemNodeBaseRefChangedEvent
+// getProjectItemNodeBaseRefDeletedEvent
+// getProjectItemNodeBaseRefForcePushedEvent
+// getProjectItemNodeBlob
+// getProjectItemNodeBot
+// getProjectItemNodeBranchProtectionRule
+// getProjectItemNodeBypassForcePushAllowance
+// getProjectItemNodeBypassPullRequestAllowance
+// getProjectItemNodeCWE
+// getProjectItemNodeCheckRun
+// getProjectItemNodeCheckSuite
+// getProjectItemNodeClosedEvent
+// getProjectItemNodeCodeOfConduct
+// getProjectItemNodeCommentDeletedEvent
+// getProjectItemNodeCommit
+// getProjectItemNodeCommitComment
+// getProjectItemNodeCommitCommentThread
+// getProjectItemNodeComparison
+// getProjectItemNodeConnectedEvent
+// getProjectItemNodeConvertToDraftEvent
+// getProjectItemNodeConvertedNoteToIssueEvent
+// getProjectItemNodeConvertedToDiscussionEvent
+// getProjectItemNodeCrossReferencedEvent
+// getProjectItemNodeDemilestonedEvent
+// getProjectItemNodeDependencyGraphManifest
+// getProjectItemNodeDeployKey
+// getProjectItemNodeDeployedEvent
+// getProjectItemNodeDeployment
+// getProjectItemNodeDeploymentEnvironmentChangedEvent
+// getProjectItemNodeDeploymentReview
+// getProjectItemNodeDeploymentStatus
+// getProjectItemNodeDisconnectedEvent
+// getProjectItemNodeDiscussion
+// getProjectItemNodeDiscussionCategory
+// getProjectItemNodeDiscussionComment
+// getProjectItemNodeDiscussionPoll
+// getProjectItemNodeDiscussionPollOption
+// getProjectItemNodeDraftIssue
+// getProjectItemNodeEnterprise
+// getProjectItemNodeEnterpriseAdministratorInvitation
+// getProjectItemNodeEnterpriseIdentityProvider
+// getProjectItemNodeEnterpriseRepositoryInfo
+// getProjectItemNodeEnterpriseServerInstallation
+// getProjectItemNodeEnterpriseServerUserAccount
+// getProjectItemNodeEnterpriseServerUserAccountEmail
+// getProjectItemNodeEnterpriseServerUserAccountsUpload
+// getProjectItemNodeEnterpriseUserAccount
+// getProjectItemNodeEnvironment
+// getProjectItemNodeExternalIdentity
+// getProjectItemNodeGist
+// getProjectItemNodeGistComment
+// getProjectItemNodeHeadRefDeletedEvent
+// getProjectItemNodeHeadRefForcePushedEvent
+// getProjectItemNodeHeadRefRestoredEvent
+// getProjectItemNodeIpAllowListEntry
+// getProjectItemNodeIssue
+// getProjectItemNodeIssueComment
+// getProjectItemNodeLabel
+// getProjectItemNodeLabeledEvent
+// getProjectItemNodeLanguage
+// getProjectItemNodeLicense
+// getProjectItemNodeLinkedBranch
+// getProjectItemNodeLockedEvent
+// getProjectItemNodeMannequin
+// getProjectItemNodeMarkedAsDuplicateEvent
+// getProjectItemNodeMarketplaceCategory
+// getProjectItemNodeMarketplaceListing
+// getProjectItemNodeMemberFeatureRequestNotification
+// getProjectItemNodeMembersCanDeleteReposClearAuditEntry
+// getProjectItemNodeMembersCanDeleteReposDisableAuditEntry
+// getProjectItemNodeMembersCanDeleteReposEnableAuditEntry
+// getProjectItemNodeMentionedEvent
+// getProjectItemNodeMergeQueue
+// getProjectItemNodeMergeQueueEntry
+// getProjectItemNodeMergedEvent
+// getProjectItemNodeMigrationSource
+// getProjectItemNodeMilestone
+// getProjectItemNodeMilestonedEvent
+// getProjectItemNodeMovedColumnsInProjectEvent
+// getProjectItemNodeOIDCProvider
+// getProjectItemNodeOauthApplicationCreateAuditEntry
+// getProjectItemNodeOrgAddBillingManagerAuditEntry
+// getProjectItemNodeOrgAddMemberAuditEntry
+// getProjectItemNodeOrgBlockUserAuditEntry
+// getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+// getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+// getProjectItemNodeOrgCreateAuditEntry
+// getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry
+// getProjectItemNodeOrgDisableSamlAuditEntry
+// getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry
+// getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry
+// getProjectItemNodeOrgEnableSamlAuditEntry
+// getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry
+// getProjectItemNodeOrgInviteMemberAuditEntry
+// getProjectItemNodeOrgInviteToBusinessAuditEntry
+// getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry
+// getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry
+// getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry
+// getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry
+// getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry
+// getProjectItemNodeOrgRemoveBillingManagerAuditEntry
+// getProjectItemNodeOrgRemoveMemberAuditEntry
+// getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry
+// getProjectItemNodeOrgRestoreMemberAuditEntry
+// getProjectItemNodeOrgUnblockUserAuditEntry
+// getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+// getProjectItemNodeOrgUpdateMemberAuditEntry
+// getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+// getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+// getProjectItemNodeOrganization
+// getProjectItemNodeOrganizationIdentityProvider
+// getProjectItemNodeOrganizationInvitation
+// getProjectItemNodeOrganizationMigration
+// getProjectItemNodePackage
+// getProjectItemNodePackageFile
+// getProjectItemNodePackageTag
+// getProjectItemNodePackageVersion
+// getProjectItemNodePinnedDiscussion
+// getProjectItemNodePinnedEnvironment
+// getProjectItemNodePinnedEvent
+// getProjectItemNodePinnedIssue
+// getProjectItemNodePrivateRepositoryForkingDisableAuditEntry
+// getProjectItemNodePrivateRepositoryForkingEnableAuditEntry
+// getProjectItemNodeProject
+// getProjectItemNodeProjectCard
+// getProjectItemNodeProjectColumn
+// getProjectItemNodeProjectV2
+// getProjectItemNodeProjectV2Field
+// getProjectItemNodeProjectV2Item
+// getProjectItemNodeProjectV2ItemFieldDateValue
+// getProjectItemNodeProjectV2ItemFieldIterationValue
+// getProjectItemNodeProjectV2ItemFieldNumberValue
+// getProjectItemNodeProjectV2ItemFieldSingleSelectValue
+// getProjectItemNodeProjectV2ItemFieldTextValue
+// getProjectItemNodeProjectV2IterationField
+// getProjectItemNodeProjectV2SingleSelectField
+// getProjectItemNodeProjectV2View
+// getProjectItemNodeProjectV2Workflow
+// getProjectItemNodePublicKey
+// getProjectItemNodePullRequest
+// getProjectItemNodePullRequestCommit
+// getProjectItemNodePullRequestCommitCommentThread
+// getProjectItemNodePullRequestReview
+// getProjectItemNodePullRequestReviewComment
+// getProjectItemNodePullRequestReviewThread
+// getProjectItemNodePullRequestThread
+// getProjectItemNodePush
+// getProjectItemNodePushAllowance
+// getProjectItemNodeReaction
+// getProjectItemNodeReadyForReviewEvent
+// getProjectItemNodeRef
+// getProjectItemNodeReferencedEvent
+// getProjectItemNodeRelease
+// getProjectItemNodeReleaseAsset
+// getProjectItemNodeRemovedFromMergeQueueEvent
+// getProjectItemNodeRemovedFromProjectEvent
+// getProjectItemNodeRenamedTitleEvent
+// getProjectItemNodeReopenedEvent
+// getProjectItemNodeRepoAccessAuditEntry
+// getProjectItemNodeRepoAddMemberAuditEntry
+// getProjectItemNodeRepoAddTopicAuditEntry
+// getProjectItemNodeRepoArchivedAuditEntry
+// getProjectItemNodeRepoChangeMergeSettingAuditEntry
+// getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+// getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+// getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry
+// getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+// getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+// getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+// getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry
+// getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+// getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry
+// getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+// getProjectItemNodeRepoCreateAuditEntry
+// getProjectItemNodeRepoDestroyAuditEntry
+// getProjectItemNodeRepoRemoveMemberAuditEntry
+// getProjectItemNodeRepoRemoveTopicAuditEntry
+// getProjectItemNodeRepository
+// getProjectItemNodeRepositoryInvitation
+// getProjectItemNodeRepositoryMigration
+// getProjectItemNodeRepositoryRule
+// getProjectItemNodeRepositoryRuleset
+// getProjectItemNodeRepositoryRulesetBypassActor
+// getProjectItemNodeRepositoryTopic
+// getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry
+// getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry
+// getProjectItemNodeRepositoryVulnerabilityAlert
+// getProjectItemNodeReviewDismissalAllowance
+// getProjectItemNodeReviewDismissedEvent
+// getProjectItemNodeReviewRequest
+// getProjectItemNodeReviewRequestRemovedEvent
+// getProjectItemNodeReviewRequestedEvent
+// getProjectItemNodeSavedReply
+// getProjectItemNodeSecurityAdvisory
+// getProjectItemNodeSponsorsActivity
+// getProjectItemNodeSponsorsListing
+// getProjectItemNodeSponsorsListingFeaturedItem
+// getProjectItemNodeSponsorsTier
+// getProjectItemNodeSponsorship
+// getProjectItemNodeSponsorshipNewsletter
+// getProjectItemNodeStatus
+// getProjectItemNodeStatusCheckRollup
+// getProjectItemNodeStatusContext
+// getProjectItemNodeSubscribedEvent
+// getProjectItemNodeTag
+// getProjectItemNodeTeam
+// getProjectItemNodeTeamAddMemberAuditEntry
+// getProjectItemNodeTeamAddRepositoryAuditEntry
+// getProjectItemNodeTeamChangeParentTeamAuditEntry
+// getProjectItemNodeTeamDiscussion
+// getProjectItemNodeTeamDiscussionComment
+// getProjectItemNodeTeamRemoveMemberAuditEntry
+// getProjectItemNodeTeamRemoveRepositoryAuditEntry
+// getProjectItemNodeTopic
+// getProjectItemNodeTransferredEvent
+// getProjectItemNodeTree
+// getProjectItemNodeUnassignedEvent
+// getProjectItemNodeUnlabeledEvent
+// getProjectItemNodeUnlockedEvent
+// getProjectItemNodeUnmarkedAsDuplicateEvent
+// getProjectItemNodeUnpinnedEvent
+// getProjectItemNodeUnsubscribedEvent
+// getProjectItemNodeUser
+// getProjectItemNodeUserBlockedEvent
+// getProjectItemNodeUserContentEdit
+// getProjectItemNodeUserList
+// getProjectItemNodeUserStatus
+// getProjectItemNodeVerifiableDomain
+// getProjectItemNodeWorkflow
+// getProjectItemNodeWorkflowRun
+// getProjectItemNodeWorkflowRunFile
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+type getProjectItemNode interface {
+	implementsGraphQLInterfacegetProjectItemNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getProjectItemNodeAddedToMergeQueueEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeAddedToProjectEvent) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeApp) implementsGraphQLInterfacegetProjectItemNode()                    {}
+func (v *getProjectItemNodeAssignedEvent) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeAutoMergeDisabledEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeAutoMergeEnabledEvent) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeAutoRebaseEnabledEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeAutoSquashEnabledEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeAutomaticBaseChangeFailedEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeAutomaticBaseChangeSucceededEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeBaseRefChangedEvent) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeBaseRefDeletedEvent) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeBaseRefForcePushedEvent) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeBlob) implementsGraphQLInterfacegetProjectItemNode()                     {}
+func (v *getProjectItemNodeBot) implementsGraphQLInterfacegetProjectItemNode()                      {}
+func (v *getProjectItemNodeBranchProtectionRule) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeBypassForcePushAllowance) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeBypassPullRequestAllowance) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeCWE) implementsGraphQLInterfacegetProjectItemNode()                 {}
+func (v *getProjectItemNodeCheckRun) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeCheckSuite) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeClosedEvent) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodeCodeOfConduct) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeCommentDeletedEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeCommit) implementsGraphQLInterfacegetProjectItemNode()              {}
+func (v *getProjectItemNodeCommitComment) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeCommitCommentThread) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeComparison) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeConnectedEvent) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeConvertToDraftEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeConvertedNoteToIssueEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeConvertedToDiscussionEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeCrossReferencedEvent) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeDemilestonedEvent) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeDependencyGraphManifest) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeDeployKey) implementsGraphQLInterfacegetProjectItemNode()               {}
+func (v *getProjectItemNodeDeployedEvent) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeDeployment) implementsGraphQLInterfacegetProjectItemNode()              {}
+func (v *getProjectItemNodeDeploymentEnvironmentChangedEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeDeploymentReview) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeDeploymentStatus) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeDisconnectedEvent) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeDiscussion) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeDiscussionCategory) implementsGraphQLInterfacegetProjectItemNode()   {}
+func (v *getProjectItemNodeDiscussionComment) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeDiscussionPoll) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeDiscussionPollOption) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeDraftIssue) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeEnterprise) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeEnterpriseAdministratorInvitation) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseIdentityProvider) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseRepositoryInfo) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeEnterpriseServerInstallation) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseServerUserAccount) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseServerUserAccountEmail) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseServerUserAccountsUpload) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeEnterpriseUserAccount) implementsGraphQLInterfacegetProjectItemNode()   {}
+func (v *getProjectItemNodeEnvironment) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodeExternalIdentity) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodeGist) implementsGraphQLInterfacegetProjectItemNode()                    {}
+func (v *getProjectItemNodeGistComment) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodeHeadRefDeletedEvent) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeHeadRefForcePushedEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeHeadRefRestoredEvent) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeIpAllowListEntry) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodeIssue) implementsGraphQLInterfacegetProjectItemNode()                   {}
+func (v *getProjectItemNodeIssueComment) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeLabel) implementsGraphQLInterfacegetProjectItemNode()                   {}
+func (v *getProjectItemNodeLabeledEvent) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeLanguage) implementsGraphQLInterfacegetProjectItemNode()                {}
+func (v *getProjectItemNodeLicense) implementsGraphQLInterfacegetProjectItemNode()                 {}
+func (v *getProjectItemNodeLinkedBranch) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeLockedEvent) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodeMannequin) implementsGraphQLInterfacegetProjectItemNode()               {}
+func (v *getProjectItemNodeMarkedAsDuplicateEvent) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeMarketplaceCategory) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeMarketplaceListing) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeMemberFeatureRequestNotification) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeMembersCanDeleteReposClearAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeMembersCanDeleteReposDisableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeMembersCanDeleteReposEnableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeMentionedEvent) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeMergeQueue) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeMergeQueueEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeMergedEvent) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeMigrationSource) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeMilestone) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeMilestonedEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeMovedColumnsInProjectEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOIDCProvider) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOauthApplicationCreateAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgAddBillingManagerAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgBlockUserAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgCreateAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgDisableSamlAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgEnableSamlAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgInviteMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgInviteToBusinessAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgRemoveBillingManagerAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgRestoreMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgUnblockUserAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgUpdateMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrganization) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrganizationIdentityProvider) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeOrganizationInvitation) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeOrganizationMigration) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodePackage) implementsGraphQLInterfacegetProjectItemNode()                {}
+func (v *getProjectItemNodePackageFile) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodePackageTag) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodePackageVersion) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodePinnedDiscussion) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodePinnedEnvironment) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodePinnedEvent) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodePinnedIssue) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodePrivateRepositoryForkingDisableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodePrivateRepositoryForkingEnableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProject) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodeProjectCard) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeProjectColumn) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeProjectV2) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeProjectV2Field) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeProjectV2Item) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeProjectV2ItemFieldDateValue) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2ItemFieldIterationValue) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2ItemFieldNumberValue) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2ItemFieldTextValue) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2IterationField) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeProjectV2SingleSelectField) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeProjectV2View) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeProjectV2Workflow) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodePublicKey) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodePullRequest) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodePullRequestCommit) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodePullRequestCommitCommentThread) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodePullRequestReview) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodePullRequestReviewComment) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodePullRequestReviewThread) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodePullRequestThread) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodePush) implementsGraphQLInterfacegetProjectItemNode()                     {}
+func (v *getProjectItemNodePushAllowance) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeReaction) implementsGraphQLInterfacegetProjectItemNode()                 {}
+func (v *getProjectItemNodeReadyForReviewEvent) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeRef) implementsGraphQLInterfacegetProjectItemNode()                      {}
+func (v *getProjectItemNodeReferencedEvent) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeRelease) implementsGraphQLInterfacegetProjectItemNode()                  {}
+func (v *getProjectItemNodeReleaseAsset) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodeRemovedFromMergeQueueEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRemovedFromProjectEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeRenamedTitleEvent) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeReopenedEvent) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeRepoAccessAuditEntry) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeRepoAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeRepoAddTopicAuditEntry) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeRepoArchivedAuditEntry) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeRepoChangeMergeSettingAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoCreateAuditEntry) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeRepoDestroyAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeRepoRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepoRemoveTopicAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepository) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeRepositoryInvitation) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeRepositoryMigration) implementsGraphQLInterfacegetProjectItemNode()  {}
+func (v *getProjectItemNodeRepositoryRule) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeRepositoryRuleset) implementsGraphQLInterfacegetProjectItemNode()    {}
+func (v *getProjectItemNodeRepositoryRulesetBypassActor) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepositoryTopic) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeRepositoryVulnerabilityAlert) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeReviewDismissalAllowance) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeReviewDismissedEvent) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeReviewRequest) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeReviewRequestRemovedEvent) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeReviewRequestedEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeSavedReply) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeSecurityAdvisory) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeSponsorsActivity) implementsGraphQLInterfacegetProjectItemNode()     {}
+func (v *getProjectItemNodeSponsorsListing) implementsGraphQLInterfacegetProjectItemNode()      {}
+func (v *getProjectItemNodeSponsorsListingFeaturedItem) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeSponsorsTier) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeSponsorship) implementsGraphQLInterfacegetProjectItemNode()             {}
+func (v *getProjectItemNodeSponsorshipNewsletter) implementsGraphQLInterfacegetProjectItemNode()   {}
+func (v *getProjectItemNodeStatus) implementsGraphQLInterfacegetProjectItemNode()                  {}
+func (v *getProjectItemNodeStatusCheckRollup) implementsGraphQLInterfacegetProjectItemNode()       {}
+func (v *getProjectItemNodeStatusContext) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeSubscribedEvent) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodeTag) implementsGraphQLInterfacegetProjectItemNode()                     {}
+func (v *getProjectItemNodeTeam) implementsGraphQLInterfacegetProjectItemNode()                    {}
+func (v *getProjectItemNodeTeamAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeTeamAddRepositoryAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeTeamChangeParentTeamAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeTeamDiscussion) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodeTeamDiscussionComment) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeTeamRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeTeamRemoveRepositoryAuditEntry) implementsGraphQLInterfacegetProjectItemNode() {
+}
+func (v *getProjectItemNodeTopic) implementsGraphQLInterfacegetProjectItemNode()                    {}
+func (v *getProjectItemNodeTransferredEvent) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodeTree) implementsGraphQLInterfacegetProjectItemNode()                     {}
+func (v *getProjectItemNodeUnassignedEvent) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeUnlabeledEvent) implementsGraphQLInterfacegetProjectItemNode()           {}
+func (v *getProjectItemNodeUnlockedEvent) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeUnmarkedAsDuplicateEvent) implementsGraphQLInterfacegetProjectItemNode() {}
+func (v *getProjectItemNodeUnpinnedEvent) implementsGraphQLInterfacegetProjectItemNode()            {}
+func (v *getProjectItemNodeUnsubscribedEvent) implementsGraphQLInterfacegetProjectItemNode()        {}
+func (v *getProjectItemNodeUser) implementsGraphQLInterfacegetProjectItemNode()                     {}
+func (v *getProjectItemNodeUserBlockedEvent) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodeUserContentEdit) implementsGraphQLInterfacegetProjectItemNode()          {}
+func (v *getProjectItemNodeUserList) implementsGraphQLInterfacegetProjectItemNode()                 {}
+func (v *getProjectItemNodeUserStatus) implementsGraphQLInterfacegetProjectItemNode()               {}
+func (v *getProjectItemNodeVerifiableDomain) implementsGraphQLInterfacegetProjectItemNode()         {}
+func (v *getProjectItemNodeWorkflow) implementsGraphQLInterfacegetProjectItemNode()                 {}
+func (v *getProjectItemNodeWorkflowRun) implementsGraphQLInterfacegetProjectItemNode()              {}
+func (v *getProjectItemNodeWorkflowRunFile) implementsGraphQLInterfacegetProjectItemNode()          {}
+
+func __unmarshalgetProjectItemNode(b []byte, v *getProjectItemNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AddedToMergeQueueEvent":
+		*v = new(getProjectItemNodeAddedToMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "AddedToProjectEvent":
+		*v = new(getProjectItemNodeAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(getProjectItemNodeApp)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(getProjectItemNodeAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeDisabledEvent":
+		*v = new(getProjectItemNodeAutoMergeDisabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeEnabledEvent":
+		*v = new(getProjectItemNodeAutoMergeEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoRebaseEnabledEvent":
+		*v = new(getProjectItemNodeAutoRebaseEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoSquashEnabledEvent":
+		*v = new(getProjectItemNodeAutoSquashEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeFailedEvent":
+		*v = new(getProjectItemNodeAutomaticBaseChangeFailedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeSucceededEvent":
+		*v = new(getProjectItemNodeAutomaticBaseChangeSucceededEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefChangedEvent":
+		*v = new(getProjectItemNodeBaseRefChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefDeletedEvent":
+		*v = new(getProjectItemNodeBaseRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefForcePushedEvent":
+		*v = new(getProjectItemNodeBaseRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "Blob":
+		*v = new(getProjectItemNodeBlob)
+		return json.Unmarshal(b, *v)
+	case "Bot":
+		*v = new(getProjectItemNodeBot)
+		return json.Unmarshal(b, *v)
+	case "BranchProtectionRule":
+		*v = new(getProjectItemNodeBranchProtectionRule)
+		return json.Unmarshal(b, *v)
+	case "BypassForcePushAllowance":
+		*v = new(getProjectItemNodeBypassForcePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "BypassPullRequestAllowance":
+		*v = new(getProjectItemNodeBypassPullRequestAllowance)
+		return json.Unmarshal(b, *v)
+	case "CWE":
+		*v = new(getProjectItemNodeCWE)
+		return json.Unmarshal(b, *v)
+	case "CheckRun":
+		*v = new(getProjectItemNodeCheckRun)
+		return json.Unmarshal(b, *v)
+	case "CheckSuite":
+		*v = new(getProjectItemNodeCheckSuite)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(getProjectItemNodeClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CodeOfConduct":
+		*v = new(getProjectItemNodeCodeOfConduct)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(getProjectItemNodeCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getProjectItemNodeCommit)
+		return json.Unmarshal(b, *v)
+	case "CommitComment":
+		*v = new(getProjectItemNodeCommitComment)
+		return json.Unmarshal(b, *v)
+	case "CommitCommentThread":
+		*v = new(getProjectItemNodeCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "Comparison":
+		*v = new(getProjectItemNodeComparison)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(getProjectItemNodeConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertToDraftEvent":
+		*v = new(getProjectItemNodeConvertToDraftEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(getProjectItemNodeConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(getProjectItemNodeConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(getProjectItemNodeCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(getProjectItemNodeDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DependencyGraphManifest":
+		*v = new(getProjectItemNodeDependencyGraphManifest)
+		return json.Unmarshal(b, *v)
+	case "DeployKey":
+		*v = new(getProjectItemNodeDeployKey)
+		return json.Unmarshal(b, *v)
+	case "DeployedEvent":
+		*v = new(getProjectItemNodeDeployedEvent)
+		return json.Unmarshal(b, *v)
+	case "Deployment":
+		*v = new(getProjectItemNodeDeployment)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEnvironmentChangedEvent":
+		*v = new(getProjectItemNodeDeploymentEnvironmentChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentReview":
+		*v = new(getProjectItemNodeDeploymentReview)
+		return json.Unmarshal(b, *v)
+	case "DeploymentStatus":
+		*v = new(getProjectItemNodeDeploymentStatus)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(getProjectItemNodeDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "Discussion":
+		*v = new(getProjectItemNodeDiscussion)
+		return json.Unmarshal(b, *v)
+	case "DiscussionCategory":
+		*v = new(getProjectItemNodeDiscussionCategory)
+		return json.Unmarshal(b, *v)
+	case "DiscussionComment":
+		*v = new(getProjectItemNodeDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPoll":
+		*v = new(getProjectItemNodeDiscussionPoll)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPollOption":
+		*v = new(getProjectItemNodeDiscussionPollOption)
+		return json.Unmarshal(b, *v)
+	case "DraftIssue":
+		*v = new(getProjectItemNodeDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Enterprise":
+		*v = new(getProjectItemNodeEnterprise)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseAdministratorInvitation":
+		*v = new(getProjectItemNodeEnterpriseAdministratorInvitation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseIdentityProvider":
+		*v = new(getProjectItemNodeEnterpriseIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseRepositoryInfo":
+		*v = new(getProjectItemNodeEnterpriseRepositoryInfo)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerInstallation":
+		*v = new(getProjectItemNodeEnterpriseServerInstallation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccount":
+		*v = new(getProjectItemNodeEnterpriseServerUserAccount)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountEmail":
+		*v = new(getProjectItemNodeEnterpriseServerUserAccountEmail)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountsUpload":
+		*v = new(getProjectItemNodeEnterpriseServerUserAccountsUpload)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(getProjectItemNodeEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Environment":
+		*v = new(getProjectItemNodeEnvironment)
+		return json.Unmarshal(b, *v)
+	case "ExternalIdentity":
+		*v = new(getProjectItemNodeExternalIdentity)
+		return json.Unmarshal(b, *v)
+	case "Gist":
+		*v = new(getProjectItemNodeGist)
+		return json.Unmarshal(b, *v)
+	case "GistComment":
+		*v = new(getProjectItemNodeGistComment)
+		return json.Unmarshal(b, *v)
+	case "HeadRefDeletedEvent":
+		*v = new(getProjectItemNodeHeadRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefForcePushedEvent":
+		*v = new(getProjectItemNodeHeadRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefRestoredEvent":
+		*v = new(getProjectItemNodeHeadRefRestoredEvent)
+		return json.Unmarshal(b, *v)
+	case "IpAllowListEntry":
+		*v = new(getProjectItemNodeIpAllowListEntry)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(getProjectItemNodeIssue)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(getProjectItemNodeIssueComment)
+		return json.Unmarshal(b, *v)
+	case "Label":
+		*v = new(getProjectItemNodeLabel)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(getProjectItemNodeLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "Language":
+		*v = new(getProjectItemNodeLanguage)
+		return json.Unmarshal(b, *v)
+	case "License":
+		*v = new(getProjectItemNodeLicense)
+		return json.Unmarshal(b, *v)
+	case "LinkedBranch":
+		*v = new(getProjectItemNodeLinkedBranch)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(getProjectItemNodeLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(getProjectItemNodeMannequin)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(getProjectItemNodeMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceCategory":
+		*v = new(getProjectItemNodeMarketplaceCategory)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceListing":
+		*v = new(getProjectItemNodeMarketplaceListing)
+		return json.Unmarshal(b, *v)
+	case "MemberFeatureRequestNotification":
+		*v = new(getProjectItemNodeMemberFeatureRequestNotification)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposClearAuditEntry":
+		*v = new(getProjectItemNodeMembersCanDeleteReposClearAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposDisableAuditEntry":
+		*v = new(getProjectItemNodeMembersCanDeleteReposDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposEnableAuditEntry":
+		*v = new(getProjectItemNodeMembersCanDeleteReposEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(getProjectItemNodeMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MergeQueue":
+		*v = new(getProjectItemNodeMergeQueue)
+		return json.Unmarshal(b, *v)
+	case "MergeQueueEntry":
+		*v = new(getProjectItemNodeMergeQueueEntry)
+		return json.Unmarshal(b, *v)
+	case "MergedEvent":
+		*v = new(getProjectItemNodeMergedEvent)
+		return json.Unmarshal(b, *v)
+	case "MigrationSource":
+		*v = new(getProjectItemNodeMigrationSource)
+		return json.Unmarshal(b, *v)
+	case "Milestone":
+		*v = new(getProjectItemNodeMilestone)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(getProjectItemNodeMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(getProjectItemNodeMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "OIDCProvider":
+		*v = new(getProjectItemNodeOIDCProvider)
+		return json.Unmarshal(b, *v)
+	case "OauthApplicationCreateAuditEntry":
+		*v = new(getProjectItemNodeOauthApplicationCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddBillingManagerAuditEntry":
+		*v = new(getProjectItemNodeOrgAddBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddMemberAuditEntry":
+		*v = new(getProjectItemNodeOrgAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgBlockUserAuditEntry":
+		*v = new(getProjectItemNodeOrgBlockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgCreateAuditEntry":
+		*v = new(getProjectItemNodeOrgCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableOauthAppRestrictionsAuditEntry":
+		*v = new(getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableSamlAuditEntry":
+		*v = new(getProjectItemNodeOrgDisableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableTwoFactorRequirementAuditEntry":
+		*v = new(getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableOauthAppRestrictionsAuditEntry":
+		*v = new(getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableSamlAuditEntry":
+		*v = new(getProjectItemNodeOrgEnableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableTwoFactorRequirementAuditEntry":
+		*v = new(getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteMemberAuditEntry":
+		*v = new(getProjectItemNodeOrgInviteMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteToBusinessAuditEntry":
+		*v = new(getProjectItemNodeOrgInviteToBusinessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessApprovedAuditEntry":
+		*v = new(getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessBlockedAuditEntry":
+		*v = new(getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessDeniedAuditEntry":
+		*v = new(getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessRequestedAuditEntry":
+		*v = new(getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessUnblockedAuditEntry":
+		*v = new(getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveBillingManagerAuditEntry":
+		*v = new(getProjectItemNodeOrgRemoveBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveMemberAuditEntry":
+		*v = new(getProjectItemNodeOrgRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveOutsideCollaboratorAuditEntry":
+		*v = new(getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRestoreMemberAuditEntry":
+		*v = new(getProjectItemNodeOrgRestoreMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUnblockUserAuditEntry":
+		*v = new(getProjectItemNodeOrgUnblockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateDefaultRepositoryPermissionAuditEntry":
+		*v = new(getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberAuditEntry":
+		*v = new(getProjectItemNodeOrgUpdateMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryCreationPermissionAuditEntry":
+		*v = new(getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry":
+		*v = new(getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(getProjectItemNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationIdentityProvider":
+		*v = new(getProjectItemNodeOrganizationIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(getProjectItemNodeOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "OrganizationMigration":
+		*v = new(getProjectItemNodeOrganizationMigration)
+		return json.Unmarshal(b, *v)
+	case "Package":
+		*v = new(getProjectItemNodePackage)
+		return json.Unmarshal(b, *v)
+	case "PackageFile":
+		*v = new(getProjectItemNodePackageFile)
+		return json.Unmarshal(b, *v)
+	case "PackageTag":
+		*v = new(getProjectItemNodePackageTag)
+		return json.Unmarshal(b, *v)
+	case "PackageVersion":
+		*v = new(getProjectItemNodePackageVersion)
+		return json.Unmarshal(b, *v)
+	case "PinnedDiscussion":
+		*v = new(getProjectItemNodePinnedDiscussion)
+		return json.Unmarshal(b, *v)
+	case "PinnedEnvironment":
+		*v = new(getProjectItemNodePinnedEnvironment)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(getProjectItemNodePinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedIssue":
+		*v = new(getProjectItemNodePinnedIssue)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingDisableAuditEntry":
+		*v = new(getProjectItemNodePrivateRepositoryForkingDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingEnableAuditEntry":
+		*v = new(getProjectItemNodePrivateRepositoryForkingEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Project":
+		*v = new(getProjectItemNodeProject)
+		return json.Unmarshal(b, *v)
+	case "ProjectCard":
+		*v = new(getProjectItemNodeProjectCard)
+		return json.Unmarshal(b, *v)
+	case "ProjectColumn":
+		*v = new(getProjectItemNodeProjectColumn)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2":
+		*v = new(getProjectItemNodeProjectV2)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Field":
+		*v = new(getProjectItemNodeProjectV2Field)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Item":
+		*v = new(getProjectItemNodeProjectV2Item)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldDateValue":
+		*v = new(getProjectItemNodeProjectV2ItemFieldDateValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldIterationValue":
+		*v = new(getProjectItemNodeProjectV2ItemFieldIterationValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldNumberValue":
+		*v = new(getProjectItemNodeProjectV2ItemFieldNumberValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		*v = new(getProjectItemNodeProjectV2ItemFieldSingleSelectValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldTextValue":
+		*v = new(getProjectItemNodeProjectV2ItemFieldTextValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2IterationField":
+		*v = new(getProjectItemNodeProjectV2IterationField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2SingleSelectField":
+		*v = new(getProjectItemNodeProjectV2SingleSelectField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2View":
+		*v = new(getProjectItemNodeProjectV2View)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Workflow":
+		*v = new(getProjectItemNodeProjectV2Workflow)
+		return json.Unmarshal(b, *v)
+	case "PublicKey":
+		*v = new(getProjectItemNodePublicKey)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(getProjectItemNodePullRequest)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommit":
+		*v = new(getProjectItemNodePullRequestCommit)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommitCommentThread":
+		*v = new(getProjectItemNodePullRequestCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReview":
+		*v = new(getProjectItemNodePullRequestReview)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewComment":
+		*v = new(getProjectItemNodePullRequestReviewComment)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewThread":
+		*v = new(getProjectItemNodePullRequestReviewThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestThread":
+		*v = new(getProjectItemNodePullRequestThread)
+		return json.Unmarshal(b, *v)
+	case "Push":
+		*v = new(getProjectItemNodePush)
+		return json.Unmarshal(b, *v)
+	case "PushAllowance":
+		*v = new(getProjectItemNodePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "Reaction":
+		*v = new(getProjectItemNodeReaction)
+		return json.Unmarshal(b, *v)
+	case "ReadyForReviewEvent":
+		*v = new(getProjectItemNodeReadyForReviewEvent)
+		return json.Unmarshal(b, *v)
+	case "Ref":
+		*v = new(getProjectItemNodeRef)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(getProjectItemNodeReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(getProjectItemNodeRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseAsset":
+		*v = new(getProjectItemNodeReleaseAsset)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromMergeQueueEvent":
+		*v = new(getProjectItemNodeRemovedFromMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(getProjectItemNodeRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(getProjectItemNodeRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(getProjectItemNodeReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "RepoAccessAuditEntry":
+		*v = new(getProjectItemNodeRepoAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddMemberAuditEntry":
+		*v = new(getProjectItemNodeRepoAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddTopicAuditEntry":
+		*v = new(getProjectItemNodeRepoAddTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoArchivedAuditEntry":
+		*v = new(getProjectItemNodeRepoArchivedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoChangeMergeSettingAuditEntry":
+		*v = new(getProjectItemNodeRepoChangeMergeSettingAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableContributorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableSockpuppetDisallowedAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableContributorsOnlyAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableSockpuppetDisallowedAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigLockAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigUnlockAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoCreateAuditEntry":
+		*v = new(getProjectItemNodeRepoCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoDestroyAuditEntry":
+		*v = new(getProjectItemNodeRepoDestroyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveMemberAuditEntry":
+		*v = new(getProjectItemNodeRepoRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveTopicAuditEntry":
+		*v = new(getProjectItemNodeRepoRemoveTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Repository":
+		*v = new(getProjectItemNodeRepository)
+		return json.Unmarshal(b, *v)
+	case "RepositoryInvitation":
+		*v = new(getProjectItemNodeRepositoryInvitation)
+		return json.Unmarshal(b, *v)
+	case "RepositoryMigration":
+		*v = new(getProjectItemNodeRepositoryMigration)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRule":
+		*v = new(getProjectItemNodeRepositoryRule)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRuleset":
+		*v = new(getProjectItemNodeRepositoryRuleset)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRulesetBypassActor":
+		*v = new(getProjectItemNodeRepositoryRulesetBypassActor)
+		return json.Unmarshal(b, *v)
+	case "RepositoryTopic":
+		*v = new(getProjectItemNodeRepositoryTopic)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeDisableAuditEntry":
+		*v = new(getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeEnableAuditEntry":
+		*v = new(getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVulnerabilityAlert":
+		*v = new(getProjectItemNodeRepositoryVulnerabilityAlert)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissalAllowance":
+		*v = new(getProjectItemNodeReviewDismissalAllowance)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissedEvent":
+		*v = new(getProjectItemNodeReviewDismissedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequest":
+		*v = new(getProjectItemNodeReviewRequest)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestRemovedEvent":
+		*v = new(getProjectItemNodeReviewRequestRemovedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestedEvent":
+		*v = new(getProjectItemNodeReviewRequestedEvent)
+		return json.Unmarshal(b, *v)
+	case "SavedReply":
+		*v = new(getProjectItemNodeSavedReply)
+		return json.Unmarshal(b, *v)
+	case "SecurityAdvisory":
+		*v = new(getProjectItemNodeSecurityAdvisory)
+		return json.Unmarshal(b, *v)
+	case "SponsorsActivity":
+		*v = new(getProjectItemNodeSponsorsActivity)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListing":
+		*v = new(getProjectItemNodeSponsorsListing)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListingFeaturedItem":
+		*v = new(getProjectItemNodeSponsorsListingFeaturedItem)
+		return json.Unmarshal(b, *v)
+	case "SponsorsTier":
+		*v = new(getProjectItemNodeSponsorsTier)
+		return json.Unmarshal(b, *v)
+	case "Sponsorship":
+		*v = new(getProjectItemNodeSponsorship)
+		return json.Unmarshal(b, *v)
+	case "SponsorshipNewsletter":
+		*v = new(getProjectItemNodeSponsorshipNewsletter)
+		return json.Unmarshal(b, *v)
+	case "Status":
+		*v = new(getProjectItemNodeStatus)
+		return json.Unmarshal(b, *v)
+	case "StatusCheckRollup":
+		*v = new(getProjectItemNodeStatusCheckRollup)
+		return json.Unmarshal(b, *v)
+	case "StatusContext":
+		*v = new(getProjectItemNodeStatusContext)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(getProjectItemNodeSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getProjectItemNodeTag)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(getProjectItemNodeTeam)
+		return json.Unmarshal(b, *v)
+	case "TeamAddMemberAuditEntry":
+		*v = new(getProjectItemNodeTeamAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamAddRepositoryAuditEntry":
+		*v = new(getProjectItemNodeTeamAddRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamChangeParentTeamAuditEntry":
+		*v = new(getProjectItemNodeTeamChangeParentTeamAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussion":
+		*v = new(getProjectItemNodeTeamDiscussion)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussionComment":
+		*v = new(getProjectItemNodeTeamDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveMemberAuditEntry":
+		*v = new(getProjectItemNodeTeamRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveRepositoryAuditEntry":
+		*v = new(getProjectItemNodeTeamRemoveRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(getProjectItemNodeTopic)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(getProjectItemNodeTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getProjectItemNodeTree)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(getProjectItemNodeUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(getProjectItemNodeUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(getProjectItemNodeUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(getProjectItemNodeUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(getProjectItemNodeUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(getProjectItemNodeUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getProjectItemNodeUser)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(getProjectItemNodeUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserContentEdit":
+		*v = new(getProjectItemNodeUserContentEdit)
+		return json.Unmarshal(b, *v)
+	case "UserList":
+		*v = new(getProjectItemNodeUserList)
+		return json.Unmarshal(b, *v)
+	case "UserStatus":
+		*v = new(getProjectItemNodeUserStatus)
+		return json.Unmarshal(b, *v)
+	case "VerifiableDomain":
+		*v = new(getProjectItemNodeVerifiableDomain)
+		return json.Unmarshal(b, *v)
+	case "Workflow":
+		*v = new(getProjectItemNodeWorkflow)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRun":
+		*v = new(getProjectItemNodeWorkflowRun)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRunFile":
+		*v = new(getProjectItemNodeWorkflowRunFile)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getProjectItemNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetProjectItemNode(v *getProjectItemNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getProjectItemNodeAddedToMergeQueueEvent:
+		typename = "AddedToMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAddedToMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeApp:
+		typename = "App"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAssignedEvent:
+		typename = "AssignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutoMergeDisabledEvent:
+		typename = "AutoMergeDisabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutoMergeDisabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutoMergeEnabledEvent:
+		typename = "AutoMergeEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutoMergeEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutoRebaseEnabledEvent:
+		typename = "AutoRebaseEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutoRebaseEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutoSquashEnabledEvent:
+		typename = "AutoSquashEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutoSquashEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutomaticBaseChangeFailedEvent:
+		typename = "AutomaticBaseChangeFailedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutomaticBaseChangeFailedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeAutomaticBaseChangeSucceededEvent:
+		typename = "AutomaticBaseChangeSucceededEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeAutomaticBaseChangeSucceededEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBaseRefChangedEvent:
+		typename = "BaseRefChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBaseRefChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBaseRefDeletedEvent:
+		typename = "BaseRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBaseRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBaseRefForcePushedEvent:
+		typename = "BaseRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBaseRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBranchProtectionRule:
+		typename = "BranchProtectionRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBranchProtectionRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBypassForcePushAllowance:
+		typename = "BypassForcePushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBypassForcePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeBypassPullRequestAllowance:
+		typename = "BypassPullRequestAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeBypassPullRequestAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCWE:
+		typename = "CWE"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCWE
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCheckRun:
+		typename = "CheckRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCheckRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCheckSuite:
+		typename = "CheckSuite"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCheckSuite
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeClosedEvent:
+		typename = "ClosedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCodeOfConduct:
+		typename = "CodeOfConduct"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCodeOfConduct
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCommitComment:
+		typename = "CommitComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCommitComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCommitCommentThread:
+		typename = "CommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeComparison:
+		typename = "Comparison"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeComparison
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeConnectedEvent:
+		typename = "ConnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeConvertToDraftEvent:
+		typename = "ConvertToDraftEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeConvertToDraftEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDemilestonedEvent:
+		typename = "DemilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDependencyGraphManifest:
+		typename = "DependencyGraphManifest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDependencyGraphManifest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeployKey:
+		typename = "DeployKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeployKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeployedEvent:
+		typename = "DeployedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeployedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeployment:
+		typename = "Deployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeploymentEnvironmentChangedEvent:
+		typename = "DeploymentEnvironmentChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeploymentEnvironmentChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeploymentReview:
+		typename = "DeploymentReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeploymentReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDeploymentStatus:
+		typename = "DeploymentStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDeploymentStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDisconnectedEvent:
+		typename = "DisconnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDiscussion:
+		typename = "Discussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDiscussionCategory:
+		typename = "DiscussionCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDiscussionCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDiscussionComment:
+		typename = "DiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDiscussionPoll:
+		typename = "DiscussionPoll"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDiscussionPoll
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDiscussionPollOption:
+		typename = "DiscussionPollOption"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDiscussionPollOption
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterprise:
+		typename = "Enterprise"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterprise
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseAdministratorInvitation:
+		typename = "EnterpriseAdministratorInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseAdministratorInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseIdentityProvider:
+		typename = "EnterpriseIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseRepositoryInfo:
+		typename = "EnterpriseRepositoryInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseRepositoryInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseServerInstallation:
+		typename = "EnterpriseServerInstallation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseServerInstallation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseServerUserAccount:
+		typename = "EnterpriseServerUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseServerUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseServerUserAccountEmail:
+		typename = "EnterpriseServerUserAccountEmail"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseServerUserAccountEmail
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseServerUserAccountsUpload:
+		typename = "EnterpriseServerUserAccountsUpload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseServerUserAccountsUpload
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeEnvironment:
+		typename = "Environment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeExternalIdentity:
+		typename = "ExternalIdentity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeExternalIdentity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeGist:
+		typename = "Gist"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeGist
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeGistComment:
+		typename = "GistComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeGistComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeHeadRefDeletedEvent:
+		typename = "HeadRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeHeadRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeHeadRefForcePushedEvent:
+		typename = "HeadRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeHeadRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeHeadRefRestoredEvent:
+		typename = "HeadRefRestoredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeHeadRefRestoredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeIpAllowListEntry:
+		typename = "IpAllowListEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeIpAllowListEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeIssueComment:
+		typename = "IssueComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLabel:
+		typename = "Label"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLabel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLabeledEvent:
+		typename = "LabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLanguage:
+		typename = "Language"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLanguage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLicense:
+		typename = "License"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLicense
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLinkedBranch:
+		typename = "LinkedBranch"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLinkedBranch
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeLockedEvent:
+		typename = "LockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMarketplaceCategory:
+		typename = "MarketplaceCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMarketplaceCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMarketplaceListing:
+		typename = "MarketplaceListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMarketplaceListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMemberFeatureRequestNotification:
+		typename = "MemberFeatureRequestNotification"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMemberFeatureRequestNotification
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMembersCanDeleteReposClearAuditEntry:
+		typename = "MembersCanDeleteReposClearAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMembersCanDeleteReposClearAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMembersCanDeleteReposDisableAuditEntry:
+		typename = "MembersCanDeleteReposDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMembersCanDeleteReposDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMembersCanDeleteReposEnableAuditEntry:
+		typename = "MembersCanDeleteReposEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMembersCanDeleteReposEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMentionedEvent:
+		typename = "MentionedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMergeQueue:
+		typename = "MergeQueue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMergeQueue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMergeQueueEntry:
+		typename = "MergeQueueEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMergeQueueEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMergedEvent:
+		typename = "MergedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMergedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMigrationSource:
+		typename = "MigrationSource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMigrationSource
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMilestone:
+		typename = "Milestone"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMilestone
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMilestonedEvent:
+		typename = "MilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOIDCProvider:
+		typename = "OIDCProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOIDCProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOauthApplicationCreateAuditEntry:
+		typename = "OauthApplicationCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOauthApplicationCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgAddBillingManagerAuditEntry:
+		typename = "OrgAddBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgAddBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgAddMemberAuditEntry:
+		typename = "OrgAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgBlockUserAuditEntry:
+		typename = "OrgBlockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgBlockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgCreateAuditEntry:
+		typename = "OrgCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry:
+		typename = "OrgDisableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgDisableSamlAuditEntry:
+		typename = "OrgDisableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgDisableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry:
+		typename = "OrgDisableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry:
+		typename = "OrgEnableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgEnableSamlAuditEntry:
+		typename = "OrgEnableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgEnableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry:
+		typename = "OrgEnableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgInviteMemberAuditEntry:
+		typename = "OrgInviteMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgInviteMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgInviteToBusinessAuditEntry:
+		typename = "OrgInviteToBusinessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgInviteToBusinessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry:
+		typename = "OrgOauthAppAccessApprovedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry:
+		typename = "OrgOauthAppAccessBlockedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry:
+		typename = "OrgOauthAppAccessDeniedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry:
+		typename = "OrgOauthAppAccessRequestedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry:
+		typename = "OrgOauthAppAccessUnblockedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgRemoveBillingManagerAuditEntry:
+		typename = "OrgRemoveBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgRemoveBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgRemoveMemberAuditEntry:
+		typename = "OrgRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry:
+		typename = "OrgRemoveOutsideCollaboratorAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgRestoreMemberAuditEntry:
+		typename = "OrgRestoreMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgRestoreMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgUnblockUserAuditEntry:
+		typename = "OrgUnblockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgUnblockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry:
+		typename = "OrgUpdateDefaultRepositoryPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgUpdateMemberAuditEntry:
+		typename = "OrgUpdateMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgUpdateMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryCreationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrganizationIdentityProvider:
+		typename = "OrganizationIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrganizationIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeOrganizationMigration:
+		typename = "OrganizationMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeOrganizationMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePackage:
+		typename = "Package"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePackage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePackageFile:
+		typename = "PackageFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePackageFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePackageTag:
+		typename = "PackageTag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePackageTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePackageVersion:
+		typename = "PackageVersion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePackageVersion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePinnedDiscussion:
+		typename = "PinnedDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePinnedDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePinnedEnvironment:
+		typename = "PinnedEnvironment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePinnedEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePinnedEvent:
+		typename = "PinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePinnedIssue:
+		typename = "PinnedIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePinnedIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePrivateRepositoryForkingDisableAuditEntry:
+		typename = "PrivateRepositoryForkingDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePrivateRepositoryForkingDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePrivateRepositoryForkingEnableAuditEntry:
+		typename = "PrivateRepositoryForkingEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePrivateRepositoryForkingEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProject:
+		typename = "Project"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProject
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectCard:
+		typename = "ProjectCard"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectCard
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectColumn:
+		typename = "ProjectColumn"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectColumn
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2:
+		typename = "ProjectV2"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2Field:
+		typename = "ProjectV2Field"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2Field
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2Item:
+		typename = "ProjectV2Item"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetProjectItemNodeProjectV2Item
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2ItemFieldDateValue:
+		typename = "ProjectV2ItemFieldDateValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2ItemFieldDateValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2ItemFieldIterationValue:
+		typename = "ProjectV2ItemFieldIterationValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2ItemFieldIterationValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2ItemFieldNumberValue:
+		typename = "ProjectV2ItemFieldNumberValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2ItemFieldNumberValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2ItemFieldSingleSelectValue:
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2ItemFieldSingleSelectValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2ItemFieldTextValue:
+		typename = "ProjectV2ItemFieldTextValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2ItemFieldTextValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2IterationField:
+		typename = "ProjectV2IterationField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2IterationField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2SingleSelectField:
+		typename = "ProjectV2SingleSelectField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2SingleSelectField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2View:
+		typename = "ProjectV2View"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2View
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeProjectV2Workflow:
+		typename = "ProjectV2Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeProjectV2Workflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePublicKey:
+		typename = "PublicKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePublicKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequest:
+		typename = "PullRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestCommit:
+		typename = "PullRequestCommit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestCommitCommentThread:
+		typename = "PullRequestCommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestReview:
+		typename = "PullRequestReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestReviewComment:
+		typename = "PullRequestReviewComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestReviewComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestReviewThread:
+		typename = "PullRequestReviewThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestReviewThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePullRequestThread:
+		typename = "PullRequestThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePullRequestThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePush:
+		typename = "Push"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePush
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodePushAllowance:
+		typename = "PushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReaction:
+		typename = "Reaction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReaction
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReadyForReviewEvent:
+		typename = "ReadyForReviewEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReadyForReviewEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRef:
+		typename = "Ref"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRef
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReferencedEvent:
+		typename = "ReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReleaseAsset:
+		typename = "ReleaseAsset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReleaseAsset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRemovedFromMergeQueueEvent:
+		typename = "RemovedFromMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRemovedFromMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReopenedEvent:
+		typename = "ReopenedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoAccessAuditEntry:
+		typename = "RepoAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoAddMemberAuditEntry:
+		typename = "RepoAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoAddTopicAuditEntry:
+		typename = "RepoAddTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoAddTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoArchivedAuditEntry:
+		typename = "RepoArchivedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoArchivedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoChangeMergeSettingAuditEntry:
+		typename = "RepoChangeMergeSettingAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoChangeMergeSettingAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigDisableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry:
+		typename = "RepoConfigDisableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigDisableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigEnableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry:
+		typename = "RepoConfigEnableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigEnableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigLockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigUnlockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoCreateAuditEntry:
+		typename = "RepoCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoDestroyAuditEntry:
+		typename = "RepoDestroyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoDestroyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoRemoveMemberAuditEntry:
+		typename = "RepoRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepoRemoveTopicAuditEntry:
+		typename = "RepoRemoveTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepoRemoveTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepository:
+		typename = "Repository"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepository
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryInvitation:
+		typename = "RepositoryInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryMigration:
+		typename = "RepositoryMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryRule:
+		typename = "RepositoryRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryRuleset:
+		typename = "RepositoryRuleset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryRuleset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryRulesetBypassActor:
+		typename = "RepositoryRulesetBypassActor"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryRulesetBypassActor
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryTopic:
+		typename = "RepositoryTopic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry:
+		typename = "RepositoryVisibilityChangeDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry:
+		typename = "RepositoryVisibilityChangeEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeRepositoryVulnerabilityAlert:
+		typename = "RepositoryVulnerabilityAlert"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeRepositoryVulnerabilityAlert
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReviewDismissalAllowance:
+		typename = "ReviewDismissalAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReviewDismissalAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReviewDismissedEvent:
+		typename = "ReviewDismissedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReviewDismissedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReviewRequest:
+		typename = "ReviewRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReviewRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReviewRequestRemovedEvent:
+		typename = "ReviewRequestRemovedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReviewRequestRemovedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeReviewRequestedEvent:
+		typename = "ReviewRequestedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeReviewRequestedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSavedReply:
+		typename = "SavedReply"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSavedReply
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSecurityAdvisory:
+		typename = "SecurityAdvisory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSecurityAdvisory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorsActivity:
+		typename = "SponsorsActivity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorsActivity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorsListing:
+		typename = "SponsorsListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorsListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorsListingFeaturedItem:
+		typename = "SponsorsListingFeaturedItem"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorsListingFeaturedItem
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorsTier:
+		typename = "SponsorsTier"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorsTier
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorship:
+		typename = "Sponsorship"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorship
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSponsorshipNewsletter:
+		typename = "SponsorshipNewsletter"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSponsorshipNewsletter
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeStatus:
+		typename = "Status"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeStatusCheckRollup:
+		typename = "StatusCheckRollup"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeStatusCheckRollup
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeStatusContext:
+		typename = "StatusContext"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeStatusContext
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeSubscribedEvent:
+		typename = "SubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamAddMemberAuditEntry:
+		typename = "TeamAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamAddRepositoryAuditEntry:
+		typename = "TeamAddRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamAddRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamChangeParentTeamAuditEntry:
+		typename = "TeamChangeParentTeamAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamChangeParentTeamAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamDiscussion:
+		typename = "TeamDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamDiscussionComment:
+		typename = "TeamDiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamRemoveMemberAuditEntry:
+		typename = "TeamRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTeamRemoveRepositoryAuditEntry:
+		typename = "TeamRemoveRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTeamRemoveRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTransferredEvent:
+		typename = "TransferredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeTree
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnassignedEvent:
+		typename = "UnassignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnlabeledEvent:
+		typename = "UnlabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnlockedEvent:
+		typename = "UnlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnpinnedEvent:
+		typename = "UnpinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnpinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUnsubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUserBlockedEvent:
+		typename = "UserBlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUserBlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUserContentEdit:
+		typename = "UserContentEdit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUserContentEdit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUserList:
+		typename = "UserList"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUserList
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeUserStatus:
+		typename = "UserStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeUserStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeVerifiableDomain:
+		typename = "VerifiableDomain"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeVerifiableDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeWorkflow:
+		typename = "Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeWorkflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeWorkflowRun:
+		typename = "WorkflowRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeWorkflowRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemNodeWorkflowRunFile:
+		typename = "WorkflowRunFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemNodeWorkflowRunFile
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getProjectItemNode: "%T"`, v)
+	}
+}
+
+// getProjectItemNodeAddedToMergeQueueEvent includes the requested fields of the GraphQL type AddedToMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'added_to_merge_queue' event on a given pull request.
+type getProjectItemNodeAddedToMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAddedToMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAddedToMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'added_to_project' event on a given issue or pull request.
+type getProjectItemNodeAddedToProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type getProjectItemNodeApp struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeApp.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeApp) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type getProjectItemNodeAssignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAssignedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutoMergeDisabledEvent includes the requested fields of the GraphQL type AutoMergeDisabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_disabled' event on a given pull request.
+type getProjectItemNodeAutoMergeDisabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutoMergeDisabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutoMergeDisabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutoMergeEnabledEvent includes the requested fields of the GraphQL type AutoMergeEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_enabled' event on a given pull request.
+type getProjectItemNodeAutoMergeEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutoMergeEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutoMergeEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutoRebaseEnabledEvent includes the requested fields of the GraphQL type AutoRebaseEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_rebase_enabled' event on a given pull request.
+type getProjectItemNodeAutoRebaseEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutoRebaseEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutoRebaseEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutoSquashEnabledEvent includes the requested fields of the GraphQL type AutoSquashEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_squash_enabled' event on a given pull request.
+type getProjectItemNodeAutoSquashEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutoSquashEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutoSquashEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutomaticBaseChangeFailedEvent includes the requested fields of the GraphQL type AutomaticBaseChangeFailedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_failed' event on a given pull request.
+type getProjectItemNodeAutomaticBaseChangeFailedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutomaticBaseChangeFailedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutomaticBaseChangeFailedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeAutomaticBaseChangeSucceededEvent includes the requested fields of the GraphQL type AutomaticBaseChangeSucceededEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_succeeded' event on a given pull request.
+type getProjectItemNodeAutomaticBaseChangeSucceededEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeAutomaticBaseChangeSucceededEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeAutomaticBaseChangeSucceededEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBaseRefChangedEvent includes the requested fields of the GraphQL type BaseRefChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_changed' event on a given issue or pull request.
+type getProjectItemNodeBaseRefChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBaseRefChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBaseRefChangedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBaseRefDeletedEvent includes the requested fields of the GraphQL type BaseRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_deleted' event on a given pull request.
+type getProjectItemNodeBaseRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBaseRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBaseRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBaseRefForcePushedEvent includes the requested fields of the GraphQL type BaseRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_force_pushed' event on a given pull request.
+type getProjectItemNodeBaseRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBaseRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBaseRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getProjectItemNodeBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBlob) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type getProjectItemNodeBot struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBot.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBot) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBranchProtectionRule includes the requested fields of the GraphQL type BranchProtectionRule.
+// The GraphQL type's documentation follows.
+//
+// A branch protection rule.
+type getProjectItemNodeBranchProtectionRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBranchProtectionRule.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBranchProtectionRule) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBypassForcePushAllowance includes the requested fields of the GraphQL type BypassForcePushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a force push requirement on a protected branch.
+type getProjectItemNodeBypassForcePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBypassForcePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBypassForcePushAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeBypassPullRequestAllowance includes the requested fields of the GraphQL type BypassPullRequestAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a pull request requirement on a protected branch.
+type getProjectItemNodeBypassPullRequestAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeBypassPullRequestAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeBypassPullRequestAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCWE includes the requested fields of the GraphQL type CWE.
+// The GraphQL type's documentation follows.
+//
+// A common weakness enumeration
+type getProjectItemNodeCWE struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCWE.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCWE) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCheckRun includes the requested fields of the GraphQL type CheckRun.
+// The GraphQL type's documentation follows.
+//
+// A check run.
+type getProjectItemNodeCheckRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCheckRun.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCheckRun) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type getProjectItemNodeCheckSuite struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCheckSuite.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCheckSuite) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'closed' event on any `Closable`.
+type getProjectItemNodeClosedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeClosedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCodeOfConduct includes the requested fields of the GraphQL type CodeOfConduct.
+// The GraphQL type's documentation follows.
+//
+// The Code of Conduct for a repository
+type getProjectItemNodeCodeOfConduct struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCodeOfConduct.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCodeOfConduct) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type getProjectItemNodeCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCommentDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getProjectItemNodeCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCommit) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCommitComment includes the requested fields of the GraphQL type CommitComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on a given Commit.
+type getProjectItemNodeCommitComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCommitComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCommitComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCommitCommentThread includes the requested fields of the GraphQL type CommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// A thread of comments on a commit.
+type getProjectItemNodeCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeComparison includes the requested fields of the GraphQL type Comparison.
+// The GraphQL type's documentation follows.
+//
+// Represents a comparison between two commit revisions.
+type getProjectItemNodeComparison struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeComparison.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeComparison) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'connected' event on a given issue or pull request.
+type getProjectItemNodeConnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeConnectedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeConvertToDraftEvent includes the requested fields of the GraphQL type ConvertToDraftEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'convert_to_draft' event on a given pull request.
+type getProjectItemNodeConvertToDraftEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeConvertToDraftEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeConvertToDraftEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type getProjectItemNodeConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeConvertedNoteToIssueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_to_discussion' event on a given issue.
+type getProjectItemNodeConvertedToDiscussionEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeConvertedToDiscussionEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a mention made by one issue or pull request to another.
+type getProjectItemNodeCrossReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeCrossReferencedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'demilestoned' event on a given issue or pull request.
+type getProjectItemNodeDemilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDemilestonedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDependencyGraphManifest includes the requested fields of the GraphQL type DependencyGraphManifest.
+// The GraphQL type's documentation follows.
+//
+// Dependency manifest for a repository
+type getProjectItemNodeDependencyGraphManifest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDependencyGraphManifest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDependencyGraphManifest) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeployKey includes the requested fields of the GraphQL type DeployKey.
+// The GraphQL type's documentation follows.
+//
+// A repository deploy key.
+type getProjectItemNodeDeployKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeployKey.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeployKey) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeployedEvent includes the requested fields of the GraphQL type DeployedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployed' event on a given pull request.
+type getProjectItemNodeDeployedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeployedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeployedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type getProjectItemNodeDeployment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeployment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeploymentEnvironmentChangedEvent includes the requested fields of the GraphQL type DeploymentEnvironmentChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployment_environment_changed' event on a given pull request.
+type getProjectItemNodeDeploymentEnvironmentChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeploymentEnvironmentChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeploymentEnvironmentChangedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeploymentReview includes the requested fields of the GraphQL type DeploymentReview.
+// The GraphQL type's documentation follows.
+//
+// A deployment review.
+type getProjectItemNodeDeploymentReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeploymentReview.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeploymentReview) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// The GraphQL type's documentation follows.
+//
+// Describes the status of a given deployment attempt.
+type getProjectItemNodeDeploymentStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDeploymentStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDeploymentStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'disconnected' event on a given issue or pull request.
+type getProjectItemNodeDisconnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDisconnectedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDiscussion includes the requested fields of the GraphQL type Discussion.
+// The GraphQL type's documentation follows.
+//
+// A discussion in a repository.
+type getProjectItemNodeDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDiscussionCategory includes the requested fields of the GraphQL type DiscussionCategory.
+// The GraphQL type's documentation follows.
+//
+// A category for discussions in a repository.
+type getProjectItemNodeDiscussionCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDiscussionCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDiscussionCategory) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDiscussionComment includes the requested fields of the GraphQL type DiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a discussion.
+type getProjectItemNodeDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDiscussionComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDiscussionPoll includes the requested fields of the GraphQL type DiscussionPoll.
+// The GraphQL type's documentation follows.
+//
+// A poll for a discussion.
+type getProjectItemNodeDiscussionPoll struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDiscussionPoll.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDiscussionPoll) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDiscussionPollOption includes the requested fields of the GraphQL type DiscussionPollOption.
+// The GraphQL type's documentation follows.
+//
+// An option for a discussion poll.
+type getProjectItemNodeDiscussionPollOption struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDiscussionPollOption.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDiscussionPollOption) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type getProjectItemNodeDraftIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeDraftIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterprise includes the requested fields of the GraphQL type Enterprise.
+// The GraphQL type's documentation follows.
+//
+// An account to manage multiple organizations with consolidated policy and billing.
+type getProjectItemNodeEnterprise struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterprise.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterprise) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseAdministratorInvitation includes the requested fields of the GraphQL type EnterpriseAdministratorInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to become an owner or billing manager of an enterprise.
+type getProjectItemNodeEnterpriseAdministratorInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseAdministratorInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseAdministratorInvitation) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseIdentityProvider includes the requested fields of the GraphQL type EnterpriseIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An identity provider configured to provision identities for an enterprise.
+// Visible to enterprise owners or enterprise owners' personal access tokens
+// (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemNodeEnterpriseIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseIdentityProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseRepositoryInfo includes the requested fields of the GraphQL type EnterpriseRepositoryInfo.
+// The GraphQL type's documentation follows.
+//
+// A subset of repository information queryable from an enterprise.
+type getProjectItemNodeEnterpriseRepositoryInfo struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseRepositoryInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseRepositoryInfo) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseServerInstallation includes the requested fields of the GraphQL type EnterpriseServerInstallation.
+// The GraphQL type's documentation follows.
+//
+// An Enterprise Server installation.
+type getProjectItemNodeEnterpriseServerInstallation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseServerInstallation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseServerInstallation) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseServerUserAccount includes the requested fields of the GraphQL type EnterpriseServerUserAccount.
+// The GraphQL type's documentation follows.
+//
+// A user account on an Enterprise Server installation.
+type getProjectItemNodeEnterpriseServerUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseServerUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseServerUserAccount) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseServerUserAccountEmail includes the requested fields of the GraphQL type EnterpriseServerUserAccountEmail.
+// The GraphQL type's documentation follows.
+//
+// An email belonging to a user account on an Enterprise Server installation.
+type getProjectItemNodeEnterpriseServerUserAccountEmail struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseServerUserAccountEmail.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseServerUserAccountEmail) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnterpriseServerUserAccountsUpload includes the requested fields of the GraphQL type EnterpriseServerUserAccountsUpload.
+// The GraphQL type's documentation follows.
+//
+// A user accounts upload from an Enterprise Server installation.
+type getProjectItemNodeEnterpriseServerUserAccountsUpload struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseServerUserAccountsUpload.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseServerUserAccountsUpload) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type getProjectItemNodeEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// An environment.
+type getProjectItemNodeEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeEnvironment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeExternalIdentity includes the requested fields of the GraphQL type ExternalIdentity.
+// The GraphQL type's documentation follows.
+//
+// An external identity provisioned by SAML SSO or SCIM. If SAML is configured on
+// the organization, the external identity is visible to (1) organization owners,
+// (2) organization owners' personal access tokens (classic) with read:org or
+// admin:org scope, (3) GitHub App with an installation token with read or write
+// access to members. If SAML is configured on the enterprise, the external
+// identity is visible to (1) enterprise owners, (2) enterprise owners' personal
+// access tokens (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemNodeExternalIdentity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeExternalIdentity.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeExternalIdentity) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeGist includes the requested fields of the GraphQL type Gist.
+// The GraphQL type's documentation follows.
+//
+// A Gist.
+type getProjectItemNodeGist struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeGist.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeGist) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeGistComment includes the requested fields of the GraphQL type GistComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Gist.
+type getProjectItemNodeGistComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeGistComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeGistComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeHeadRefDeletedEvent includes the requested fields of the GraphQL type HeadRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_deleted' event on a given pull request.
+type getProjectItemNodeHeadRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeHeadRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeHeadRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeHeadRefForcePushedEvent includes the requested fields of the GraphQL type HeadRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_force_pushed' event on a given pull request.
+type getProjectItemNodeHeadRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeHeadRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeHeadRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeHeadRefRestoredEvent includes the requested fields of the GraphQL type HeadRefRestoredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_restored' event on a given pull request.
+type getProjectItemNodeHeadRefRestoredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeHeadRefRestoredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeHeadRefRestoredEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeIpAllowListEntry includes the requested fields of the GraphQL type IpAllowListEntry.
+// The GraphQL type's documentation follows.
+//
+// An IP address or range of addresses that is allowed to access an owner's resources.
+type getProjectItemNodeIpAllowListEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeIpAllowListEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeIpAllowListEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type getProjectItemNodeIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeIssueComment includes the requested fields of the GraphQL type IssueComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Issue.
+type getProjectItemNodeIssueComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeIssueComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getProjectItemNodeLabel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLabel.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLabel) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'labeled' event on a given issue or pull request.
+type getProjectItemNodeLabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLabeledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLanguage includes the requested fields of the GraphQL type Language.
+// The GraphQL type's documentation follows.
+//
+// Represents a given language found in repositories.
+type getProjectItemNodeLanguage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLanguage.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLanguage) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLicense includes the requested fields of the GraphQL type License.
+// The GraphQL type's documentation follows.
+//
+// A repository's open source license
+type getProjectItemNodeLicense struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLicense.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLicense) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLinkedBranch includes the requested fields of the GraphQL type LinkedBranch.
+// The GraphQL type's documentation follows.
+//
+// A branch linked to an issue.
+type getProjectItemNodeLinkedBranch struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLinkedBranch.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLinkedBranch) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeLockedEvent includes the requested fields of the GraphQL type LockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'locked' event on a given issue or pull request.
+type getProjectItemNodeLockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeLockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type getProjectItemNodeMannequin struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMannequin) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type getProjectItemNodeMarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMarketplaceCategory includes the requested fields of the GraphQL type MarketplaceCategory.
+// The GraphQL type's documentation follows.
+//
+// A public description of a Marketplace category.
+type getProjectItemNodeMarketplaceCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMarketplaceCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMarketplaceCategory) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMarketplaceListing includes the requested fields of the GraphQL type MarketplaceListing.
+// The GraphQL type's documentation follows.
+//
+// A listing in the GitHub integration marketplace.
+type getProjectItemNodeMarketplaceListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMarketplaceListing.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMarketplaceListing) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMemberFeatureRequestNotification includes the requested fields of the GraphQL type MemberFeatureRequestNotification.
+// The GraphQL type's documentation follows.
+//
+// Represents a member feature request notification
+type getProjectItemNodeMemberFeatureRequestNotification struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMemberFeatureRequestNotification.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMemberFeatureRequestNotification) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMembersCanDeleteReposClearAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposClearAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.clear event.
+type getProjectItemNodeMembersCanDeleteReposClearAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMembersCanDeleteReposClearAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMembersCanDeleteReposClearAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeMembersCanDeleteReposDisableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.disable event.
+type getProjectItemNodeMembersCanDeleteReposDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMembersCanDeleteReposDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMembersCanDeleteReposDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeMembersCanDeleteReposEnableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.enable event.
+type getProjectItemNodeMembersCanDeleteReposEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMembersCanDeleteReposEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMembersCanDeleteReposEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'mentioned' event on a given issue or pull request.
+type getProjectItemNodeMentionedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMentionedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMergeQueue includes the requested fields of the GraphQL type MergeQueue.
+// The GraphQL type's documentation follows.
+//
+// The queue of pull request entries to be merged into a protected branch in a repository.
+type getProjectItemNodeMergeQueue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMergeQueue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMergeQueue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMergeQueueEntry includes the requested fields of the GraphQL type MergeQueueEntry.
+// The GraphQL type's documentation follows.
+//
+// Entries in a MergeQueue
+type getProjectItemNodeMergeQueueEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMergeQueueEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMergeQueueEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMergedEvent includes the requested fields of the GraphQL type MergedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'merged' event on a given pull request.
+type getProjectItemNodeMergedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMergedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMergedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMigrationSource includes the requested fields of the GraphQL type MigrationSource.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) migration source.
+type getProjectItemNodeMigrationSource struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMigrationSource.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMigrationSource) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMilestone includes the requested fields of the GraphQL type Milestone.
+// The GraphQL type's documentation follows.
+//
+// Represents a Milestone object on a given repository.
+type getProjectItemNodeMilestone struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMilestone.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMilestone) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'milestoned' event on a given issue or pull request.
+type getProjectItemNodeMilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMilestonedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type getProjectItemNodeMovedColumnsInProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeMovedColumnsInProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOIDCProvider includes the requested fields of the GraphQL type OIDCProvider.
+// The GraphQL type's documentation follows.
+//
+// An OIDC identity provider configured to provision identities for an enterprise.
+// Visible to enterprise owners or enterprise owners' personal access tokens
+// (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemNodeOIDCProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOIDCProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOIDCProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOauthApplicationCreateAuditEntry includes the requested fields of the GraphQL type OauthApplicationCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a oauth_application.create event.
+type getProjectItemNodeOauthApplicationCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOauthApplicationCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOauthApplicationCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgAddBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgAddBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_billing_manager
+type getProjectItemNodeOrgAddBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgAddBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgAddBillingManagerAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgAddMemberAuditEntry includes the requested fields of the GraphQL type OrgAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_member
+type getProjectItemNodeOrgAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgBlockUserAuditEntry includes the requested fields of the GraphQL type OrgBlockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.block_user
+type getProjectItemNodeOrgBlockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgBlockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgBlockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.disable_collaborators_only event.
+type getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.enable_collaborators_only event.
+type getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgCreateAuditEntry includes the requested fields of the GraphQL type OrgCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.create event.
+type getProjectItemNodeOrgCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgDisableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_oauth_app_restrictions event.
+type getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgDisableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgDisableSamlAuditEntry includes the requested fields of the GraphQL type OrgDisableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_saml event.
+type getProjectItemNodeOrgDisableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgDisableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgDisableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgDisableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_two_factor_requirement event.
+type getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgDisableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgEnableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_oauth_app_restrictions event.
+type getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgEnableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgEnableSamlAuditEntry includes the requested fields of the GraphQL type OrgEnableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_saml event.
+type getProjectItemNodeOrgEnableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgEnableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgEnableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgEnableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_two_factor_requirement event.
+type getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgEnableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgInviteMemberAuditEntry includes the requested fields of the GraphQL type OrgInviteMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_member event.
+type getProjectItemNodeOrgInviteMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgInviteMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgInviteMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgInviteToBusinessAuditEntry includes the requested fields of the GraphQL type OrgInviteToBusinessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_to_business event.
+type getProjectItemNodeOrgInviteToBusinessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgInviteToBusinessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgInviteToBusinessAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessApprovedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_approved event.
+type getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgOauthAppAccessApprovedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessBlockedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_blocked event.
+type getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgOauthAppAccessBlockedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessDeniedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_denied event.
+type getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgOauthAppAccessDeniedAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessRequestedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_requested event.
+type getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgOauthAppAccessRequestedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessUnblockedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_unblocked event.
+type getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgOauthAppAccessUnblockedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgRemoveBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgRemoveBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_billing_manager event.
+type getProjectItemNodeOrgRemoveBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgRemoveBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgRemoveBillingManagerAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgRemoveMemberAuditEntry includes the requested fields of the GraphQL type OrgRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_member event.
+type getProjectItemNodeOrgRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry includes the requested fields of the GraphQL type OrgRemoveOutsideCollaboratorAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_outside_collaborator event.
+type getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgRemoveOutsideCollaboratorAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgRestoreMemberAuditEntry includes the requested fields of the GraphQL type OrgRestoreMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.restore_member event.
+type getProjectItemNodeOrgRestoreMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgRestoreMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgRestoreMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgUnblockUserAuditEntry includes the requested fields of the GraphQL type OrgUnblockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.unblock_user
+type getProjectItemNodeOrgUnblockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgUnblockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgUnblockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateDefaultRepositoryPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_default_repository_permission
+type getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgUpdateDefaultRepositoryPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgUpdateMemberAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member event.
+type getProjectItemNodeOrgUpdateMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgUpdateMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgUpdateMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryCreationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_creation_permission event.
+type getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgUpdateMemberRepositoryCreationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryInvitationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_invitation_permission event.
+type getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getProjectItemNodeOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrganization) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrganizationIdentityProvider includes the requested fields of the GraphQL type OrganizationIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An Identity Provider configured to provision SAML and SCIM identities for
+// Organizations. Visible to (1) organization owners, (2) organization owners'
+// personal access tokens (classic) with read:org or admin:org scope, (3) GitHub
+// App with an installation token with read or write access to members.
+type getProjectItemNodeOrganizationIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrganizationIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrganizationIdentityProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+// The GraphQL type's documentation follows.
+//
+// An Invitation for a user to an organization.
+type getProjectItemNodeOrganizationInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrganizationInvitation) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeOrganizationMigration includes the requested fields of the GraphQL type OrganizationMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) organization migration.
+type getProjectItemNodeOrganizationMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeOrganizationMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeOrganizationMigration) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Information for an uploaded package.
+type getProjectItemNodePackage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePackage.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePackage) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePackageFile includes the requested fields of the GraphQL type PackageFile.
+// The GraphQL type's documentation follows.
+//
+// A file in a package version.
+type getProjectItemNodePackageFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePackageFile.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePackageFile) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePackageTag includes the requested fields of the GraphQL type PackageTag.
+// The GraphQL type's documentation follows.
+//
+// A version tag contains the mapping between a tag name and a version.
+type getProjectItemNodePackageTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePackageTag.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePackageTag) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePackageVersion includes the requested fields of the GraphQL type PackageVersion.
+// The GraphQL type's documentation follows.
+//
+// Information about a specific package version.
+type getProjectItemNodePackageVersion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePackageVersion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePackageVersion) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePinnedDiscussion includes the requested fields of the GraphQL type PinnedDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Discussion is a discussion pinned to a repository's index page.
+type getProjectItemNodePinnedDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePinnedDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePinnedDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePinnedEnvironment includes the requested fields of the GraphQL type PinnedEnvironment.
+// The GraphQL type's documentation follows.
+//
+// Represents a pinned environment on a given repository
+type getProjectItemNodePinnedEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePinnedEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePinnedEnvironment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'pinned' event on a given issue or pull request.
+type getProjectItemNodePinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePinnedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePinnedIssue includes the requested fields of the GraphQL type PinnedIssue.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Issue is a issue pinned to a repository's index page.
+type getProjectItemNodePinnedIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePinnedIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePinnedIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePrivateRepositoryForkingDisableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.disable event.
+type getProjectItemNodePrivateRepositoryForkingDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePrivateRepositoryForkingDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePrivateRepositoryForkingDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodePrivateRepositoryForkingEnableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.enable event.
+type getProjectItemNodePrivateRepositoryForkingEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePrivateRepositoryForkingEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePrivateRepositoryForkingEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Projects manage issues, pull requests and notes within a project owner.
+type getProjectItemNodeProject struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProject.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProject) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectCard includes the requested fields of the GraphQL type ProjectCard.
+// The GraphQL type's documentation follows.
+//
+// A card in a project.
+type getProjectItemNodeProjectCard struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectCard.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectCard) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectColumn includes the requested fields of the GraphQL type ProjectColumn.
+// The GraphQL type's documentation follows.
+//
+// A column inside a project.
+type getProjectItemNodeProjectColumn struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectColumn.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectColumn) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getProjectItemNodeProjectV2 struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
+// The GraphQL type's documentation follows.
+//
+// A field inside a project.
+type getProjectItemNodeProjectV2Field struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Field) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2Item includes the requested fields of the GraphQL type ProjectV2Item.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type getProjectItemNodeProjectV2Item struct {
+	Typename    string `json:"__typename"`
+	ProjectItem `json:"-"`
+	// The project that contains this item.
+	Project getProjectItemNodeProjectV2ItemProjectProjectV2 `json:"project"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2Item.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetTypename() string { return v.Typename }
+
+// GetProject returns getProjectItemNodeProjectV2Item.Project, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetProject() getProjectItemNodeProjectV2ItemProjectProjectV2 {
+	return v.Project
+}
+
+// GetId returns getProjectItemNodeProjectV2Item.Id, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetId() string { return v.ProjectItem.Id }
+
+// GetIsArchived returns getProjectItemNodeProjectV2Item.IsArchived, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetIsArchived() bool { return v.ProjectItem.IsArchived }
+
+// GetUpdatedAt returns getProjectItemNodeProjectV2Item.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetUpdatedAt() time.Time { return v.ProjectItem.UpdatedAt }
+
+// GetFieldValues returns getProjectItemNodeProjectV2Item.FieldValues, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetFieldValues() ProjectItemFieldValuesProjectV2ItemFieldValueConnection {
+	return v.ProjectItem.FieldValues
+}
+
+// GetContent returns getProjectItemNodeProjectV2Item.Content, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Item) GetContent() ProjectItemContentProjectV2ItemContent {
+	return v.ProjectItem.Content
+}
+
+func (v *getProjectItemNodeProjectV2Item) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectItemNodeProjectV2Item
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectItemNodeProjectV2Item = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItem)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectItemNodeProjectV2Item struct {
+	Typename string `json:"__typename"`
+
+	Project getProjectItemNodeProjectV2ItemProjectProjectV2 `json:"project"`
+
+	Id string `json:"id"`
+
+	IsArchived bool `json:"isArchived"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	FieldValues ProjectItemFieldValuesProjectV2ItemFieldValueConnection `json:"fieldValues"`
+
+	Content json.RawMessage `json:"content"`
+}
+
+func (v *getProjectItemNodeProjectV2Item) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectItemNodeProjectV2Item) __premarshalJSON() (*__premarshalgetProjectItemNodeProjectV2Item, error) {
+	var retval __premarshalgetProjectItemNodeProjectV2Item
+
+	retval.Typename = v.Typename
+	retval.Project = v.Project
+	retval.Id = v.ProjectItem.Id
+	retval.IsArchived = v.ProjectItem.IsArchived
+	retval.UpdatedAt = v.ProjectItem.UpdatedAt
+	retval.FieldValues = v.ProjectItem.FieldValues
+	{
+
+		dst := &retval.Content
+		src := v.ProjectItem.Content
+		var err error
+		*dst, err = __marshalProjectItemContentProjectV2ItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getProjectItemNodeProjectV2Item.ProjectItem.Content: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getProjectItemNodeProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a date field in a Project item.
+type getProjectItemNodeProjectV2ItemFieldDateValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemFieldDateValue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// The GraphQL type's documentation follows.
+//
+// The value of an iteration field in a Project item.
+type getProjectItemNodeProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemFieldIterationValue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a number field in a Project item.
+type getProjectItemNodeProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemFieldNumberValue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a single select field in a Project item.
+type getProjectItemNodeProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemFieldSingleSelectValue) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a text field in a Project item.
+type getProjectItemNodeProjectV2ItemFieldTextValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemFieldTextValue) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2ItemProjectProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getProjectItemNodeProjectV2ItemProjectProjectV2 struct {
+	ProjectFieldDefinitions `json:"-"`
+}
+
+// GetId returns getProjectItemNodeProjectV2ItemProjectProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) GetId() string {
+	return v.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getProjectItemNodeProjectV2ItemProjectProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) GetTitle() string {
+	return v.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getProjectItemNodeProjectV2ItemProjectProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFieldDefinitions.Fields
+}
+
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectItemNodeProjectV2ItemProjectProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectItemNodeProjectV2ItemProjectProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectFieldDefinitions)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectItemNodeProjectV2ItemProjectProjectV2 struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectItemNodeProjectV2ItemProjectProjectV2) __premarshalJSON() (*__premarshalgetProjectItemNodeProjectV2ItemProjectProjectV2, error) {
+	var retval __premarshalgetProjectItemNodeProjectV2ItemProjectProjectV2
+
+	retval.Id = v.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getProjectItemNodeProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
+// The GraphQL type's documentation follows.
+//
+// An iteration field inside a project.
+type getProjectItemNodeProjectV2IterationField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2IterationField) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
+// The GraphQL type's documentation follows.
+//
+// A single select field inside a project.
+type getProjectItemNodeProjectV2SingleSelectField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2SingleSelectField) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2View includes the requested fields of the GraphQL type ProjectV2View.
+// The GraphQL type's documentation follows.
+//
+// A view within a ProjectV2.
+type getProjectItemNodeProjectV2View struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2View.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2View) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeProjectV2Workflow includes the requested fields of the GraphQL type ProjectV2Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow inside a project.
+type getProjectItemNodeProjectV2Workflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeProjectV2Workflow.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeProjectV2Workflow) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePublicKey includes the requested fields of the GraphQL type PublicKey.
+// The GraphQL type's documentation follows.
+//
+// A user's public key.
+type getProjectItemNodePublicKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePublicKey.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePublicKey) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getProjectItemNodePullRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequest) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type getProjectItemNodePullRequestCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestCommit) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestCommitCommentThread includes the requested fields of the GraphQL type PullRequestCommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit comment thread part of a pull request.
+type getProjectItemNodePullRequestCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type getProjectItemNodePullRequestReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestReview.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestReview) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestReviewComment includes the requested fields of the GraphQL type PullRequestReviewComment.
+// The GraphQL type's documentation follows.
+//
+// A review comment associated with a given repository pull request.
+type getProjectItemNodePullRequestReviewComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestReviewComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestReviewComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getProjectItemNodePullRequestReviewThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestReviewThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestReviewThread) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePullRequestThread includes the requested fields of the GraphQL type PullRequestThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getProjectItemNodePullRequestThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePullRequestThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePullRequestThread) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePush includes the requested fields of the GraphQL type Push.
+// The GraphQL type's documentation follows.
+//
+// A Git push.
+type getProjectItemNodePush struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePush.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePush) GetTypename() string { return v.Typename }
+
+// getProjectItemNodePushAllowance includes the requested fields of the GraphQL type PushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A team, user, or app who has the ability to push to a protected branch.
+type getProjectItemNodePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodePushAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReaction includes the requested fields of the GraphQL type Reaction.
+// The GraphQL type's documentation follows.
+//
+// An emoji reaction to a particular piece of content.
+type getProjectItemNodeReaction struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReaction.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReaction) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReadyForReviewEvent includes the requested fields of the GraphQL type ReadyForReviewEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'ready_for_review' event on a given pull request.
+type getProjectItemNodeReadyForReviewEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReadyForReviewEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReadyForReviewEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getProjectItemNodeRef struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRef.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRef) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type getProjectItemNodeReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReferencedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRelease includes the requested fields of the GraphQL type Release.
+// The GraphQL type's documentation follows.
+//
+// A release contains the content for a release.
+type getProjectItemNodeRelease struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRelease.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRelease) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReleaseAsset includes the requested fields of the GraphQL type ReleaseAsset.
+// The GraphQL type's documentation follows.
+//
+// A release asset contains the content for a release asset.
+type getProjectItemNodeReleaseAsset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReleaseAsset.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReleaseAsset) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRemovedFromMergeQueueEvent includes the requested fields of the GraphQL type RemovedFromMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_merge_queue' event on a given pull request.
+type getProjectItemNodeRemovedFromMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRemovedFromMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRemovedFromMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type getProjectItemNodeRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRemovedFromProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'renamed' event on a given issue or pull request
+type getProjectItemNodeRenamedTitleEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRenamedTitleEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'reopened' event on any `Closable`.
+type getProjectItemNodeReopenedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReopenedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoAccessAuditEntry includes the requested fields of the GraphQL type RepoAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.access event.
+type getProjectItemNodeRepoAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoAccessAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoAddMemberAuditEntry includes the requested fields of the GraphQL type RepoAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_member event.
+type getProjectItemNodeRepoAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoAddTopicAuditEntry includes the requested fields of the GraphQL type RepoAddTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_topic event.
+type getProjectItemNodeRepoAddTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoAddTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoAddTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoArchivedAuditEntry includes the requested fields of the GraphQL type RepoArchivedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.archived event.
+type getProjectItemNodeRepoArchivedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoArchivedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoArchivedAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoChangeMergeSettingAuditEntry includes the requested fields of the GraphQL type RepoChangeMergeSettingAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.change_merge_setting event.
+type getProjectItemNodeRepoChangeMergeSettingAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoChangeMergeSettingAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoChangeMergeSettingAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_anonymous_git_access event.
+type getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigDisableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_collaborators_only event.
+type getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_contributors_only event.
+type getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigDisableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_sockpuppet_disallowed event.
+type getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigDisableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_anonymous_git_access event.
+type getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigEnableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_collaborators_only event.
+type getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_contributors_only event.
+type getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigEnableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_sockpuppet_disallowed event.
+type getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigEnableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigLockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.lock_anonymous_git_access event.
+type getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigLockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigUnlockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.unlock_anonymous_git_access event.
+type getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoConfigUnlockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepoCreateAuditEntry includes the requested fields of the GraphQL type RepoCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.create event.
+type getProjectItemNodeRepoCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoDestroyAuditEntry includes the requested fields of the GraphQL type RepoDestroyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.destroy event.
+type getProjectItemNodeRepoDestroyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoDestroyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoDestroyAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoRemoveMemberAuditEntry includes the requested fields of the GraphQL type RepoRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_member event.
+type getProjectItemNodeRepoRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepoRemoveTopicAuditEntry includes the requested fields of the GraphQL type RepoRemoveTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_topic event.
+type getProjectItemNodeRepoRemoveTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepoRemoveTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepoRemoveTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getProjectItemNodeRepository struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepository.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepository) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryInvitation includes the requested fields of the GraphQL type RepositoryInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to be added to a repository.
+type getProjectItemNodeRepositoryInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryInvitation) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryMigration includes the requested fields of the GraphQL type RepositoryMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) repository migration.
+type getProjectItemNodeRepositoryMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryMigration) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryRule includes the requested fields of the GraphQL type RepositoryRule.
+// The GraphQL type's documentation follows.
+//
+// A repository rule.
+type getProjectItemNodeRepositoryRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryRule.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryRule) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryRuleset includes the requested fields of the GraphQL type RepositoryRuleset.
+// The GraphQL type's documentation follows.
+//
+// A repository ruleset.
+type getProjectItemNodeRepositoryRuleset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryRuleset.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryRuleset) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryRulesetBypassActor includes the requested fields of the GraphQL type RepositoryRulesetBypassActor.
+// The GraphQL type's documentation follows.
+//
+// A team or app that has the ability to bypass a rules defined on a ruleset
+type getProjectItemNodeRepositoryRulesetBypassActor struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryRulesetBypassActor.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryRulesetBypassActor) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryTopic includes the requested fields of the GraphQL type RepositoryTopic.
+// The GraphQL type's documentation follows.
+//
+// A repository-topic connects a repository to a topic.
+type getProjectItemNodeRepositoryTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryTopic) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.disable event.
+type getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryVisibilityChangeDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.enable event.
+type getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryVisibilityChangeEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemNodeRepositoryVulnerabilityAlert includes the requested fields of the GraphQL type RepositoryVulnerabilityAlert.
+// The GraphQL type's documentation follows.
+//
+// A Dependabot alert for a repository with a dependency affected by a security vulnerability.
+type getProjectItemNodeRepositoryVulnerabilityAlert struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeRepositoryVulnerabilityAlert.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeRepositoryVulnerabilityAlert) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReviewDismissalAllowance includes the requested fields of the GraphQL type ReviewDismissalAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to dismiss a review on a protected branch.
+type getProjectItemNodeReviewDismissalAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReviewDismissalAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReviewDismissalAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReviewDismissedEvent includes the requested fields of the GraphQL type ReviewDismissedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'review_dismissed' event on a given issue or pull request.
+type getProjectItemNodeReviewDismissedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReviewDismissedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReviewDismissedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReviewRequest includes the requested fields of the GraphQL type ReviewRequest.
+// The GraphQL type's documentation follows.
+//
+// A request for a user to review a pull request.
+type getProjectItemNodeReviewRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReviewRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReviewRequest) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReviewRequestRemovedEvent includes the requested fields of the GraphQL type ReviewRequestRemovedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_request_removed' event on a given pull request.
+type getProjectItemNodeReviewRequestRemovedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReviewRequestRemovedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReviewRequestRemovedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeReviewRequestedEvent includes the requested fields of the GraphQL type ReviewRequestedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_requested' event on a given pull request.
+type getProjectItemNodeReviewRequestedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeReviewRequestedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeReviewRequestedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSavedReply includes the requested fields of the GraphQL type SavedReply.
+// The GraphQL type's documentation follows.
+//
+// A Saved Reply is text a user can use to reply quickly.
+type getProjectItemNodeSavedReply struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSavedReply.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSavedReply) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSecurityAdvisory includes the requested fields of the GraphQL type SecurityAdvisory.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Security Advisory
+type getProjectItemNodeSecurityAdvisory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSecurityAdvisory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSecurityAdvisory) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorsActivity includes the requested fields of the GraphQL type SponsorsActivity.
+// The GraphQL type's documentation follows.
+//
+// An event related to sponsorship activity.
+type getProjectItemNodeSponsorsActivity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorsActivity.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorsActivity) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorsListing includes the requested fields of the GraphQL type SponsorsListing.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors listing.
+type getProjectItemNodeSponsorsListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorsListing.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorsListing) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorsListingFeaturedItem includes the requested fields of the GraphQL type SponsorsListingFeaturedItem.
+// The GraphQL type's documentation follows.
+//
+// A record that is promoted on a GitHub Sponsors profile.
+type getProjectItemNodeSponsorsListingFeaturedItem struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorsListingFeaturedItem.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorsListingFeaturedItem) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorsTier includes the requested fields of the GraphQL type SponsorsTier.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors tier associated with a GitHub Sponsors listing.
+type getProjectItemNodeSponsorsTier struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorsTier.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorsTier) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorship includes the requested fields of the GraphQL type Sponsorship.
+// The GraphQL type's documentation follows.
+//
+// A sponsorship relationship between a sponsor and a maintainer
+type getProjectItemNodeSponsorship struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorship.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorship) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSponsorshipNewsletter includes the requested fields of the GraphQL type SponsorshipNewsletter.
+// The GraphQL type's documentation follows.
+//
+// An update sent to sponsors of a user or organization on GitHub Sponsors.
+type getProjectItemNodeSponsorshipNewsletter struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSponsorshipNewsletter.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSponsorshipNewsletter) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeStatus includes the requested fields of the GraphQL type Status.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit status.
+type getProjectItemNodeStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getProjectItemNodeStatusCheckRollup struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeStatusCheckRollup.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeStatusCheckRollup) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeStatusContext includes the requested fields of the GraphQL type StatusContext.
+// The GraphQL type's documentation follows.
+//
+// Represents an individual commit status context
+type getProjectItemNodeStatusContext struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeStatusContext.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeStatusContext) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'subscribed' event on a given `Subscribable`.
+type getProjectItemNodeSubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeSubscribedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getProjectItemNodeTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTag.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTag) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// A team of users in an organization.
+type getProjectItemNodeTeam struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeam.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeam) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamAddMemberAuditEntry includes the requested fields of the GraphQL type TeamAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_member event.
+type getProjectItemNodeTeamAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamAddRepositoryAuditEntry includes the requested fields of the GraphQL type TeamAddRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_repository event.
+type getProjectItemNodeTeamAddRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamAddRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamAddRepositoryAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamChangeParentTeamAuditEntry includes the requested fields of the GraphQL type TeamChangeParentTeamAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.change_parent_team event.
+type getProjectItemNodeTeamChangeParentTeamAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamChangeParentTeamAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamChangeParentTeamAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamDiscussion includes the requested fields of the GraphQL type TeamDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A team discussion.
+type getProjectItemNodeTeamDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamDiscussionComment includes the requested fields of the GraphQL type TeamDiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a team discussion.
+type getProjectItemNodeTeamDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamDiscussionComment) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamRemoveMemberAuditEntry includes the requested fields of the GraphQL type TeamRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_member event.
+type getProjectItemNodeTeamRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTeamRemoveRepositoryAuditEntry includes the requested fields of the GraphQL type TeamRemoveRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_repository event.
+type getProjectItemNodeTeamRemoveRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTeamRemoveRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTeamRemoveRepositoryAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTopic includes the requested fields of the GraphQL type Topic.
+// The GraphQL type's documentation follows.
+//
+// A topic aggregates entities that are related to a subject.
+type getProjectItemNodeTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTopic) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'transferred' event on a given issue or pull request.
+type getProjectItemNodeTransferredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTransferredEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getProjectItemNodeTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeTree.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeTree) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unassigned' event on any assignable object.
+type getProjectItemNodeUnassignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnassignedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlabeled' event on a given issue or pull request.
+type getProjectItemNodeUnlabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlocked' event on a given issue or pull request.
+type getProjectItemNodeUnlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnlockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type getProjectItemNodeUnmarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnmarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unpinned' event on a given issue or pull request.
+type getProjectItemNodeUnpinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnpinnedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type getProjectItemNodeUnsubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUnsubscribedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getProjectItemNodeUser struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUser) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'user_blocked' event on a given user.
+type getProjectItemNodeUserBlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUserContentEdit includes the requested fields of the GraphQL type UserContentEdit.
+// The GraphQL type's documentation follows.
+//
+// An edit on user content
+type getProjectItemNodeUserContentEdit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUserContentEdit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUserContentEdit) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUserList includes the requested fields of the GraphQL type UserList.
+// The GraphQL type's documentation follows.
+//
+// A user-curated list of repositories
+type getProjectItemNodeUserList struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUserList.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUserList) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeUserStatus includes the requested fields of the GraphQL type UserStatus.
+// The GraphQL type's documentation follows.
+//
+// The user's description of what they're currently doing.
+type getProjectItemNodeUserStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeUserStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeUserStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeVerifiableDomain includes the requested fields of the GraphQL type VerifiableDomain.
+// The GraphQL type's documentation follows.
+//
+// A domain that can be verified or approved for an organization or an enterprise.
+type getProjectItemNodeVerifiableDomain struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeVerifiableDomain.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeVerifiableDomain) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeWorkflow includes the requested fields of the GraphQL type Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow contains meta information about an Actions workflow file.
+type getProjectItemNodeWorkflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeWorkflow.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeWorkflow) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeWorkflowRun includes the requested fields of the GraphQL type WorkflowRun.
+// The GraphQL type's documentation follows.
+//
+// A workflow run.
+type getProjectItemNodeWorkflowRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeWorkflowRun.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeWorkflowRun) GetTypename() string { return v.Typename }
+
+// getProjectItemNodeWorkflowRunFile includes the requested fields of the GraphQL type WorkflowRunFile.
+// The GraphQL type's documentation follows.
+//
+// An executed workflow file for a workflow run.
+type getProjectItemNodeWorkflowRunFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemNodeWorkflowRunFile.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemNodeWorkflowRunFile) GetTypename() string { return v.Typename }
+
+// getProjectItemResponse is returned by getProjectItem on success.
+type getProjectItemResponse struct {
+	// Fetches an object given its ID.
+	Node getProjectItemNode `json:"-"`
+}
+
+// GetNode returns getProjectItemResponse.Node, and is useful for accessing the field via an interface.
+func (v *getProjectItemResponse) GetNode() getProjectItemNode { return v.Node }
+
+func (v *getProjectItemResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectItemResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectItemResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetProjectItemNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getProjectItemResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetProjectItemResponse struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *getProjectItemResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectItemResponse) __premarshalJSON() (*__premarshalgetProjectItemResponse, error) {
+	var retval __premarshalgetProjectItemResponse
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalgetProjectItemNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getProjectItemResponse.Node: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getProjectItemsNodesAddedToMergeQueueEvent includes the requested fields of the GraphQL type AddedToMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'added_to_merge_queue' event on a given pull request.
+type getProjectItemsNodesAddedToMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAddedToMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAddedToMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAddedToProjectEvent includes the requested fields of the GraphQL type AddedToProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'added_to_project' event on a given issue or pull request.
+type getProjectItemsNodesAddedToProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAddedToProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAddedToProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesApp includes the requested fields of the GraphQL type App.
+// The GraphQL type's documentation follows.
+//
+// A GitHub App.
+type getProjectItemsNodesApp struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesApp.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesApp) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAssignedEvent includes the requested fields of the GraphQL type AssignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'assigned' event on any assignable object.
+type getProjectItemsNodesAssignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAssignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAssignedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutoMergeDisabledEvent includes the requested fields of the GraphQL type AutoMergeDisabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_disabled' event on a given pull request.
+type getProjectItemsNodesAutoMergeDisabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutoMergeDisabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutoMergeDisabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutoMergeEnabledEvent includes the requested fields of the GraphQL type AutoMergeEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_merge_enabled' event on a given pull request.
+type getProjectItemsNodesAutoMergeEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutoMergeEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutoMergeEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutoRebaseEnabledEvent includes the requested fields of the GraphQL type AutoRebaseEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_rebase_enabled' event on a given pull request.
+type getProjectItemsNodesAutoRebaseEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutoRebaseEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutoRebaseEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutoSquashEnabledEvent includes the requested fields of the GraphQL type AutoSquashEnabledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'auto_squash_enabled' event on a given pull request.
+type getProjectItemsNodesAutoSquashEnabledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutoSquashEnabledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutoSquashEnabledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutomaticBaseChangeFailedEvent includes the requested fields of the GraphQL type AutomaticBaseChangeFailedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_failed' event on a given pull request.
+type getProjectItemsNodesAutomaticBaseChangeFailedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutomaticBaseChangeFailedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutomaticBaseChangeFailedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesAutomaticBaseChangeSucceededEvent includes the requested fields of the GraphQL type AutomaticBaseChangeSucceededEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'automatic_base_change_succeeded' event on a given pull request.
+type getProjectItemsNodesAutomaticBaseChangeSucceededEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesAutomaticBaseChangeSucceededEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesAutomaticBaseChangeSucceededEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesBaseRefChangedEvent includes the requested fields of the GraphQL type BaseRefChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_changed' event on a given issue or pull request.
+type getProjectItemsNodesBaseRefChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBaseRefChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBaseRefChangedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBaseRefDeletedEvent includes the requested fields of the GraphQL type BaseRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_deleted' event on a given pull request.
+type getProjectItemsNodesBaseRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBaseRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBaseRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBaseRefForcePushedEvent includes the requested fields of the GraphQL type BaseRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'base_ref_force_pushed' event on a given pull request.
+type getProjectItemsNodesBaseRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBaseRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBaseRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getProjectItemsNodesBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBlob) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type getProjectItemsNodesBot struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBot.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBot) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBranchProtectionRule includes the requested fields of the GraphQL type BranchProtectionRule.
+// The GraphQL type's documentation follows.
+//
+// A branch protection rule.
+type getProjectItemsNodesBranchProtectionRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBranchProtectionRule.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBranchProtectionRule) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBypassForcePushAllowance includes the requested fields of the GraphQL type BypassForcePushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a force push requirement on a protected branch.
+type getProjectItemsNodesBypassForcePushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBypassForcePushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBypassForcePushAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesBypassPullRequestAllowance includes the requested fields of the GraphQL type BypassPullRequestAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to bypass a pull request requirement on a protected branch.
+type getProjectItemsNodesBypassPullRequestAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesBypassPullRequestAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesBypassPullRequestAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCWE includes the requested fields of the GraphQL type CWE.
+// The GraphQL type's documentation follows.
+//
+// A common weakness enumeration
+type getProjectItemsNodesCWE struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCWE.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCWE) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCheckRun includes the requested fields of the GraphQL type CheckRun.
+// The GraphQL type's documentation follows.
+//
+// A check run.
+type getProjectItemsNodesCheckRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCheckRun.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCheckRun) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type getProjectItemsNodesCheckSuite struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCheckSuite.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCheckSuite) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesClosedEvent includes the requested fields of the GraphQL type ClosedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'closed' event on any `Closable`.
+type getProjectItemsNodesClosedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesClosedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesClosedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCodeOfConduct includes the requested fields of the GraphQL type CodeOfConduct.
+// The GraphQL type's documentation follows.
+//
+// The Code of Conduct for a repository
+type getProjectItemsNodesCodeOfConduct struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCodeOfConduct.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCodeOfConduct) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCommentDeletedEvent includes the requested fields of the GraphQL type CommentDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'comment_deleted' event on a given issue or pull request.
+type getProjectItemsNodesCommentDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCommentDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCommentDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getProjectItemsNodesCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCommit) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCommitComment includes the requested fields of the GraphQL type CommitComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on a given Commit.
+type getProjectItemsNodesCommitComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCommitComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCommitComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCommitCommentThread includes the requested fields of the GraphQL type CommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// A thread of comments on a commit.
+type getProjectItemsNodesCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesComparison includes the requested fields of the GraphQL type Comparison.
+// The GraphQL type's documentation follows.
+//
+// Represents a comparison between two commit revisions.
+type getProjectItemsNodesComparison struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesComparison.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesComparison) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesConnectedEvent includes the requested fields of the GraphQL type ConnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'connected' event on a given issue or pull request.
+type getProjectItemsNodesConnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesConnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesConnectedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesConvertToDraftEvent includes the requested fields of the GraphQL type ConvertToDraftEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'convert_to_draft' event on a given pull request.
+type getProjectItemsNodesConvertToDraftEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesConvertToDraftEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesConvertToDraftEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesConvertedNoteToIssueEvent includes the requested fields of the GraphQL type ConvertedNoteToIssueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_note_to_issue' event on a given issue or pull request.
+type getProjectItemsNodesConvertedNoteToIssueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesConvertedNoteToIssueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesConvertedNoteToIssueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesConvertedToDiscussionEvent includes the requested fields of the GraphQL type ConvertedToDiscussionEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'converted_to_discussion' event on a given issue.
+type getProjectItemsNodesConvertedToDiscussionEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesConvertedToDiscussionEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesConvertedToDiscussionEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesCrossReferencedEvent includes the requested fields of the GraphQL type CrossReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a mention made by one issue or pull request to another.
+type getProjectItemsNodesCrossReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesCrossReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesCrossReferencedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDemilestonedEvent includes the requested fields of the GraphQL type DemilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'demilestoned' event on a given issue or pull request.
+type getProjectItemsNodesDemilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDemilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDemilestonedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDependencyGraphManifest includes the requested fields of the GraphQL type DependencyGraphManifest.
+// The GraphQL type's documentation follows.
+//
+// Dependency manifest for a repository
+type getProjectItemsNodesDependencyGraphManifest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDependencyGraphManifest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDependencyGraphManifest) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDeployKey includes the requested fields of the GraphQL type DeployKey.
+// The GraphQL type's documentation follows.
+//
+// A repository deploy key.
+type getProjectItemsNodesDeployKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeployKey.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeployKey) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDeployedEvent includes the requested fields of the GraphQL type DeployedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployed' event on a given pull request.
+type getProjectItemsNodesDeployedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeployedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeployedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type getProjectItemsNodesDeployment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeployment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDeploymentEnvironmentChangedEvent includes the requested fields of the GraphQL type DeploymentEnvironmentChangedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'deployment_environment_changed' event on a given pull request.
+type getProjectItemsNodesDeploymentEnvironmentChangedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeploymentEnvironmentChangedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeploymentEnvironmentChangedEvent) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesDeploymentReview includes the requested fields of the GraphQL type DeploymentReview.
+// The GraphQL type's documentation follows.
+//
+// A deployment review.
+type getProjectItemsNodesDeploymentReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeploymentReview.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeploymentReview) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// The GraphQL type's documentation follows.
+//
+// Describes the status of a given deployment attempt.
+type getProjectItemsNodesDeploymentStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDeploymentStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDeploymentStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDisconnectedEvent includes the requested fields of the GraphQL type DisconnectedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'disconnected' event on a given issue or pull request.
+type getProjectItemsNodesDisconnectedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDisconnectedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDisconnectedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDiscussion includes the requested fields of the GraphQL type Discussion.
+// The GraphQL type's documentation follows.
+//
+// A discussion in a repository.
+type getProjectItemsNodesDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDiscussionCategory includes the requested fields of the GraphQL type DiscussionCategory.
+// The GraphQL type's documentation follows.
+//
+// A category for discussions in a repository.
+type getProjectItemsNodesDiscussionCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDiscussionCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDiscussionCategory) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDiscussionComment includes the requested fields of the GraphQL type DiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a discussion.
+type getProjectItemsNodesDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDiscussionComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDiscussionPoll includes the requested fields of the GraphQL type DiscussionPoll.
+// The GraphQL type's documentation follows.
+//
+// A poll for a discussion.
+type getProjectItemsNodesDiscussionPoll struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDiscussionPoll.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDiscussionPoll) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDiscussionPollOption includes the requested fields of the GraphQL type DiscussionPollOption.
+// The GraphQL type's documentation follows.
+//
+// An option for a discussion poll.
+type getProjectItemsNodesDiscussionPollOption struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDiscussionPollOption.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDiscussionPollOption) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesDraftIssue includes the requested fields of the GraphQL type DraftIssue.
+// The GraphQL type's documentation follows.
+//
+// A draft issue within a project.
+type getProjectItemsNodesDraftIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesDraftIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesDraftIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterprise includes the requested fields of the GraphQL type Enterprise.
+// The GraphQL type's documentation follows.
+//
+// An account to manage multiple organizations with consolidated policy and billing.
+type getProjectItemsNodesEnterprise struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterprise.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterprise) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterpriseAdministratorInvitation includes the requested fields of the GraphQL type EnterpriseAdministratorInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to become an owner or billing manager of an enterprise.
+type getProjectItemsNodesEnterpriseAdministratorInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseAdministratorInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseAdministratorInvitation) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesEnterpriseIdentityProvider includes the requested fields of the GraphQL type EnterpriseIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An identity provider configured to provision identities for an enterprise.
+// Visible to enterprise owners or enterprise owners' personal access tokens
+// (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemsNodesEnterpriseIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseIdentityProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterpriseRepositoryInfo includes the requested fields of the GraphQL type EnterpriseRepositoryInfo.
+// The GraphQL type's documentation follows.
+//
+// A subset of repository information queryable from an enterprise.
+type getProjectItemsNodesEnterpriseRepositoryInfo struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseRepositoryInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseRepositoryInfo) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterpriseServerInstallation includes the requested fields of the GraphQL type EnterpriseServerInstallation.
+// The GraphQL type's documentation follows.
+//
+// An Enterprise Server installation.
+type getProjectItemsNodesEnterpriseServerInstallation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseServerInstallation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseServerInstallation) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterpriseServerUserAccount includes the requested fields of the GraphQL type EnterpriseServerUserAccount.
+// The GraphQL type's documentation follows.
+//
+// A user account on an Enterprise Server installation.
+type getProjectItemsNodesEnterpriseServerUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseServerUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseServerUserAccount) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnterpriseServerUserAccountEmail includes the requested fields of the GraphQL type EnterpriseServerUserAccountEmail.
+// The GraphQL type's documentation follows.
+//
+// An email belonging to a user account on an Enterprise Server installation.
+type getProjectItemsNodesEnterpriseServerUserAccountEmail struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseServerUserAccountEmail.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseServerUserAccountEmail) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesEnterpriseServerUserAccountsUpload includes the requested fields of the GraphQL type EnterpriseServerUserAccountsUpload.
+// The GraphQL type's documentation follows.
+//
+// A user accounts upload from an Enterprise Server installation.
+type getProjectItemsNodesEnterpriseServerUserAccountsUpload struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseServerUserAccountsUpload.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseServerUserAccountsUpload) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type getProjectItemsNodesEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// An environment.
+type getProjectItemsNodesEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesEnvironment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesExternalIdentity includes the requested fields of the GraphQL type ExternalIdentity.
+// The GraphQL type's documentation follows.
+//
+// An external identity provisioned by SAML SSO or SCIM. If SAML is configured on
+// the organization, the external identity is visible to (1) organization owners,
+// (2) organization owners' personal access tokens (classic) with read:org or
+// admin:org scope, (3) GitHub App with an installation token with read or write
+// access to members. If SAML is configured on the enterprise, the external
+// identity is visible to (1) enterprise owners, (2) enterprise owners' personal
+// access tokens (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemsNodesExternalIdentity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesExternalIdentity.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesExternalIdentity) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesGist includes the requested fields of the GraphQL type Gist.
+// The GraphQL type's documentation follows.
+//
+// A Gist.
+type getProjectItemsNodesGist struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesGist.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesGist) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesGistComment includes the requested fields of the GraphQL type GistComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Gist.
+type getProjectItemsNodesGistComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesGistComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesGistComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesHeadRefDeletedEvent includes the requested fields of the GraphQL type HeadRefDeletedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_deleted' event on a given pull request.
+type getProjectItemsNodesHeadRefDeletedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesHeadRefDeletedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesHeadRefDeletedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesHeadRefForcePushedEvent includes the requested fields of the GraphQL type HeadRefForcePushedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_force_pushed' event on a given pull request.
+type getProjectItemsNodesHeadRefForcePushedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesHeadRefForcePushedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesHeadRefForcePushedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesHeadRefRestoredEvent includes the requested fields of the GraphQL type HeadRefRestoredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'head_ref_restored' event on a given pull request.
+type getProjectItemsNodesHeadRefRestoredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesHeadRefRestoredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesHeadRefRestoredEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesIpAllowListEntry includes the requested fields of the GraphQL type IpAllowListEntry.
+// The GraphQL type's documentation follows.
+//
+// An IP address or range of addresses that is allowed to access an owner's resources.
+type getProjectItemsNodesIpAllowListEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesIpAllowListEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesIpAllowListEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type getProjectItemsNodesIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesIssueComment includes the requested fields of the GraphQL type IssueComment.
+// The GraphQL type's documentation follows.
+//
+// Represents a comment on an Issue.
+type getProjectItemsNodesIssueComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesIssueComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesIssueComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getProjectItemsNodesLabel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLabel.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLabel) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLabeledEvent includes the requested fields of the GraphQL type LabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'labeled' event on a given issue or pull request.
+type getProjectItemsNodesLabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLabeledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLanguage includes the requested fields of the GraphQL type Language.
+// The GraphQL type's documentation follows.
+//
+// Represents a given language found in repositories.
+type getProjectItemsNodesLanguage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLanguage.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLanguage) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLicense includes the requested fields of the GraphQL type License.
+// The GraphQL type's documentation follows.
+//
+// A repository's open source license
+type getProjectItemsNodesLicense struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLicense.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLicense) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLinkedBranch includes the requested fields of the GraphQL type LinkedBranch.
+// The GraphQL type's documentation follows.
+//
+// A branch linked to an issue.
+type getProjectItemsNodesLinkedBranch struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLinkedBranch.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLinkedBranch) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesLockedEvent includes the requested fields of the GraphQL type LockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'locked' event on a given issue or pull request.
+type getProjectItemsNodesLockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesLockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesLockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type getProjectItemsNodesMannequin struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMannequin) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMarkedAsDuplicateEvent includes the requested fields of the GraphQL type MarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'marked_as_duplicate' event on a given issue or pull request.
+type getProjectItemsNodesMarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMarketplaceCategory includes the requested fields of the GraphQL type MarketplaceCategory.
+// The GraphQL type's documentation follows.
+//
+// A public description of a Marketplace category.
+type getProjectItemsNodesMarketplaceCategory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMarketplaceCategory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMarketplaceCategory) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMarketplaceListing includes the requested fields of the GraphQL type MarketplaceListing.
+// The GraphQL type's documentation follows.
+//
+// A listing in the GitHub integration marketplace.
+type getProjectItemsNodesMarketplaceListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMarketplaceListing.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMarketplaceListing) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMemberFeatureRequestNotification includes the requested fields of the GraphQL type MemberFeatureRequestNotification.
+// The GraphQL type's documentation follows.
+//
+// Represents a member feature request notification
+type getProjectItemsNodesMemberFeatureRequestNotification struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMemberFeatureRequestNotification.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMemberFeatureRequestNotification) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesMembersCanDeleteReposClearAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposClearAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.clear event.
+type getProjectItemsNodesMembersCanDeleteReposClearAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMembersCanDeleteReposClearAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMembersCanDeleteReposClearAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.disable event.
+type getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry includes the requested fields of the GraphQL type MembersCanDeleteReposEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a members_can_delete_repos.enable event.
+type getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesMentionedEvent includes the requested fields of the GraphQL type MentionedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'mentioned' event on a given issue or pull request.
+type getProjectItemsNodesMentionedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMentionedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMentionedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMergeQueue includes the requested fields of the GraphQL type MergeQueue.
+// The GraphQL type's documentation follows.
+//
+// The queue of pull request entries to be merged into a protected branch in a repository.
+type getProjectItemsNodesMergeQueue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMergeQueue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMergeQueue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMergeQueueEntry includes the requested fields of the GraphQL type MergeQueueEntry.
+// The GraphQL type's documentation follows.
+//
+// Entries in a MergeQueue
+type getProjectItemsNodesMergeQueueEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMergeQueueEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMergeQueueEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMergedEvent includes the requested fields of the GraphQL type MergedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'merged' event on a given pull request.
+type getProjectItemsNodesMergedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMergedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMergedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMigrationSource includes the requested fields of the GraphQL type MigrationSource.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) migration source.
+type getProjectItemsNodesMigrationSource struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMigrationSource.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMigrationSource) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMilestone includes the requested fields of the GraphQL type Milestone.
+// The GraphQL type's documentation follows.
+//
+// Represents a Milestone object on a given repository.
+type getProjectItemsNodesMilestone struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMilestone.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMilestone) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMilestonedEvent includes the requested fields of the GraphQL type MilestonedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'milestoned' event on a given issue or pull request.
+type getProjectItemsNodesMilestonedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMilestonedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMilestonedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesMovedColumnsInProjectEvent includes the requested fields of the GraphQL type MovedColumnsInProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'moved_columns_in_project' event on a given issue or pull request.
+type getProjectItemsNodesMovedColumnsInProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesMovedColumnsInProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesMovedColumnsInProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesNode includes the requested fields of the GraphQL interface Node.
+//
+// getProjectItemsNodesNode is implemented by the following types:
+// getProjectItemsNodesAddedToMergeQueueEvent
+// getProjectItemsNodesAddedToProjectEvent
+// getProjectItemsNodesApp
+// getProjectItemsNodesAssignedEvent
+// getProjectItemsNodesAutoMergeDisabledEvent
+// getProjectItemsNodesAutoMergeEnabledEvent
+// getProjectItemsNodesAutoRebaseEnabledEvent
+// getProjectItemsNodesAutoSquashEnabledEvent
+// getProjectItemsNodesAutomaticBaseChangeFailedEvent
+// getProjectItemsNodesAutomaticBaseChangeSucceededEvent
+// getProjectItemsNodesBaseRefChangedEvent
+// getProjectItemsNodesBaseRefDeletedEvent
+// getProjectItemsNodesBaseRefForcePushedEvent
+// getProjectItemsNodesBlob
+// getProjectItemsNodesBot
+// getProjectItemsNodesBranchProtectionRule
+// getProjectItemsNodesBypassForcePushAllowance
+// getProjectItemsNodesBypassPullRequestAllowance
+// getProjectItemsNodesCWE
+// getProjectItemsNodesCheckRun
+// getProjectItemsNodesCheckSuite
+// getProjectItemsNodesClosedEvent
+// getProjectItemsNodesCodeOfConduct
+// getProjectItemsNodesCommentDeletedEvent
+// getProjectItemsNodesCommit
+// getProjectItemsNodesCommitComment
+// getProjectItemsNodesCommitCommentThread
+// getProjectItemsNodesComparison
+// getProjectItemsNodesConnectedEvent
+// getProjectItemsNodesConvertToDraftEvent
+// getProjectItemsNodesConvertedNoteToIssueEvent
+// getProjectItemsNodesConvertedToDiscussionEvent
+// getProjectItemsNodesCrossReferencedEvent
+// getProjectItemsNodesDemilestonedEvent
+// getProjectItemsNodesDependencyGraphManifest
+// getProjectItemsNodesDeployKey
+// getProjectItemsNodesDeployedEvent
+// getProjectItemsNodesDeployment
+// getProjectItemsNodesDeploymentEnvironmentChangedEvent
+// getProjectItemsNodesDeploymentReview
+// getProjectItemsNodesDeploymentStatus
+// getProjectItemsNodesDisconnectedEvent
+// getProjectItemsNodesDiscussion
+// getProjectItemsNodesDiscussionCategory
+// getProjectItemsNodesDiscussionComment
+// getProjectItemsNodesDiscussionPoll
+// getProjectItemsNodesDiscussionPollOption
+// getProjectItemsNodesDraftIssue
+// getProjectItemsNodesEnterprise
+// getProjectItemsNodesEnterpriseAdministratorInvitation
+// getProjectItemsNodesEnterpriseIdentityProvider
+// getProjectItemsNodesEnterpriseRepositoryInfo
+// getProjectItemsNodesEnterpriseServerInstallation
+// getProjectItemsNodesEnterpriseServerUserAccount
+// getProjectItemsNodesEnterpriseServerUserAccountEmail
+// getProjectItemsNodesEnterpriseServerUserAccountsUpload
+// getProjectItemsNodesEnterpriseUserAccount
+// getProjectItemsNodesEnvironment
+// getProjectItemsNodesExternalIdentity
+// getProjectItemsNodesGist
+// getProjectItemsNodesGistComment
+// getProjectItemsNodesHeadRefDeletedEvent
+// getProjectItemsNodesHeadRefForcePushedEvent
+// getProjectItemsNodesHeadRefRestoredEvent
+// getProjectItemsNodesIpAllowListEntry
+// getProjectItemsNodesIssue
+// getProjectItemsNodesIssueComment
+// getProjectItemsNodesLabel
+// getProjectItemsNodesLabeledEvent
+// getProjectItemsNodesLanguage
+// getProjectItemsNodesLicense
+// getProjectItemsNodesLinkedBranch
+// getProjectItemsNodesLockedEvent
+// getProjectItemsNodesMannequin
+// getProjectItemsNodesMarkedAsDuplicateEvent
+// getProjectItemsNodesMarketplaceCategory
+// getProjectItemsNodesMarketplaceListing
+// getProjectItemsNodesMemberFeatureRequestNotification
+// getProjectItemsNodesMembersCanDeleteReposClearAuditEntry
+// getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry
+// getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry
+// getProjectItemsNodesMentionedEvent
+// getProjectItemsNodesMergeQueue
+// getProjectItemsNodesMergeQueueEntry
+// getProjectItemsNodesMergedEvent
+// getProjectItemsNodesMigrationSource
+// getProjectItemsNodesMilestone
+// getProjectItemsNodesMilestonedEvent
+// getProjectItemsNodesMovedColumnsInProjectEvent
+// getProjectItemsNodesOIDCProvider
+// getProjectItemsNodesOauthApplicationCreateAuditEntry
+// getProjectItemsNodesOrgAddBillingManagerAuditEntry
+// getProjectItemsNodesOrgAddMemberAuditEntry
+// getProjectItemsNodesOrgBlockUserAuditEntry
+// getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry
+// getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry
+// getProjectItemsNodesOrgCreateAuditEntry
+// getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry
+// getProjectItemsNodesOrgDisableSamlAuditEntry
+// getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry
+// getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry
+// getProjectItemsNodesOrgEnableSamlAuditEntry
+// getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry
+// getProjectItemsNodesOrgInviteMemberAuditEntry
+// getProjectItemsNodesOrgInviteToBusinessAuditEntry
+// getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry
+// getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry
+// getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry
+// getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry
+// getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry
+// getProjectItemsNodesOrgRemoveBillingManagerAuditEntry
+// getProjectItemsNodesOrgRemoveMemberAuditEntry
+// getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry
+// getProjectItemsNodesOrgRestoreMemberAuditEntry
+// getProjectItemsNodesOrgUnblockUserAuditEntry
+// getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry
+// getProjectItemsNodesOrgUpdateMemberAuditEntry
+// getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+// getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+// getProjectItemsNodesOrganization
+// getProjectItemsNodesOrganizationIdentityProvider
+// getProjectItemsNodesOrganizationInvitation
+// getProjectItemsNodesOrganizationMigration
+// getProjectItemsNodesPackage
+// getProjectItemsNodesPackageFile
+// getProjectItemsNodesPackageTag
+// getProjectItemsNodesPackageVersion
+// getProjectItemsNodesPinnedDiscussion
+// getProjectItemsNodesPinnedEnvironment
+// getProjectItemsNodesPinnedEvent
+// getProjectItemsNodesPinnedIssue
+// getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry
+// getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry
+// getProjectItemsNodesProject
+// getProjectItemsNodesProjectCard
+// getProjectItemsNodesProjectColumn
+// getProjectItemsNodesProjectV2
+// getProjectItemsNodesProjectV2Field
+// getProjectItemsNodesProjectV2Item
+// getProjectItemsNodesProjectV2ItemFieldDateValue
+// getProjectItemsNodesProjectV2ItemFieldIterationValue
+// getProjectItemsNodesProjectV2ItemFieldNumberValue
+// getProjectItemsNodesProjectV2ItemFieldSingleSelectValue
+// getProjectItemsNodesProjectV2ItemFieldTextValue
+// getProjectItemsNodesProjectV2IterationField
+// getProjectItemsNodesProjectV2SingleSelectField
+// getProjectItemsNodesProjectV2View
+// getProjectItemsNodesProjectV2Workflow
+// getProjectItemsNodesPublicKey
+// getProjectItemsNodesPullRequest
+// getProjectItemsNodesPullRequestCommit
+// getProjectItemsNodesPullRequestCommitCommentThread
+// getProjectItemsNodesPullRequestReview
+// getProjectItemsNodesPullRequestReviewComment
+// getProjectItemsNodesPullRequestReviewThread
+// getProjectItemsNodesPullRequestThread
+// getProjectItemsNodesPush
+// getProjectItemsNodesPushAllowance
+// getProjectItemsNodesReaction
+// getProjectItemsNodesReadyForReviewEvent
+// getProjectItemsNodesRef
+// getProjectItemsNodesReferencedEvent
+// getProjectItemsNodesRelease
+// getProjectItemsNodesReleaseAsset
+// getProjectItemsNodesRemovedFromMergeQueueEvent
+// getProjectItemsNodesRemovedFromProjectEvent
+// getProjectItemsNodesRenamedTitleEvent
+// getProjectItemsNodesReopenedEvent
+// getProjectItemsNodesRepoAccessAuditEntry
+// getProjectItemsNodesRepoAddMemberAuditEntry
+// getProjectItemsNodesRepoAddTopicAuditEntry
+// getProjectItemsNodesRepoArchivedAuditEntry
+// getProjectItemsNodesRepoChangeMergeSettingAuditEntry
+// getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry
+// getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry
+// getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry
+// getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry
+// getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry
+// getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry
+// getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry
+// getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry
+// getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry
+// getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry
+// getProjectItemsNodesRepoCreateAuditEntry
+// getProjectItemsNodesRepoDestroyAuditEntry
+// getProjectItemsNodesRepoRemoveMemberAuditEntry
+// getProjectItemsNodesRepoRemoveTopicAuditEntry
+// getProjectItemsNodesRepository
+// getProjectItemsNodesRepositoryInvitation
+// getProjectItemsNodesRepositoryMigration
+// getProjectItemsNodesRepositoryRule
+// getProjectItemsNodesRepositoryRuleset
+// getProjectItemsNodesRepositoryRulesetBypassActor
+// getProjectItemsNodesRepositoryTopic
+// getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry
+// getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry
+// getProjectItemsNodesRepositoryVulnerabilityAlert
+// getProjectItemsNodesReviewDismissalAllowance
+// getProjectItemsNodesReviewDismissedEvent
+// getProjectItemsNodesReviewRequest
+// getProjectItemsNodesReviewRequestRemovedEvent
+// getProjectItemsNodesReviewRequestedEvent
+// getProjectItemsNodesSavedReply
+// getProjectItemsNodesSecurityAdvisory
+// getProjectItemsNodesSponsorsActivity
+// getProjectItemsNodesSponsorsListing
+// getProjectItemsNodesSponsorsListingFeaturedItem
+// getProjectItemsNodesSponsorsTier
+// getProjectItemsNodesSponsorship
+// getProjectItemsNodesSponsorshipNewsletter
+// getProjectItemsNodesStatus
+// getProjectItemsNodesStatusCheckRollup
+// getProjectItemsNodesStatusContext
+// getProjectItemsNodesSubscribedEvent
+// getProjectItemsNodesTag
+// getProjectItemsNodesTeam
+// getProjectItemsNodesTeamAddMemberAuditEntry
+// getProjectItemsNodesTeamAddRepositoryAuditEntry
+// getProjectItemsNodesTeamChangeParentTeamAuditEntry
+// getProjectItemsNodesTeamDiscussion
+// getProjectItemsNodesTeamDiscussionComment
+// getProjectItemsNodesTeamRemoveMemberAuditEntry
+// getProjectItemsNodesTeamRemoveRepositoryAuditEntry
+// getProjectItemsNodesTopic
+// getProjectItemsNodesTransferredEvent
+// getProjectItemsNodesTree
+// getProjectItemsNodesUnassignedEvent
+// getProjectItemsNodesUnlabeledEvent
+// getProjectItemsNodesUnlockedEvent
+// getProjectItemsNodesUnmarkedAsDuplicateEvent
+// getProjectItemsNodesUnpinnedEvent
+// getProjectItemsNodesUnsubscribedEvent
+// getProjectItemsNodesUser
+// getProjectItemsNodesUserBlockedEvent
+// getProjectItemsNodesUserContentEdit
+// getProjectItemsNodesUserList
+// getProjectItemsNodesUserStatus
+// getProjectItemsNodesVerifiableDomain
+// getProjectItemsNodesWorkflow
+// getProjectItemsNodesWorkflowRun
+// getProjectItemsNodesWorkflowRunFile
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+type getProjectItemsNodesNode interface {
+	implementsGraphQLInterfacegetProjectItemsNodesNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getProjectItemsNodesAddedToMergeQueueEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAddedToProjectEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesApp) implementsGraphQLInterfacegetProjectItemsNodesNode()           {}
+func (v *getProjectItemsNodesAssignedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesAutoMergeDisabledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAutoMergeEnabledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAutoRebaseEnabledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAutoSquashEnabledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAutomaticBaseChangeFailedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesAutomaticBaseChangeSucceededEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBaseRefChangedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBaseRefDeletedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBaseRefForcePushedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBlob) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesBot) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesBranchProtectionRule) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBypassForcePushAllowance) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesBypassPullRequestAllowance) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesCWE) implementsGraphQLInterfacegetProjectItemsNodesNode()           {}
+func (v *getProjectItemsNodesCheckRun) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesCheckSuite) implementsGraphQLInterfacegetProjectItemsNodesNode()    {}
+func (v *getProjectItemsNodesClosedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()   {}
+func (v *getProjectItemsNodesCodeOfConduct) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesCommentDeletedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesCommit) implementsGraphQLInterfacegetProjectItemsNodesNode()        {}
+func (v *getProjectItemsNodesCommitComment) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesCommitCommentThread) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesComparison) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesConnectedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesConvertToDraftEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesConvertedNoteToIssueEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesConvertedToDiscussionEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesCrossReferencedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDemilestonedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDependencyGraphManifest) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDeployKey) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesDeployedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesDeployment) implementsGraphQLInterfacegetProjectItemsNodesNode()    {}
+func (v *getProjectItemsNodesDeploymentEnvironmentChangedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDeploymentReview) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesDeploymentStatus) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesDisconnectedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDiscussion) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesDiscussionCategory) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDiscussionComment) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDiscussionPoll) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesDiscussionPollOption) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesDraftIssue) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesEnterprise) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesEnterpriseAdministratorInvitation) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseIdentityProvider) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseRepositoryInfo) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseServerInstallation) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseServerUserAccount) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseServerUserAccountEmail) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseServerUserAccountsUpload) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnterpriseUserAccount) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesEnvironment) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesExternalIdentity) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesGist) implementsGraphQLInterfacegetProjectItemsNodesNode()             {}
+func (v *getProjectItemsNodesGistComment) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesHeadRefDeletedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesHeadRefForcePushedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesHeadRefRestoredEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesIpAllowListEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesIssue) implementsGraphQLInterfacegetProjectItemsNodesNode()            {}
+func (v *getProjectItemsNodesIssueComment) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesLabel) implementsGraphQLInterfacegetProjectItemsNodesNode()            {}
+func (v *getProjectItemsNodesLabeledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesLanguage) implementsGraphQLInterfacegetProjectItemsNodesNode()         {}
+func (v *getProjectItemsNodesLicense) implementsGraphQLInterfacegetProjectItemsNodesNode()          {}
+func (v *getProjectItemsNodesLinkedBranch) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesLockedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesMannequin) implementsGraphQLInterfacegetProjectItemsNodesNode()        {}
+func (v *getProjectItemsNodesMarkedAsDuplicateEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMarketplaceCategory) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMarketplaceListing) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMemberFeatureRequestNotification) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMembersCanDeleteReposClearAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesMentionedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesMergeQueue) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesMergeQueueEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesMergedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()     {}
+func (v *getProjectItemsNodesMigrationSource) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesMilestone) implementsGraphQLInterfacegetProjectItemsNodesNode()       {}
+func (v *getProjectItemsNodesMilestonedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesMovedColumnsInProjectEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOIDCProvider) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesOauthApplicationCreateAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgAddBillingManagerAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgBlockUserAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgCreateAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgDisableSamlAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgEnableSamlAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgInviteMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgInviteToBusinessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgRemoveBillingManagerAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgRestoreMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgUnblockUserAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgUpdateMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrganization) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesOrganizationIdentityProvider) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrganizationInvitation) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesOrganizationMigration) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPackage) implementsGraphQLInterfacegetProjectItemsNodesNode()          {}
+func (v *getProjectItemsNodesPackageFile) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesPackageTag) implementsGraphQLInterfacegetProjectItemsNodesNode()       {}
+func (v *getProjectItemsNodesPackageVersion) implementsGraphQLInterfacegetProjectItemsNodesNode()   {}
+func (v *getProjectItemsNodesPinnedDiscussion) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesPinnedEnvironment) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPinnedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesPinnedIssue) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProject) implementsGraphQLInterfacegetProjectItemsNodesNode()        {}
+func (v *getProjectItemsNodesProjectCard) implementsGraphQLInterfacegetProjectItemsNodesNode()    {}
+func (v *getProjectItemsNodesProjectColumn) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesProjectV2) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesProjectV2Field) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesProjectV2Item) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesProjectV2ItemFieldDateValue) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2ItemFieldIterationValue) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2ItemFieldNumberValue) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2ItemFieldSingleSelectValue) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2ItemFieldTextValue) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2IterationField) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2SingleSelectField) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesProjectV2View) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesProjectV2Workflow) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPublicKey) implementsGraphQLInterfacegetProjectItemsNodesNode()   {}
+func (v *getProjectItemsNodesPullRequest) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesPullRequestCommit) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPullRequestCommitCommentThread) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPullRequestReview) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPullRequestReviewComment) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPullRequestReviewThread) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPullRequestThread) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesPush) implementsGraphQLInterfacegetProjectItemsNodesNode()          {}
+func (v *getProjectItemsNodesPushAllowance) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesReaction) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesReadyForReviewEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRef) implementsGraphQLInterfacegetProjectItemsNodesNode()             {}
+func (v *getProjectItemsNodesReferencedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesRelease) implementsGraphQLInterfacegetProjectItemsNodesNode()         {}
+func (v *getProjectItemsNodesReleaseAsset) implementsGraphQLInterfacegetProjectItemsNodesNode()    {}
+func (v *getProjectItemsNodesRemovedFromMergeQueueEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRemovedFromProjectEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRenamedTitleEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesReopenedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesRepoAccessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoAddTopicAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoArchivedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoChangeMergeSettingAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoCreateAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoDestroyAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepoRemoveTopicAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepository) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesRepositoryInvitation) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryMigration) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryRule) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesRepositoryRuleset) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryRulesetBypassActor) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryTopic) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesRepositoryVulnerabilityAlert) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesReviewDismissalAllowance) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesReviewDismissedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesReviewRequest) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesReviewRequestRemovedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesReviewRequestedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesSavedReply) implementsGraphQLInterfacegetProjectItemsNodesNode()       {}
+func (v *getProjectItemsNodesSecurityAdvisory) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesSponsorsActivity) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesSponsorsListing) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesSponsorsListingFeaturedItem) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesSponsorsTier) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesSponsorship) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesSponsorshipNewsletter) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesStatus) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesStatusCheckRollup) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesStatusContext) implementsGraphQLInterfacegetProjectItemsNodesNode()   {}
+func (v *getProjectItemsNodesSubscribedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesTag) implementsGraphQLInterfacegetProjectItemsNodesNode()             {}
+func (v *getProjectItemsNodesTeam) implementsGraphQLInterfacegetProjectItemsNodesNode()            {}
+func (v *getProjectItemsNodesTeamAddMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTeamAddRepositoryAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTeamChangeParentTeamAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTeamDiscussion) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesTeamDiscussionComment) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTeamRemoveMemberAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTeamRemoveRepositoryAuditEntry) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesTopic) implementsGraphQLInterfacegetProjectItemsNodesNode()            {}
+func (v *getProjectItemsNodesTransferredEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesTree) implementsGraphQLInterfacegetProjectItemsNodesNode()             {}
+func (v *getProjectItemsNodesUnassignedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesUnlabeledEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()   {}
+func (v *getProjectItemsNodesUnlockedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode()    {}
+func (v *getProjectItemsNodesUnmarkedAsDuplicateEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesUnpinnedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesUnsubscribedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {
+}
+func (v *getProjectItemsNodesUser) implementsGraphQLInterfacegetProjectItemsNodesNode()             {}
+func (v *getProjectItemsNodesUserBlockedEvent) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesUserContentEdit) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+func (v *getProjectItemsNodesUserList) implementsGraphQLInterfacegetProjectItemsNodesNode()         {}
+func (v *getProjectItemsNodesUserStatus) implementsGraphQLInterfacegetProjectItemsNodesNode()       {}
+func (v *getProjectItemsNodesVerifiableDomain) implementsGraphQLInterfacegetProjectItemsNodesNode() {}
+func (v *getProjectItemsNodesWorkflow) implementsGraphQLInterfacegetProjectItemsNodesNode()         {}
+func (v *getProjectItemsNodesWorkflowRun) implementsGraphQLInterfacegetProjectItemsNodesNode()      {}
+func (v *getProjectItemsNodesWorkflowRunFile) implementsGraphQLInterfacegetProjectItemsNodesNode()  {}
+
+func __unmarshalgetProjectItemsNodesNode(b []byte, v *getProjectItemsNodesNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AddedToMergeQueueEvent":
+		*v = new(getProjectItemsNodesAddedToMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "AddedToProjectEvent":
+		*v = new(getProjectItemsNodesAddedToProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(getProjectItemsNodesApp)
+		return json.Unmarshal(b, *v)
+	case "AssignedEvent":
+		*v = new(getProjectItemsNodesAssignedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeDisabledEvent":
+		*v = new(getProjectItemsNodesAutoMergeDisabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoMergeEnabledEvent":
+		*v = new(getProjectItemsNodesAutoMergeEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoRebaseEnabledEvent":
+		*v = new(getProjectItemsNodesAutoRebaseEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutoSquashEnabledEvent":
+		*v = new(getProjectItemsNodesAutoSquashEnabledEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeFailedEvent":
+		*v = new(getProjectItemsNodesAutomaticBaseChangeFailedEvent)
+		return json.Unmarshal(b, *v)
+	case "AutomaticBaseChangeSucceededEvent":
+		*v = new(getProjectItemsNodesAutomaticBaseChangeSucceededEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefChangedEvent":
+		*v = new(getProjectItemsNodesBaseRefChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefDeletedEvent":
+		*v = new(getProjectItemsNodesBaseRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "BaseRefForcePushedEvent":
+		*v = new(getProjectItemsNodesBaseRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "Blob":
+		*v = new(getProjectItemsNodesBlob)
+		return json.Unmarshal(b, *v)
+	case "Bot":
+		*v = new(getProjectItemsNodesBot)
+		return json.Unmarshal(b, *v)
+	case "BranchProtectionRule":
+		*v = new(getProjectItemsNodesBranchProtectionRule)
+		return json.Unmarshal(b, *v)
+	case "BypassForcePushAllowance":
+		*v = new(getProjectItemsNodesBypassForcePushAllowance)
+		return json.Unmarshal(b, *v)
+	case "BypassPullRequestAllowance":
+		*v = new(getProjectItemsNodesBypassPullRequestAllowance)
+		return json.Unmarshal(b, *v)
+	case "CWE":
+		*v = new(getProjectItemsNodesCWE)
+		return json.Unmarshal(b, *v)
+	case "CheckRun":
+		*v = new(getProjectItemsNodesCheckRun)
+		return json.Unmarshal(b, *v)
+	case "CheckSuite":
+		*v = new(getProjectItemsNodesCheckSuite)
+		return json.Unmarshal(b, *v)
+	case "ClosedEvent":
+		*v = new(getProjectItemsNodesClosedEvent)
+		return json.Unmarshal(b, *v)
+	case "CodeOfConduct":
+		*v = new(getProjectItemsNodesCodeOfConduct)
+		return json.Unmarshal(b, *v)
+	case "CommentDeletedEvent":
+		*v = new(getProjectItemsNodesCommentDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getProjectItemsNodesCommit)
+		return json.Unmarshal(b, *v)
+	case "CommitComment":
+		*v = new(getProjectItemsNodesCommitComment)
+		return json.Unmarshal(b, *v)
+	case "CommitCommentThread":
+		*v = new(getProjectItemsNodesCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "Comparison":
+		*v = new(getProjectItemsNodesComparison)
+		return json.Unmarshal(b, *v)
+	case "ConnectedEvent":
+		*v = new(getProjectItemsNodesConnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertToDraftEvent":
+		*v = new(getProjectItemsNodesConvertToDraftEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedNoteToIssueEvent":
+		*v = new(getProjectItemsNodesConvertedNoteToIssueEvent)
+		return json.Unmarshal(b, *v)
+	case "ConvertedToDiscussionEvent":
+		*v = new(getProjectItemsNodesConvertedToDiscussionEvent)
+		return json.Unmarshal(b, *v)
+	case "CrossReferencedEvent":
+		*v = new(getProjectItemsNodesCrossReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "DemilestonedEvent":
+		*v = new(getProjectItemsNodesDemilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "DependencyGraphManifest":
+		*v = new(getProjectItemsNodesDependencyGraphManifest)
+		return json.Unmarshal(b, *v)
+	case "DeployKey":
+		*v = new(getProjectItemsNodesDeployKey)
+		return json.Unmarshal(b, *v)
+	case "DeployedEvent":
+		*v = new(getProjectItemsNodesDeployedEvent)
+		return json.Unmarshal(b, *v)
+	case "Deployment":
+		*v = new(getProjectItemsNodesDeployment)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEnvironmentChangedEvent":
+		*v = new(getProjectItemsNodesDeploymentEnvironmentChangedEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentReview":
+		*v = new(getProjectItemsNodesDeploymentReview)
+		return json.Unmarshal(b, *v)
+	case "DeploymentStatus":
+		*v = new(getProjectItemsNodesDeploymentStatus)
+		return json.Unmarshal(b, *v)
+	case "DisconnectedEvent":
+		*v = new(getProjectItemsNodesDisconnectedEvent)
+		return json.Unmarshal(b, *v)
+	case "Discussion":
+		*v = new(getProjectItemsNodesDiscussion)
+		return json.Unmarshal(b, *v)
+	case "DiscussionCategory":
+		*v = new(getProjectItemsNodesDiscussionCategory)
+		return json.Unmarshal(b, *v)
+	case "DiscussionComment":
+		*v = new(getProjectItemsNodesDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPoll":
+		*v = new(getProjectItemsNodesDiscussionPoll)
+		return json.Unmarshal(b, *v)
+	case "DiscussionPollOption":
+		*v = new(getProjectItemsNodesDiscussionPollOption)
+		return json.Unmarshal(b, *v)
+	case "DraftIssue":
+		*v = new(getProjectItemsNodesDraftIssue)
+		return json.Unmarshal(b, *v)
+	case "Enterprise":
+		*v = new(getProjectItemsNodesEnterprise)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseAdministratorInvitation":
+		*v = new(getProjectItemsNodesEnterpriseAdministratorInvitation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseIdentityProvider":
+		*v = new(getProjectItemsNodesEnterpriseIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseRepositoryInfo":
+		*v = new(getProjectItemsNodesEnterpriseRepositoryInfo)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerInstallation":
+		*v = new(getProjectItemsNodesEnterpriseServerInstallation)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccount":
+		*v = new(getProjectItemsNodesEnterpriseServerUserAccount)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountEmail":
+		*v = new(getProjectItemsNodesEnterpriseServerUserAccountEmail)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseServerUserAccountsUpload":
+		*v = new(getProjectItemsNodesEnterpriseServerUserAccountsUpload)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(getProjectItemsNodesEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Environment":
+		*v = new(getProjectItemsNodesEnvironment)
+		return json.Unmarshal(b, *v)
+	case "ExternalIdentity":
+		*v = new(getProjectItemsNodesExternalIdentity)
+		return json.Unmarshal(b, *v)
+	case "Gist":
+		*v = new(getProjectItemsNodesGist)
+		return json.Unmarshal(b, *v)
+	case "GistComment":
+		*v = new(getProjectItemsNodesGistComment)
+		return json.Unmarshal(b, *v)
+	case "HeadRefDeletedEvent":
+		*v = new(getProjectItemsNodesHeadRefDeletedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefForcePushedEvent":
+		*v = new(getProjectItemsNodesHeadRefForcePushedEvent)
+		return json.Unmarshal(b, *v)
+	case "HeadRefRestoredEvent":
+		*v = new(getProjectItemsNodesHeadRefRestoredEvent)
+		return json.Unmarshal(b, *v)
+	case "IpAllowListEntry":
+		*v = new(getProjectItemsNodesIpAllowListEntry)
+		return json.Unmarshal(b, *v)
+	case "Issue":
+		*v = new(getProjectItemsNodesIssue)
+		return json.Unmarshal(b, *v)
+	case "IssueComment":
+		*v = new(getProjectItemsNodesIssueComment)
+		return json.Unmarshal(b, *v)
+	case "Label":
+		*v = new(getProjectItemsNodesLabel)
+		return json.Unmarshal(b, *v)
+	case "LabeledEvent":
+		*v = new(getProjectItemsNodesLabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "Language":
+		*v = new(getProjectItemsNodesLanguage)
+		return json.Unmarshal(b, *v)
+	case "License":
+		*v = new(getProjectItemsNodesLicense)
+		return json.Unmarshal(b, *v)
+	case "LinkedBranch":
+		*v = new(getProjectItemsNodesLinkedBranch)
+		return json.Unmarshal(b, *v)
+	case "LockedEvent":
+		*v = new(getProjectItemsNodesLockedEvent)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(getProjectItemsNodesMannequin)
+		return json.Unmarshal(b, *v)
+	case "MarkedAsDuplicateEvent":
+		*v = new(getProjectItemsNodesMarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceCategory":
+		*v = new(getProjectItemsNodesMarketplaceCategory)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceListing":
+		*v = new(getProjectItemsNodesMarketplaceListing)
+		return json.Unmarshal(b, *v)
+	case "MemberFeatureRequestNotification":
+		*v = new(getProjectItemsNodesMemberFeatureRequestNotification)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposClearAuditEntry":
+		*v = new(getProjectItemsNodesMembersCanDeleteReposClearAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposDisableAuditEntry":
+		*v = new(getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MembersCanDeleteReposEnableAuditEntry":
+		*v = new(getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "MentionedEvent":
+		*v = new(getProjectItemsNodesMentionedEvent)
+		return json.Unmarshal(b, *v)
+	case "MergeQueue":
+		*v = new(getProjectItemsNodesMergeQueue)
+		return json.Unmarshal(b, *v)
+	case "MergeQueueEntry":
+		*v = new(getProjectItemsNodesMergeQueueEntry)
+		return json.Unmarshal(b, *v)
+	case "MergedEvent":
+		*v = new(getProjectItemsNodesMergedEvent)
+		return json.Unmarshal(b, *v)
+	case "MigrationSource":
+		*v = new(getProjectItemsNodesMigrationSource)
+		return json.Unmarshal(b, *v)
+	case "Milestone":
+		*v = new(getProjectItemsNodesMilestone)
+		return json.Unmarshal(b, *v)
+	case "MilestonedEvent":
+		*v = new(getProjectItemsNodesMilestonedEvent)
+		return json.Unmarshal(b, *v)
+	case "MovedColumnsInProjectEvent":
+		*v = new(getProjectItemsNodesMovedColumnsInProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "OIDCProvider":
+		*v = new(getProjectItemsNodesOIDCProvider)
+		return json.Unmarshal(b, *v)
+	case "OauthApplicationCreateAuditEntry":
+		*v = new(getProjectItemsNodesOauthApplicationCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddBillingManagerAuditEntry":
+		*v = new(getProjectItemsNodesOrgAddBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgAddMemberAuditEntry":
+		*v = new(getProjectItemsNodesOrgAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgBlockUserAuditEntry":
+		*v = new(getProjectItemsNodesOrgBlockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgCreateAuditEntry":
+		*v = new(getProjectItemsNodesOrgCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableOauthAppRestrictionsAuditEntry":
+		*v = new(getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableSamlAuditEntry":
+		*v = new(getProjectItemsNodesOrgDisableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgDisableTwoFactorRequirementAuditEntry":
+		*v = new(getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableOauthAppRestrictionsAuditEntry":
+		*v = new(getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableSamlAuditEntry":
+		*v = new(getProjectItemsNodesOrgEnableSamlAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgEnableTwoFactorRequirementAuditEntry":
+		*v = new(getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteMemberAuditEntry":
+		*v = new(getProjectItemsNodesOrgInviteMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgInviteToBusinessAuditEntry":
+		*v = new(getProjectItemsNodesOrgInviteToBusinessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessApprovedAuditEntry":
+		*v = new(getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessBlockedAuditEntry":
+		*v = new(getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessDeniedAuditEntry":
+		*v = new(getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessRequestedAuditEntry":
+		*v = new(getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgOauthAppAccessUnblockedAuditEntry":
+		*v = new(getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveBillingManagerAuditEntry":
+		*v = new(getProjectItemsNodesOrgRemoveBillingManagerAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveMemberAuditEntry":
+		*v = new(getProjectItemsNodesOrgRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRemoveOutsideCollaboratorAuditEntry":
+		*v = new(getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgRestoreMemberAuditEntry":
+		*v = new(getProjectItemsNodesOrgRestoreMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUnblockUserAuditEntry":
+		*v = new(getProjectItemsNodesOrgUnblockUserAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateDefaultRepositoryPermissionAuditEntry":
+		*v = new(getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberAuditEntry":
+		*v = new(getProjectItemsNodesOrgUpdateMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryCreationPermissionAuditEntry":
+		*v = new(getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry":
+		*v = new(getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(getProjectItemsNodesOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationIdentityProvider":
+		*v = new(getProjectItemsNodesOrganizationIdentityProvider)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(getProjectItemsNodesOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "OrganizationMigration":
+		*v = new(getProjectItemsNodesOrganizationMigration)
+		return json.Unmarshal(b, *v)
+	case "Package":
+		*v = new(getProjectItemsNodesPackage)
+		return json.Unmarshal(b, *v)
+	case "PackageFile":
+		*v = new(getProjectItemsNodesPackageFile)
+		return json.Unmarshal(b, *v)
+	case "PackageTag":
+		*v = new(getProjectItemsNodesPackageTag)
+		return json.Unmarshal(b, *v)
+	case "PackageVersion":
+		*v = new(getProjectItemsNodesPackageVersion)
+		return json.Unmarshal(b, *v)
+	case "PinnedDiscussion":
+		*v = new(getProjectItemsNodesPinnedDiscussion)
+		return json.Unmarshal(b, *v)
+	case "PinnedEnvironment":
+		*v = new(getProjectItemsNodesPinnedEnvironment)
+		return json.Unmarshal(b, *v)
+	case "PinnedEvent":
+		*v = new(getProjectItemsNodesPinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "PinnedIssue":
+		*v = new(getProjectItemsNodesPinnedIssue)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingDisableAuditEntry":
+		*v = new(getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "PrivateRepositoryForkingEnableAuditEntry":
+		*v = new(getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Project":
+		*v = new(getProjectItemsNodesProject)
+		return json.Unmarshal(b, *v)
+	case "ProjectCard":
+		*v = new(getProjectItemsNodesProjectCard)
+		return json.Unmarshal(b, *v)
+	case "ProjectColumn":
+		*v = new(getProjectItemsNodesProjectColumn)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2":
+		*v = new(getProjectItemsNodesProjectV2)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Field":
+		*v = new(getProjectItemsNodesProjectV2Field)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Item":
+		*v = new(getProjectItemsNodesProjectV2Item)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldDateValue":
+		*v = new(getProjectItemsNodesProjectV2ItemFieldDateValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldIterationValue":
+		*v = new(getProjectItemsNodesProjectV2ItemFieldIterationValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldNumberValue":
+		*v = new(getProjectItemsNodesProjectV2ItemFieldNumberValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		*v = new(getProjectItemsNodesProjectV2ItemFieldSingleSelectValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2ItemFieldTextValue":
+		*v = new(getProjectItemsNodesProjectV2ItemFieldTextValue)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2IterationField":
+		*v = new(getProjectItemsNodesProjectV2IterationField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2SingleSelectField":
+		*v = new(getProjectItemsNodesProjectV2SingleSelectField)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2View":
+		*v = new(getProjectItemsNodesProjectV2View)
+		return json.Unmarshal(b, *v)
+	case "ProjectV2Workflow":
+		*v = new(getProjectItemsNodesProjectV2Workflow)
+		return json.Unmarshal(b, *v)
+	case "PublicKey":
+		*v = new(getProjectItemsNodesPublicKey)
+		return json.Unmarshal(b, *v)
+	case "PullRequest":
+		*v = new(getProjectItemsNodesPullRequest)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommit":
+		*v = new(getProjectItemsNodesPullRequestCommit)
+		return json.Unmarshal(b, *v)
+	case "PullRequestCommitCommentThread":
+		*v = new(getProjectItemsNodesPullRequestCommitCommentThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReview":
+		*v = new(getProjectItemsNodesPullRequestReview)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewComment":
+		*v = new(getProjectItemsNodesPullRequestReviewComment)
+		return json.Unmarshal(b, *v)
+	case "PullRequestReviewThread":
+		*v = new(getProjectItemsNodesPullRequestReviewThread)
+		return json.Unmarshal(b, *v)
+	case "PullRequestThread":
+		*v = new(getProjectItemsNodesPullRequestThread)
+		return json.Unmarshal(b, *v)
+	case "Push":
+		*v = new(getProjectItemsNodesPush)
+		return json.Unmarshal(b, *v)
+	case "PushAllowance":
+		*v = new(getProjectItemsNodesPushAllowance)
+		return json.Unmarshal(b, *v)
+	case "Reaction":
+		*v = new(getProjectItemsNodesReaction)
+		return json.Unmarshal(b, *v)
+	case "ReadyForReviewEvent":
+		*v = new(getProjectItemsNodesReadyForReviewEvent)
+		return json.Unmarshal(b, *v)
+	case "Ref":
+		*v = new(getProjectItemsNodesRef)
+		return json.Unmarshal(b, *v)
+	case "ReferencedEvent":
+		*v = new(getProjectItemsNodesReferencedEvent)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(getProjectItemsNodesRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseAsset":
+		*v = new(getProjectItemsNodesReleaseAsset)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromMergeQueueEvent":
+		*v = new(getProjectItemsNodesRemovedFromMergeQueueEvent)
+		return json.Unmarshal(b, *v)
+	case "RemovedFromProjectEvent":
+		*v = new(getProjectItemsNodesRemovedFromProjectEvent)
+		return json.Unmarshal(b, *v)
+	case "RenamedTitleEvent":
+		*v = new(getProjectItemsNodesRenamedTitleEvent)
+		return json.Unmarshal(b, *v)
+	case "ReopenedEvent":
+		*v = new(getProjectItemsNodesReopenedEvent)
+		return json.Unmarshal(b, *v)
+	case "RepoAccessAuditEntry":
+		*v = new(getProjectItemsNodesRepoAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddMemberAuditEntry":
+		*v = new(getProjectItemsNodesRepoAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoAddTopicAuditEntry":
+		*v = new(getProjectItemsNodesRepoAddTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoArchivedAuditEntry":
+		*v = new(getProjectItemsNodesRepoArchivedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoChangeMergeSettingAuditEntry":
+		*v = new(getProjectItemsNodesRepoChangeMergeSettingAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableContributorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigDisableSockpuppetDisallowedAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableCollaboratorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableContributorsOnlyAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigEnableSockpuppetDisallowedAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigLockAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoConfigUnlockAnonymousGitAccessAuditEntry":
+		*v = new(getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoCreateAuditEntry":
+		*v = new(getProjectItemsNodesRepoCreateAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoDestroyAuditEntry":
+		*v = new(getProjectItemsNodesRepoDestroyAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveMemberAuditEntry":
+		*v = new(getProjectItemsNodesRepoRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepoRemoveTopicAuditEntry":
+		*v = new(getProjectItemsNodesRepoRemoveTopicAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Repository":
+		*v = new(getProjectItemsNodesRepository)
+		return json.Unmarshal(b, *v)
+	case "RepositoryInvitation":
+		*v = new(getProjectItemsNodesRepositoryInvitation)
+		return json.Unmarshal(b, *v)
+	case "RepositoryMigration":
+		*v = new(getProjectItemsNodesRepositoryMigration)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRule":
+		*v = new(getProjectItemsNodesRepositoryRule)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRuleset":
+		*v = new(getProjectItemsNodesRepositoryRuleset)
+		return json.Unmarshal(b, *v)
+	case "RepositoryRulesetBypassActor":
+		*v = new(getProjectItemsNodesRepositoryRulesetBypassActor)
+		return json.Unmarshal(b, *v)
+	case "RepositoryTopic":
+		*v = new(getProjectItemsNodesRepositoryTopic)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeDisableAuditEntry":
+		*v = new(getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVisibilityChangeEnableAuditEntry":
+		*v = new(getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "RepositoryVulnerabilityAlert":
+		*v = new(getProjectItemsNodesRepositoryVulnerabilityAlert)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissalAllowance":
+		*v = new(getProjectItemsNodesReviewDismissalAllowance)
+		return json.Unmarshal(b, *v)
+	case "ReviewDismissedEvent":
+		*v = new(getProjectItemsNodesReviewDismissedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequest":
+		*v = new(getProjectItemsNodesReviewRequest)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestRemovedEvent":
+		*v = new(getProjectItemsNodesReviewRequestRemovedEvent)
+		return json.Unmarshal(b, *v)
+	case "ReviewRequestedEvent":
+		*v = new(getProjectItemsNodesReviewRequestedEvent)
+		return json.Unmarshal(b, *v)
+	case "SavedReply":
+		*v = new(getProjectItemsNodesSavedReply)
+		return json.Unmarshal(b, *v)
+	case "SecurityAdvisory":
+		*v = new(getProjectItemsNodesSecurityAdvisory)
+		return json.Unmarshal(b, *v)
+	case "SponsorsActivity":
+		*v = new(getProjectItemsNodesSponsorsActivity)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListing":
+		*v = new(getProjectItemsNodesSponsorsListing)
+		return json.Unmarshal(b, *v)
+	case "SponsorsListingFeaturedItem":
+		*v = new(getProjectItemsNodesSponsorsListingFeaturedItem)
+		return json.Unmarshal(b, *v)
+	case "SponsorsTier":
+		*v = new(getProjectItemsNodesSponsorsTier)
+		return json.Unmarshal(b, *v)
+	case "Sponsorship":
+		*v = new(getProjectItemsNodesSponsorship)
+		return json.Unmarshal(b, *v)
+	case "SponsorshipNewsletter":
+		*v = new(getProjectItemsNodesSponsorshipNewsletter)
+		return json.Unmarshal(b, *v)
+	case "Status":
+		*v = new(getProjectItemsNodesStatus)
+		return json.Unmarshal(b, *v)
+	case "StatusCheckRollup":
+		*v = new(getProjectItemsNodesStatusCheckRollup)
+		return json.Unmarshal(b, *v)
+	case "StatusContext":
+		*v = new(getProjectItemsNodesStatusContext)
+		return json.Unmarshal(b, *v)
+	case "SubscribedEvent":
+		*v = new(getProjectItemsNodesSubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getProjectItemsNodesTag)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(getProjectItemsNodesTeam)
+		return json.Unmarshal(b, *v)
+	case "TeamAddMemberAuditEntry":
+		*v = new(getProjectItemsNodesTeamAddMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamAddRepositoryAuditEntry":
+		*v = new(getProjectItemsNodesTeamAddRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamChangeParentTeamAuditEntry":
+		*v = new(getProjectItemsNodesTeamChangeParentTeamAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussion":
+		*v = new(getProjectItemsNodesTeamDiscussion)
+		return json.Unmarshal(b, *v)
+	case "TeamDiscussionComment":
+		*v = new(getProjectItemsNodesTeamDiscussionComment)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveMemberAuditEntry":
+		*v = new(getProjectItemsNodesTeamRemoveMemberAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "TeamRemoveRepositoryAuditEntry":
+		*v = new(getProjectItemsNodesTeamRemoveRepositoryAuditEntry)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(getProjectItemsNodesTopic)
+		return json.Unmarshal(b, *v)
+	case "TransferredEvent":
+		*v = new(getProjectItemsNodesTransferredEvent)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getProjectItemsNodesTree)
+		return json.Unmarshal(b, *v)
+	case "UnassignedEvent":
+		*v = new(getProjectItemsNodesUnassignedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlabeledEvent":
+		*v = new(getProjectItemsNodesUnlabeledEvent)
+		return json.Unmarshal(b, *v)
+	case "UnlockedEvent":
+		*v = new(getProjectItemsNodesUnlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnmarkedAsDuplicateEvent":
+		*v = new(getProjectItemsNodesUnmarkedAsDuplicateEvent)
+		return json.Unmarshal(b, *v)
+	case "UnpinnedEvent":
+		*v = new(getProjectItemsNodesUnpinnedEvent)
+		return json.Unmarshal(b, *v)
+	case "UnsubscribedEvent":
+		*v = new(getProjectItemsNodesUnsubscribedEvent)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getProjectItemsNodesUser)
+		return json.Unmarshal(b, *v)
+	case "UserBlockedEvent":
+		*v = new(getProjectItemsNodesUserBlockedEvent)
+		return json.Unmarshal(b, *v)
+	case "UserContentEdit":
+		*v = new(getProjectItemsNodesUserContentEdit)
+		return json.Unmarshal(b, *v)
+	case "UserList":
+		*v = new(getProjectItemsNodesUserList)
+		return json.Unmarshal(b, *v)
+	case "UserStatus":
+		*v = new(getProjectItemsNodesUserStatus)
+		return json.Unmarshal(b, *v)
+	case "VerifiableDomain":
+		*v = new(getProjectItemsNodesVerifiableDomain)
+		return json.Unmarshal(b, *v)
+	case "Workflow":
+		*v = new(getProjectItemsNodesWorkflow)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRun":
+		*v = new(getProjectItemsNodesWorkflowRun)
+		return json.Unmarshal(b, *v)
+	case "WorkflowRunFile":
+		*v = new(getProjectItemsNodesWorkflowRunFile)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getProjectItemsNodesNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetProjectItemsNodesNode(v *getProjectItemsNodesNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getProjectItemsNodesAddedToMergeQueueEvent:
+		typename = "AddedToMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAddedToMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAddedToProjectEvent:
+		typename = "AddedToProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAddedToProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesApp:
+		typename = "App"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAssignedEvent:
+		typename = "AssignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAssignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutoMergeDisabledEvent:
+		typename = "AutoMergeDisabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutoMergeDisabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutoMergeEnabledEvent:
+		typename = "AutoMergeEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutoMergeEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutoRebaseEnabledEvent:
+		typename = "AutoRebaseEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutoRebaseEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutoSquashEnabledEvent:
+		typename = "AutoSquashEnabledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutoSquashEnabledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutomaticBaseChangeFailedEvent:
+		typename = "AutomaticBaseChangeFailedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutomaticBaseChangeFailedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesAutomaticBaseChangeSucceededEvent:
+		typename = "AutomaticBaseChangeSucceededEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesAutomaticBaseChangeSucceededEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBaseRefChangedEvent:
+		typename = "BaseRefChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBaseRefChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBaseRefDeletedEvent:
+		typename = "BaseRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBaseRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBaseRefForcePushedEvent:
+		typename = "BaseRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBaseRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBranchProtectionRule:
+		typename = "BranchProtectionRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBranchProtectionRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBypassForcePushAllowance:
+		typename = "BypassForcePushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBypassForcePushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesBypassPullRequestAllowance:
+		typename = "BypassPullRequestAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesBypassPullRequestAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCWE:
+		typename = "CWE"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCWE
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCheckRun:
+		typename = "CheckRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCheckRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCheckSuite:
+		typename = "CheckSuite"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCheckSuite
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesClosedEvent:
+		typename = "ClosedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesClosedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCodeOfConduct:
+		typename = "CodeOfConduct"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCodeOfConduct
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCommentDeletedEvent:
+		typename = "CommentDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCommentDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCommitComment:
+		typename = "CommitComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCommitComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCommitCommentThread:
+		typename = "CommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesComparison:
+		typename = "Comparison"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesComparison
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesConnectedEvent:
+		typename = "ConnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesConnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesConvertToDraftEvent:
+		typename = "ConvertToDraftEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesConvertToDraftEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesConvertedNoteToIssueEvent:
+		typename = "ConvertedNoteToIssueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesConvertedNoteToIssueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesConvertedToDiscussionEvent:
+		typename = "ConvertedToDiscussionEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesConvertedToDiscussionEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesCrossReferencedEvent:
+		typename = "CrossReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesCrossReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDemilestonedEvent:
+		typename = "DemilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDemilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDependencyGraphManifest:
+		typename = "DependencyGraphManifest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDependencyGraphManifest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeployKey:
+		typename = "DeployKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeployKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeployedEvent:
+		typename = "DeployedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeployedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeployment:
+		typename = "Deployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeploymentEnvironmentChangedEvent:
+		typename = "DeploymentEnvironmentChangedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeploymentEnvironmentChangedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeploymentReview:
+		typename = "DeploymentReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeploymentReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDeploymentStatus:
+		typename = "DeploymentStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDeploymentStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDisconnectedEvent:
+		typename = "DisconnectedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDisconnectedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDiscussion:
+		typename = "Discussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDiscussionCategory:
+		typename = "DiscussionCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDiscussionCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDiscussionComment:
+		typename = "DiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDiscussionPoll:
+		typename = "DiscussionPoll"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDiscussionPoll
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDiscussionPollOption:
+		typename = "DiscussionPollOption"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDiscussionPollOption
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesDraftIssue:
+		typename = "DraftIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesDraftIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterprise:
+		typename = "Enterprise"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterprise
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseAdministratorInvitation:
+		typename = "EnterpriseAdministratorInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseAdministratorInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseIdentityProvider:
+		typename = "EnterpriseIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseRepositoryInfo:
+		typename = "EnterpriseRepositoryInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseRepositoryInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseServerInstallation:
+		typename = "EnterpriseServerInstallation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseServerInstallation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseServerUserAccount:
+		typename = "EnterpriseServerUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseServerUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseServerUserAccountEmail:
+		typename = "EnterpriseServerUserAccountEmail"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseServerUserAccountEmail
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseServerUserAccountsUpload:
+		typename = "EnterpriseServerUserAccountsUpload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseServerUserAccountsUpload
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesEnvironment:
+		typename = "Environment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesExternalIdentity:
+		typename = "ExternalIdentity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesExternalIdentity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesGist:
+		typename = "Gist"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesGist
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesGistComment:
+		typename = "GistComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesGistComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesHeadRefDeletedEvent:
+		typename = "HeadRefDeletedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesHeadRefDeletedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesHeadRefForcePushedEvent:
+		typename = "HeadRefForcePushedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesHeadRefForcePushedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesHeadRefRestoredEvent:
+		typename = "HeadRefRestoredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesHeadRefRestoredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesIpAllowListEntry:
+		typename = "IpAllowListEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesIpAllowListEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesIssue:
+		typename = "Issue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesIssueComment:
+		typename = "IssueComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesIssueComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLabel:
+		typename = "Label"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLabel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLabeledEvent:
+		typename = "LabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLanguage:
+		typename = "Language"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLanguage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLicense:
+		typename = "License"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLicense
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLinkedBranch:
+		typename = "LinkedBranch"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLinkedBranch
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesLockedEvent:
+		typename = "LockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesLockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMarkedAsDuplicateEvent:
+		typename = "MarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMarketplaceCategory:
+		typename = "MarketplaceCategory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMarketplaceCategory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMarketplaceListing:
+		typename = "MarketplaceListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMarketplaceListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMemberFeatureRequestNotification:
+		typename = "MemberFeatureRequestNotification"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMemberFeatureRequestNotification
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMembersCanDeleteReposClearAuditEntry:
+		typename = "MembersCanDeleteReposClearAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMembersCanDeleteReposClearAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry:
+		typename = "MembersCanDeleteReposDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMembersCanDeleteReposDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry:
+		typename = "MembersCanDeleteReposEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMembersCanDeleteReposEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMentionedEvent:
+		typename = "MentionedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMentionedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMergeQueue:
+		typename = "MergeQueue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMergeQueue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMergeQueueEntry:
+		typename = "MergeQueueEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMergeQueueEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMergedEvent:
+		typename = "MergedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMergedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMigrationSource:
+		typename = "MigrationSource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMigrationSource
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMilestone:
+		typename = "Milestone"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMilestone
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMilestonedEvent:
+		typename = "MilestonedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMilestonedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesMovedColumnsInProjectEvent:
+		typename = "MovedColumnsInProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesMovedColumnsInProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOIDCProvider:
+		typename = "OIDCProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOIDCProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOauthApplicationCreateAuditEntry:
+		typename = "OauthApplicationCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOauthApplicationCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgAddBillingManagerAuditEntry:
+		typename = "OrgAddBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgAddBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgAddMemberAuditEntry:
+		typename = "OrgAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgBlockUserAuditEntry:
+		typename = "OrgBlockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgBlockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "OrgConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgCreateAuditEntry:
+		typename = "OrgCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry:
+		typename = "OrgDisableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgDisableSamlAuditEntry:
+		typename = "OrgDisableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgDisableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry:
+		typename = "OrgDisableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry:
+		typename = "OrgEnableOauthAppRestrictionsAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgEnableSamlAuditEntry:
+		typename = "OrgEnableSamlAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgEnableSamlAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry:
+		typename = "OrgEnableTwoFactorRequirementAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgInviteMemberAuditEntry:
+		typename = "OrgInviteMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgInviteMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgInviteToBusinessAuditEntry:
+		typename = "OrgInviteToBusinessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgInviteToBusinessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry:
+		typename = "OrgOauthAppAccessApprovedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry:
+		typename = "OrgOauthAppAccessBlockedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry:
+		typename = "OrgOauthAppAccessDeniedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry:
+		typename = "OrgOauthAppAccessRequestedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry:
+		typename = "OrgOauthAppAccessUnblockedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgRemoveBillingManagerAuditEntry:
+		typename = "OrgRemoveBillingManagerAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgRemoveBillingManagerAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgRemoveMemberAuditEntry:
+		typename = "OrgRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry:
+		typename = "OrgRemoveOutsideCollaboratorAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgRestoreMemberAuditEntry:
+		typename = "OrgRestoreMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgRestoreMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgUnblockUserAuditEntry:
+		typename = "OrgUnblockUserAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgUnblockUserAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry:
+		typename = "OrgUpdateDefaultRepositoryPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgUpdateMemberAuditEntry:
+		typename = "OrgUpdateMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgUpdateMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryCreationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry:
+		typename = "OrgUpdateMemberRepositoryInvitationPermissionAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrganizationIdentityProvider:
+		typename = "OrganizationIdentityProvider"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrganizationIdentityProvider
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesOrganizationMigration:
+		typename = "OrganizationMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesOrganizationMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPackage:
+		typename = "Package"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPackage
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPackageFile:
+		typename = "PackageFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPackageFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPackageTag:
+		typename = "PackageTag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPackageTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPackageVersion:
+		typename = "PackageVersion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPackageVersion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPinnedDiscussion:
+		typename = "PinnedDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPinnedDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPinnedEnvironment:
+		typename = "PinnedEnvironment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPinnedEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPinnedEvent:
+		typename = "PinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPinnedIssue:
+		typename = "PinnedIssue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPinnedIssue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry:
+		typename = "PrivateRepositoryForkingDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry:
+		typename = "PrivateRepositoryForkingEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProject:
+		typename = "Project"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProject
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectCard:
+		typename = "ProjectCard"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectCard
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectColumn:
+		typename = "ProjectColumn"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectColumn
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2:
+		typename = "ProjectV2"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2Field:
+		typename = "ProjectV2Field"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2Field
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2Item:
+		typename = "ProjectV2Item"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetProjectItemsNodesProjectV2Item
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2ItemFieldDateValue:
+		typename = "ProjectV2ItemFieldDateValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2ItemFieldDateValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2ItemFieldIterationValue:
+		typename = "ProjectV2ItemFieldIterationValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2ItemFieldIterationValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2ItemFieldNumberValue:
+		typename = "ProjectV2ItemFieldNumberValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2ItemFieldNumberValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2ItemFieldSingleSelectValue:
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2ItemFieldSingleSelectValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2ItemFieldTextValue:
+		typename = "ProjectV2ItemFieldTextValue"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2ItemFieldTextValue
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2IterationField:
+		typename = "ProjectV2IterationField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2IterationField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2SingleSelectField:
+		typename = "ProjectV2SingleSelectField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2SingleSelectField
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2View:
+		typename = "ProjectV2View"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2View
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesProjectV2Workflow:
+		typename = "ProjectV2Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesProjectV2Workflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPublicKey:
+		typename = "PublicKey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPublicKey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequest:
+		typename = "PullRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestCommit:
+		typename = "PullRequestCommit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestCommitCommentThread:
+		typename = "PullRequestCommitCommentThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestCommitCommentThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestReview:
+		typename = "PullRequestReview"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestReview
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestReviewComment:
+		typename = "PullRequestReviewComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestReviewComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestReviewThread:
+		typename = "PullRequestReviewThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestReviewThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPullRequestThread:
+		typename = "PullRequestThread"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPullRequestThread
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPush:
+		typename = "Push"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPush
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesPushAllowance:
+		typename = "PushAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesPushAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReaction:
+		typename = "Reaction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReaction
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReadyForReviewEvent:
+		typename = "ReadyForReviewEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReadyForReviewEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRef:
+		typename = "Ref"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRef
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReferencedEvent:
+		typename = "ReferencedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReferencedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReleaseAsset:
+		typename = "ReleaseAsset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReleaseAsset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRemovedFromMergeQueueEvent:
+		typename = "RemovedFromMergeQueueEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRemovedFromMergeQueueEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRemovedFromProjectEvent:
+		typename = "RemovedFromProjectEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRemovedFromProjectEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRenamedTitleEvent:
+		typename = "RenamedTitleEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRenamedTitleEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReopenedEvent:
+		typename = "ReopenedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReopenedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoAccessAuditEntry:
+		typename = "RepoAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoAddMemberAuditEntry:
+		typename = "RepoAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoAddTopicAuditEntry:
+		typename = "RepoAddTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoAddTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoArchivedAuditEntry:
+		typename = "RepoArchivedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoArchivedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoChangeMergeSettingAuditEntry:
+		typename = "RepoChangeMergeSettingAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoChangeMergeSettingAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigDisableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigDisableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry:
+		typename = "RepoConfigDisableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigDisableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigEnableAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry:
+		typename = "RepoConfigEnableCollaboratorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry:
+		typename = "RepoConfigEnableContributorsOnlyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry:
+		typename = "RepoConfigEnableSockpuppetDisallowedAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigLockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry:
+		typename = "RepoConfigUnlockAnonymousGitAccessAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoCreateAuditEntry:
+		typename = "RepoCreateAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoCreateAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoDestroyAuditEntry:
+		typename = "RepoDestroyAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoDestroyAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoRemoveMemberAuditEntry:
+		typename = "RepoRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepoRemoveTopicAuditEntry:
+		typename = "RepoRemoveTopicAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepoRemoveTopicAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepository:
+		typename = "Repository"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepository
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryInvitation:
+		typename = "RepositoryInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryMigration:
+		typename = "RepositoryMigration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryMigration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryRule:
+		typename = "RepositoryRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryRuleset:
+		typename = "RepositoryRuleset"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryRuleset
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryRulesetBypassActor:
+		typename = "RepositoryRulesetBypassActor"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryRulesetBypassActor
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryTopic:
+		typename = "RepositoryTopic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry:
+		typename = "RepositoryVisibilityChangeDisableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry:
+		typename = "RepositoryVisibilityChangeEnableAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesRepositoryVulnerabilityAlert:
+		typename = "RepositoryVulnerabilityAlert"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesRepositoryVulnerabilityAlert
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReviewDismissalAllowance:
+		typename = "ReviewDismissalAllowance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReviewDismissalAllowance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReviewDismissedEvent:
+		typename = "ReviewDismissedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReviewDismissedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReviewRequest:
+		typename = "ReviewRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReviewRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReviewRequestRemovedEvent:
+		typename = "ReviewRequestRemovedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReviewRequestRemovedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesReviewRequestedEvent:
+		typename = "ReviewRequestedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesReviewRequestedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSavedReply:
+		typename = "SavedReply"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSavedReply
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSecurityAdvisory:
+		typename = "SecurityAdvisory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSecurityAdvisory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorsActivity:
+		typename = "SponsorsActivity"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorsActivity
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorsListing:
+		typename = "SponsorsListing"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorsListing
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorsListingFeaturedItem:
+		typename = "SponsorsListingFeaturedItem"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorsListingFeaturedItem
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorsTier:
+		typename = "SponsorsTier"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorsTier
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorship:
+		typename = "Sponsorship"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorship
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSponsorshipNewsletter:
+		typename = "SponsorshipNewsletter"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSponsorshipNewsletter
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesStatus:
+		typename = "Status"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesStatusCheckRollup:
+		typename = "StatusCheckRollup"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesStatusCheckRollup
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesStatusContext:
+		typename = "StatusContext"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesStatusContext
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesSubscribedEvent:
+		typename = "SubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesSubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamAddMemberAuditEntry:
+		typename = "TeamAddMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamAddMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamAddRepositoryAuditEntry:
+		typename = "TeamAddRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamAddRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamChangeParentTeamAuditEntry:
+		typename = "TeamChangeParentTeamAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamChangeParentTeamAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamDiscussion:
+		typename = "TeamDiscussion"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamDiscussion
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamDiscussionComment:
+		typename = "TeamDiscussionComment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamDiscussionComment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamRemoveMemberAuditEntry:
+		typename = "TeamRemoveMemberAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamRemoveMemberAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTeamRemoveRepositoryAuditEntry:
+		typename = "TeamRemoveRepositoryAuditEntry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTeamRemoveRepositoryAuditEntry
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTransferredEvent:
+		typename = "TransferredEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTransferredEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesTree
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnassignedEvent:
+		typename = "UnassignedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnassignedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnlabeledEvent:
+		typename = "UnlabeledEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnlabeledEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnlockedEvent:
+		typename = "UnlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnmarkedAsDuplicateEvent:
+		typename = "UnmarkedAsDuplicateEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnmarkedAsDuplicateEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnpinnedEvent:
+		typename = "UnpinnedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnpinnedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUnsubscribedEvent:
+		typename = "UnsubscribedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUnsubscribedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUserBlockedEvent:
+		typename = "UserBlockedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUserBlockedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUserContentEdit:
+		typename = "UserContentEdit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUserContentEdit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUserList:
+		typename = "UserList"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUserList
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesUserStatus:
+		typename = "UserStatus"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesUserStatus
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesVerifiableDomain:
+		typename = "VerifiableDomain"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesVerifiableDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesWorkflow:
+		typename = "Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesWorkflow
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesWorkflowRun:
+		typename = "WorkflowRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesWorkflowRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectItemsNodesWorkflowRunFile:
+		typename = "WorkflowRunFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectItemsNodesWorkflowRunFile
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getProjectItemsNodesNode: "%T"`, v)
+	}
+}
+
+// getProjectItemsNodesOIDCProvider includes the requested fields of the GraphQL type OIDCProvider.
+// The GraphQL type's documentation follows.
+//
+// An OIDC identity provider configured to provision identities for an enterprise.
+// Visible to enterprise owners or enterprise owners' personal access tokens
+// (classic) with read:enterprise or admin:enterprise scope.
+type getProjectItemsNodesOIDCProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOIDCProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOIDCProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOauthApplicationCreateAuditEntry includes the requested fields of the GraphQL type OauthApplicationCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a oauth_application.create event.
+type getProjectItemsNodesOauthApplicationCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOauthApplicationCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOauthApplicationCreateAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgAddBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgAddBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_billing_manager
+type getProjectItemsNodesOrgAddBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgAddBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgAddBillingManagerAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgAddMemberAuditEntry includes the requested fields of the GraphQL type OrgAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.add_member
+type getProjectItemsNodesOrgAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgBlockUserAuditEntry includes the requested fields of the GraphQL type OrgBlockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.block_user
+type getProjectItemsNodesOrgBlockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgBlockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgBlockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.disable_collaborators_only event.
+type getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type OrgConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.config.enable_collaborators_only event.
+type getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgCreateAuditEntry includes the requested fields of the GraphQL type OrgCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.create event.
+type getProjectItemsNodesOrgCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgDisableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_oauth_app_restrictions event.
+type getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgDisableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgDisableSamlAuditEntry includes the requested fields of the GraphQL type OrgDisableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_saml event.
+type getProjectItemsNodesOrgDisableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgDisableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgDisableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgDisableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.disable_two_factor_requirement event.
+type getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgDisableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry includes the requested fields of the GraphQL type OrgEnableOauthAppRestrictionsAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_oauth_app_restrictions event.
+type getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgEnableOauthAppRestrictionsAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgEnableSamlAuditEntry includes the requested fields of the GraphQL type OrgEnableSamlAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_saml event.
+type getProjectItemsNodesOrgEnableSamlAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgEnableSamlAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgEnableSamlAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry includes the requested fields of the GraphQL type OrgEnableTwoFactorRequirementAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.enable_two_factor_requirement event.
+type getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgEnableTwoFactorRequirementAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgInviteMemberAuditEntry includes the requested fields of the GraphQL type OrgInviteMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_member event.
+type getProjectItemsNodesOrgInviteMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgInviteMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgInviteMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgInviteToBusinessAuditEntry includes the requested fields of the GraphQL type OrgInviteToBusinessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.invite_to_business event.
+type getProjectItemsNodesOrgInviteToBusinessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgInviteToBusinessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgInviteToBusinessAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessApprovedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_approved event.
+type getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgOauthAppAccessApprovedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessBlockedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_blocked event.
+type getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgOauthAppAccessBlockedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessDeniedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_denied event.
+type getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgOauthAppAccessDeniedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessRequestedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_requested event.
+type getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgOauthAppAccessRequestedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry includes the requested fields of the GraphQL type OrgOauthAppAccessUnblockedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.oauth_app_access_unblocked event.
+type getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgOauthAppAccessUnblockedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgRemoveBillingManagerAuditEntry includes the requested fields of the GraphQL type OrgRemoveBillingManagerAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_billing_manager event.
+type getProjectItemsNodesOrgRemoveBillingManagerAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgRemoveBillingManagerAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgRemoveBillingManagerAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgRemoveMemberAuditEntry includes the requested fields of the GraphQL type OrgRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_member event.
+type getProjectItemsNodesOrgRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry includes the requested fields of the GraphQL type OrgRemoveOutsideCollaboratorAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.remove_outside_collaborator event.
+type getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgRemoveOutsideCollaboratorAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgRestoreMemberAuditEntry includes the requested fields of the GraphQL type OrgRestoreMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.restore_member event.
+type getProjectItemsNodesOrgRestoreMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgRestoreMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgRestoreMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgUnblockUserAuditEntry includes the requested fields of the GraphQL type OrgUnblockUserAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.unblock_user
+type getProjectItemsNodesOrgUnblockUserAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgUnblockUserAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgUnblockUserAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateDefaultRepositoryPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_default_repository_permission
+type getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgUpdateDefaultRepositoryPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgUpdateMemberAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member event.
+type getProjectItemsNodesOrgUpdateMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgUpdateMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgUpdateMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryCreationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_creation_permission event.
+type getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgUpdateMemberRepositoryCreationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry includes the requested fields of the GraphQL type OrgUpdateMemberRepositoryInvitationPermissionAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a org.update_member_repository_invitation_permission event.
+type getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrgUpdateMemberRepositoryInvitationPermissionAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getProjectItemsNodesOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrganization) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrganizationIdentityProvider includes the requested fields of the GraphQL type OrganizationIdentityProvider.
+// The GraphQL type's documentation follows.
+//
+// An Identity Provider configured to provision SAML and SCIM identities for
+// Organizations. Visible to (1) organization owners, (2) organization owners'
+// personal access tokens (classic) with read:org or admin:org scope, (3) GitHub
+// App with an installation token with read or write access to members.
+type getProjectItemsNodesOrganizationIdentityProvider struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrganizationIdentityProvider.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrganizationIdentityProvider) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+// The GraphQL type's documentation follows.
+//
+// An Invitation for a user to an organization.
+type getProjectItemsNodesOrganizationInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrganizationInvitation) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesOrganizationMigration includes the requested fields of the GraphQL type OrganizationMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) organization migration.
+type getProjectItemsNodesOrganizationMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesOrganizationMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesOrganizationMigration) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Information for an uploaded package.
+type getProjectItemsNodesPackage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPackage.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPackage) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPackageFile includes the requested fields of the GraphQL type PackageFile.
+// The GraphQL type's documentation follows.
+//
+// A file in a package version.
+type getProjectItemsNodesPackageFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPackageFile.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPackageFile) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPackageTag includes the requested fields of the GraphQL type PackageTag.
+// The GraphQL type's documentation follows.
+//
+// A version tag contains the mapping between a tag name and a version.
+type getProjectItemsNodesPackageTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPackageTag.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPackageTag) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPackageVersion includes the requested fields of the GraphQL type PackageVersion.
+// The GraphQL type's documentation follows.
+//
+// Information about a specific package version.
+type getProjectItemsNodesPackageVersion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPackageVersion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPackageVersion) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPinnedDiscussion includes the requested fields of the GraphQL type PinnedDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Discussion is a discussion pinned to a repository's index page.
+type getProjectItemsNodesPinnedDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPinnedDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPinnedDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPinnedEnvironment includes the requested fields of the GraphQL type PinnedEnvironment.
+// The GraphQL type's documentation follows.
+//
+// Represents a pinned environment on a given repository
+type getProjectItemsNodesPinnedEnvironment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPinnedEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPinnedEnvironment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPinnedEvent includes the requested fields of the GraphQL type PinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'pinned' event on a given issue or pull request.
+type getProjectItemsNodesPinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPinnedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPinnedIssue includes the requested fields of the GraphQL type PinnedIssue.
+// The GraphQL type's documentation follows.
+//
+// A Pinned Issue is a issue pinned to a repository's index page.
+type getProjectItemsNodesPinnedIssue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPinnedIssue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPinnedIssue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.disable event.
+type getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPrivateRepositoryForkingDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry includes the requested fields of the GraphQL type PrivateRepositoryForkingEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a private_repository_forking.enable event.
+type getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPrivateRepositoryForkingEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Projects manage issues, pull requests and notes within a project owner.
+type getProjectItemsNodesProject struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProject.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProject) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectCard includes the requested fields of the GraphQL type ProjectCard.
+// The GraphQL type's documentation follows.
+//
+// A card in a project.
+type getProjectItemsNodesProjectCard struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectCard.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectCard) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectColumn includes the requested fields of the GraphQL type ProjectColumn.
+// The GraphQL type's documentation follows.
+//
+// A column inside a project.
+type getProjectItemsNodesProjectColumn struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectColumn.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectColumn) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getProjectItemsNodesProjectV2 struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2Field includes the requested fields of the GraphQL type ProjectV2Field.
+// The GraphQL type's documentation follows.
+//
+// A field inside a project.
+type getProjectItemsNodesProjectV2Field struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2Field.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Field) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2Item includes the requested fields of the GraphQL type ProjectV2Item.
+// The GraphQL type's documentation follows.
+//
+// An item within a Project.
+type getProjectItemsNodesProjectV2Item struct {
+	Typename    string `json:"__typename"`
+	ProjectItem `json:"-"`
+	// The project that contains this item.
+	Project getProjectItemsNodesProjectV2ItemProjectProjectV2 `json:"project"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2Item.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetTypename() string { return v.Typename }
+
+// GetProject returns getProjectItemsNodesProjectV2Item.Project, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetProject() getProjectItemsNodesProjectV2ItemProjectProjectV2 {
+	return v.Project
+}
+
+// GetId returns getProjectItemsNodesProjectV2Item.Id, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetId() string { return v.ProjectItem.Id }
+
+// GetIsArchived returns getProjectItemsNodesProjectV2Item.IsArchived, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetIsArchived() bool { return v.ProjectItem.IsArchived }
+
+// GetUpdatedAt returns getProjectItemsNodesProjectV2Item.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetUpdatedAt() time.Time { return v.ProjectItem.UpdatedAt }
+
+// GetFieldValues returns getProjectItemsNodesProjectV2Item.FieldValues, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetFieldValues() ProjectItemFieldValuesProjectV2ItemFieldValueConnection {
+	return v.ProjectItem.FieldValues
+}
+
+// GetContent returns getProjectItemsNodesProjectV2Item.Content, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Item) GetContent() ProjectItemContentProjectV2ItemContent {
+	return v.ProjectItem.Content
+}
+
+func (v *getProjectItemsNodesProjectV2Item) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectItemsNodesProjectV2Item
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectItemsNodesProjectV2Item = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItem)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectItemsNodesProjectV2Item struct {
+	Typename string `json:"__typename"`
+
+	Project getProjectItemsNodesProjectV2ItemProjectProjectV2 `json:"project"`
+
+	Id string `json:"id"`
+
+	IsArchived bool `json:"isArchived"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	FieldValues ProjectItemFieldValuesProjectV2ItemFieldValueConnection `json:"fieldValues"`
+
+	Content json.RawMessage `json:"content"`
+}
+
+func (v *getProjectItemsNodesProjectV2Item) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectItemsNodesProjectV2Item) __premarshalJSON() (*__premarshalgetProjectItemsNodesProjectV2Item, error) {
+	var retval __premarshalgetProjectItemsNodesProjectV2Item
+
+	retval.Typename = v.Typename
+	retval.Project = v.Project
+	retval.Id = v.ProjectItem.Id
+	retval.IsArchived = v.ProjectItem.IsArchived
+	retval.UpdatedAt = v.ProjectItem.UpdatedAt
+	retval.FieldValues = v.ProjectItem.FieldValues
+	{
+
+		dst := &retval.Content
+		src := v.ProjectItem.Content
+		var err error
+		*dst, err = __marshalProjectItemContentProjectV2ItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getProjectItemsNodesProjectV2Item.ProjectItem.Content: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getProjectItemsNodesProjectV2ItemFieldDateValue includes the requested fields of the GraphQL type ProjectV2ItemFieldDateValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a date field in a Project item.
+type getProjectItemsNodesProjectV2ItemFieldDateValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2ItemFieldDateValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemFieldDateValue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2ItemFieldIterationValue includes the requested fields of the GraphQL type ProjectV2ItemFieldIterationValue.
+// The GraphQL type's documentation follows.
+//
+// The value of an iteration field in a Project item.
+type getProjectItemsNodesProjectV2ItemFieldIterationValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2ItemFieldIterationValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemFieldIterationValue) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesProjectV2ItemFieldNumberValue includes the requested fields of the GraphQL type ProjectV2ItemFieldNumberValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a number field in a Project item.
+type getProjectItemsNodesProjectV2ItemFieldNumberValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2ItemFieldNumberValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemFieldNumberValue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2ItemFieldSingleSelectValue includes the requested fields of the GraphQL type ProjectV2ItemFieldSingleSelectValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a single select field in a Project item.
+type getProjectItemsNodesProjectV2ItemFieldSingleSelectValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2ItemFieldSingleSelectValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemFieldSingleSelectValue) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesProjectV2ItemFieldTextValue includes the requested fields of the GraphQL type ProjectV2ItemFieldTextValue.
+// The GraphQL type's documentation follows.
+//
+// The value of a text field in a Project item.
+type getProjectItemsNodesProjectV2ItemFieldTextValue struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2ItemFieldTextValue.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemFieldTextValue) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2ItemProjectProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getProjectItemsNodesProjectV2ItemProjectProjectV2 struct {
+	// The Node ID of the ProjectV2 object
+	Id string `json:"id"`
+}
+
+// GetId returns getProjectItemsNodesProjectV2ItemProjectProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2ItemProjectProjectV2) GetId() string { return v.Id }
+
+// getProjectItemsNodesProjectV2IterationField includes the requested fields of the GraphQL type ProjectV2IterationField.
+// The GraphQL type's documentation follows.
+//
+// An iteration field inside a project.
+type getProjectItemsNodesProjectV2IterationField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2IterationField.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2IterationField) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2SingleSelectField includes the requested fields of the GraphQL type ProjectV2SingleSelectField.
+// The GraphQL type's documentation follows.
+//
+// A single select field inside a project.
+type getProjectItemsNodesProjectV2SingleSelectField struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2SingleSelectField.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2SingleSelectField) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2View includes the requested fields of the GraphQL type ProjectV2View.
+// The GraphQL type's documentation follows.
+//
+// A view within a ProjectV2.
+type getProjectItemsNodesProjectV2View struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2View.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2View) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesProjectV2Workflow includes the requested fields of the GraphQL type ProjectV2Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow inside a project.
+type getProjectItemsNodesProjectV2Workflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesProjectV2Workflow.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesProjectV2Workflow) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPublicKey includes the requested fields of the GraphQL type PublicKey.
+// The GraphQL type's documentation follows.
+//
+// A user's public key.
+type getProjectItemsNodesPublicKey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPublicKey.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPublicKey) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getProjectItemsNodesPullRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequest) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestCommit includes the requested fields of the GraphQL type PullRequestCommit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit part of a pull request.
+type getProjectItemsNodesPullRequestCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestCommit) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestCommitCommentThread includes the requested fields of the GraphQL type PullRequestCommitCommentThread.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit comment thread part of a pull request.
+type getProjectItemsNodesPullRequestCommitCommentThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestCommitCommentThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestCommitCommentThread) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// The GraphQL type's documentation follows.
+//
+// A review object for a given pull request.
+type getProjectItemsNodesPullRequestReview struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestReview.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestReview) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestReviewComment includes the requested fields of the GraphQL type PullRequestReviewComment.
+// The GraphQL type's documentation follows.
+//
+// A review comment associated with a given repository pull request.
+type getProjectItemsNodesPullRequestReviewComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestReviewComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestReviewComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestReviewThread includes the requested fields of the GraphQL type PullRequestReviewThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getProjectItemsNodesPullRequestReviewThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestReviewThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestReviewThread) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPullRequestThread includes the requested fields of the GraphQL type PullRequestThread.
+// The GraphQL type's documentation follows.
+//
+// A threaded list of comments for a given pull request.
+type getProjectItemsNodesPullRequestThread struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPullRequestThread.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPullRequestThread) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPush includes the requested fields of the GraphQL type Push.
+// The GraphQL type's documentation follows.
+//
+// A Git push.
+type getProjectItemsNodesPush struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPush.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPush) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesPushAllowance includes the requested fields of the GraphQL type PushAllowance.
+// The GraphQL type's documentation follows.
+//
+// A team, user, or app who has the ability to push to a protected branch.
+type getProjectItemsNodesPushAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesPushAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesPushAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReaction includes the requested fields of the GraphQL type Reaction.
+// The GraphQL type's documentation follows.
+//
+// An emoji reaction to a particular piece of content.
+type getProjectItemsNodesReaction struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReaction.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReaction) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReadyForReviewEvent includes the requested fields of the GraphQL type ReadyForReviewEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'ready_for_review' event on a given pull request.
+type getProjectItemsNodesReadyForReviewEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReadyForReviewEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReadyForReviewEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getProjectItemsNodesRef struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRef.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRef) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReferencedEvent includes the requested fields of the GraphQL type ReferencedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'referenced' event on a given `ReferencedSubject`.
+type getProjectItemsNodesReferencedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReferencedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReferencedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRelease includes the requested fields of the GraphQL type Release.
+// The GraphQL type's documentation follows.
+//
+// A release contains the content for a release.
+type getProjectItemsNodesRelease struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRelease.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRelease) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReleaseAsset includes the requested fields of the GraphQL type ReleaseAsset.
+// The GraphQL type's documentation follows.
+//
+// A release asset contains the content for a release asset.
+type getProjectItemsNodesReleaseAsset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReleaseAsset.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReleaseAsset) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRemovedFromMergeQueueEvent includes the requested fields of the GraphQL type RemovedFromMergeQueueEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_merge_queue' event on a given pull request.
+type getProjectItemsNodesRemovedFromMergeQueueEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRemovedFromMergeQueueEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRemovedFromMergeQueueEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRemovedFromProjectEvent includes the requested fields of the GraphQL type RemovedFromProjectEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'removed_from_project' event on a given issue or pull request.
+type getProjectItemsNodesRemovedFromProjectEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRemovedFromProjectEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRemovedFromProjectEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRenamedTitleEvent includes the requested fields of the GraphQL type RenamedTitleEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'renamed' event on a given issue or pull request
+type getProjectItemsNodesRenamedTitleEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRenamedTitleEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRenamedTitleEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReopenedEvent includes the requested fields of the GraphQL type ReopenedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'reopened' event on any `Closable`.
+type getProjectItemsNodesReopenedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReopenedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReopenedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoAccessAuditEntry includes the requested fields of the GraphQL type RepoAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.access event.
+type getProjectItemsNodesRepoAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoAccessAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoAddMemberAuditEntry includes the requested fields of the GraphQL type RepoAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_member event.
+type getProjectItemsNodesRepoAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoAddTopicAuditEntry includes the requested fields of the GraphQL type RepoAddTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.add_topic event.
+type getProjectItemsNodesRepoAddTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoAddTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoAddTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoArchivedAuditEntry includes the requested fields of the GraphQL type RepoArchivedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.archived event.
+type getProjectItemsNodesRepoArchivedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoArchivedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoArchivedAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoChangeMergeSettingAuditEntry includes the requested fields of the GraphQL type RepoChangeMergeSettingAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.change_merge_setting event.
+type getProjectItemsNodesRepoChangeMergeSettingAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoChangeMergeSettingAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoChangeMergeSettingAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_anonymous_git_access event.
+type getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigDisableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_collaborators_only event.
+type getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigDisableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_contributors_only event.
+type getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigDisableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigDisableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.disable_sockpuppet_disallowed event.
+type getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigDisableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_anonymous_git_access event.
+type getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigEnableAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableCollaboratorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_collaborators_only event.
+type getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigEnableCollaboratorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableContributorsOnlyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_contributors_only event.
+type getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigEnableContributorsOnlyAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry includes the requested fields of the GraphQL type RepoConfigEnableSockpuppetDisallowedAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.enable_sockpuppet_disallowed event.
+type getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigEnableSockpuppetDisallowedAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigLockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.lock_anonymous_git_access event.
+type getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigLockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry includes the requested fields of the GraphQL type RepoConfigUnlockAnonymousGitAccessAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.config.unlock_anonymous_git_access event.
+type getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoConfigUnlockAnonymousGitAccessAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepoCreateAuditEntry includes the requested fields of the GraphQL type RepoCreateAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.create event.
+type getProjectItemsNodesRepoCreateAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoCreateAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoCreateAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoDestroyAuditEntry includes the requested fields of the GraphQL type RepoDestroyAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.destroy event.
+type getProjectItemsNodesRepoDestroyAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoDestroyAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoDestroyAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoRemoveMemberAuditEntry includes the requested fields of the GraphQL type RepoRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_member event.
+type getProjectItemsNodesRepoRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepoRemoveTopicAuditEntry includes the requested fields of the GraphQL type RepoRemoveTopicAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repo.remove_topic event.
+type getProjectItemsNodesRepoRemoveTopicAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepoRemoveTopicAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepoRemoveTopicAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getProjectItemsNodesRepository struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepository.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepository) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryInvitation includes the requested fields of the GraphQL type RepositoryInvitation.
+// The GraphQL type's documentation follows.
+//
+// An invitation for a user to be added to a repository.
+type getProjectItemsNodesRepositoryInvitation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryInvitation) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryMigration includes the requested fields of the GraphQL type RepositoryMigration.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Enterprise Importer (GEI) repository migration.
+type getProjectItemsNodesRepositoryMigration struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryMigration.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryMigration) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryRule includes the requested fields of the GraphQL type RepositoryRule.
+// The GraphQL type's documentation follows.
+//
+// A repository rule.
+type getProjectItemsNodesRepositoryRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryRule.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryRule) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryRuleset includes the requested fields of the GraphQL type RepositoryRuleset.
+// The GraphQL type's documentation follows.
+//
+// A repository ruleset.
+type getProjectItemsNodesRepositoryRuleset struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryRuleset.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryRuleset) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryRulesetBypassActor includes the requested fields of the GraphQL type RepositoryRulesetBypassActor.
+// The GraphQL type's documentation follows.
+//
+// A team or app that has the ability to bypass a rules defined on a ruleset
+type getProjectItemsNodesRepositoryRulesetBypassActor struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryRulesetBypassActor.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryRulesetBypassActor) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryTopic includes the requested fields of the GraphQL type RepositoryTopic.
+// The GraphQL type's documentation follows.
+//
+// A repository-topic connects a repository to a topic.
+type getProjectItemsNodesRepositoryTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryTopic) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeDisableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.disable event.
+type getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryVisibilityChangeDisableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry includes the requested fields of the GraphQL type RepositoryVisibilityChangeEnableAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a repository_visibility_change.enable event.
+type getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryVisibilityChangeEnableAuditEntry) GetTypename() string {
+	return v.Typename
+}
+
+// getProjectItemsNodesRepositoryVulnerabilityAlert includes the requested fields of the GraphQL type RepositoryVulnerabilityAlert.
+// The GraphQL type's documentation follows.
+//
+// A Dependabot alert for a repository with a dependency affected by a security vulnerability.
+type getProjectItemsNodesRepositoryVulnerabilityAlert struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesRepositoryVulnerabilityAlert.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesRepositoryVulnerabilityAlert) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReviewDismissalAllowance includes the requested fields of the GraphQL type ReviewDismissalAllowance.
+// The GraphQL type's documentation follows.
+//
+// A user, team, or app who has the ability to dismiss a review on a protected branch.
+type getProjectItemsNodesReviewDismissalAllowance struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReviewDismissalAllowance.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReviewDismissalAllowance) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReviewDismissedEvent includes the requested fields of the GraphQL type ReviewDismissedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'review_dismissed' event on a given issue or pull request.
+type getProjectItemsNodesReviewDismissedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReviewDismissedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReviewDismissedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReviewRequest includes the requested fields of the GraphQL type ReviewRequest.
+// The GraphQL type's documentation follows.
+//
+// A request for a user to review a pull request.
+type getProjectItemsNodesReviewRequest struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReviewRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReviewRequest) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReviewRequestRemovedEvent includes the requested fields of the GraphQL type ReviewRequestRemovedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_request_removed' event on a given pull request.
+type getProjectItemsNodesReviewRequestRemovedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReviewRequestRemovedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReviewRequestRemovedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesReviewRequestedEvent includes the requested fields of the GraphQL type ReviewRequestedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'review_requested' event on a given pull request.
+type getProjectItemsNodesReviewRequestedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesReviewRequestedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesReviewRequestedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSavedReply includes the requested fields of the GraphQL type SavedReply.
+// The GraphQL type's documentation follows.
+//
+// A Saved Reply is text a user can use to reply quickly.
+type getProjectItemsNodesSavedReply struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSavedReply.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSavedReply) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSecurityAdvisory includes the requested fields of the GraphQL type SecurityAdvisory.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Security Advisory
+type getProjectItemsNodesSecurityAdvisory struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSecurityAdvisory.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSecurityAdvisory) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorsActivity includes the requested fields of the GraphQL type SponsorsActivity.
+// The GraphQL type's documentation follows.
+//
+// An event related to sponsorship activity.
+type getProjectItemsNodesSponsorsActivity struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorsActivity.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorsActivity) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorsListing includes the requested fields of the GraphQL type SponsorsListing.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors listing.
+type getProjectItemsNodesSponsorsListing struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorsListing.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorsListing) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorsListingFeaturedItem includes the requested fields of the GraphQL type SponsorsListingFeaturedItem.
+// The GraphQL type's documentation follows.
+//
+// A record that is promoted on a GitHub Sponsors profile.
+type getProjectItemsNodesSponsorsListingFeaturedItem struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorsListingFeaturedItem.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorsListingFeaturedItem) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorsTier includes the requested fields of the GraphQL type SponsorsTier.
+// The GraphQL type's documentation follows.
+//
+// A GitHub Sponsors tier associated with a GitHub Sponsors listing.
+type getProjectItemsNodesSponsorsTier struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorsTier.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorsTier) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorship includes the requested fields of the GraphQL type Sponsorship.
+// The GraphQL type's documentation follows.
+//
+// A sponsorship relationship between a sponsor and a maintainer
+type getProjectItemsNodesSponsorship struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorship.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorship) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSponsorshipNewsletter includes the requested fields of the GraphQL type SponsorshipNewsletter.
+// The GraphQL type's documentation follows.
+//
+// An update sent to sponsors of a user or organization on GitHub Sponsors.
+type getProjectItemsNodesSponsorshipNewsletter struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSponsorshipNewsletter.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSponsorshipNewsletter) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesStatus includes the requested fields of the GraphQL type Status.
+// The GraphQL type's documentation follows.
+//
+// Represents a commit status.
+type getProjectItemsNodesStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getProjectItemsNodesStatusCheckRollup struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesStatusCheckRollup.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesStatusCheckRollup) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesStatusContext includes the requested fields of the GraphQL type StatusContext.
+// The GraphQL type's documentation follows.
+//
+// Represents an individual commit status context
+type getProjectItemsNodesStatusContext struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesStatusContext.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesStatusContext) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesSubscribedEvent includes the requested fields of the GraphQL type SubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'subscribed' event on a given `Subscribable`.
+type getProjectItemsNodesSubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesSubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesSubscribedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getProjectItemsNodesTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTag.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTag) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// A team of users in an organization.
+type getProjectItemsNodesTeam struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeam.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeam) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamAddMemberAuditEntry includes the requested fields of the GraphQL type TeamAddMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_member event.
+type getProjectItemsNodesTeamAddMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamAddMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamAddMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamAddRepositoryAuditEntry includes the requested fields of the GraphQL type TeamAddRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.add_repository event.
+type getProjectItemsNodesTeamAddRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamAddRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamAddRepositoryAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamChangeParentTeamAuditEntry includes the requested fields of the GraphQL type TeamChangeParentTeamAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.change_parent_team event.
+type getProjectItemsNodesTeamChangeParentTeamAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamChangeParentTeamAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamChangeParentTeamAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamDiscussion includes the requested fields of the GraphQL type TeamDiscussion.
+// The GraphQL type's documentation follows.
+//
+// A team discussion.
+type getProjectItemsNodesTeamDiscussion struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamDiscussion.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamDiscussion) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamDiscussionComment includes the requested fields of the GraphQL type TeamDiscussionComment.
+// The GraphQL type's documentation follows.
+//
+// A comment on a team discussion.
+type getProjectItemsNodesTeamDiscussionComment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamDiscussionComment.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamDiscussionComment) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamRemoveMemberAuditEntry includes the requested fields of the GraphQL type TeamRemoveMemberAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_member event.
+type getProjectItemsNodesTeamRemoveMemberAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamRemoveMemberAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamRemoveMemberAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTeamRemoveRepositoryAuditEntry includes the requested fields of the GraphQL type TeamRemoveRepositoryAuditEntry.
+// The GraphQL type's documentation follows.
+//
+// Audit log entry for a team.remove_repository event.
+type getProjectItemsNodesTeamRemoveRepositoryAuditEntry struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTeamRemoveRepositoryAuditEntry.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTeamRemoveRepositoryAuditEntry) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTopic includes the requested fields of the GraphQL type Topic.
+// The GraphQL type's documentation follows.
+//
+// A topic aggregates entities that are related to a subject.
+type getProjectItemsNodesTopic struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTopic.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTopic) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTransferredEvent includes the requested fields of the GraphQL type TransferredEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'transferred' event on a given issue or pull request.
+type getProjectItemsNodesTransferredEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTransferredEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTransferredEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getProjectItemsNodesTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesTree.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesTree) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnassignedEvent includes the requested fields of the GraphQL type UnassignedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unassigned' event on any assignable object.
+type getProjectItemsNodesUnassignedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnassignedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnassignedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnlabeledEvent includes the requested fields of the GraphQL type UnlabeledEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlabeled' event on a given issue or pull request.
+type getProjectItemsNodesUnlabeledEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnlabeledEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnlabeledEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnlockedEvent includes the requested fields of the GraphQL type UnlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unlocked' event on a given issue or pull request.
+type getProjectItemsNodesUnlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnlockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnmarkedAsDuplicateEvent includes the requested fields of the GraphQL type UnmarkedAsDuplicateEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unmarked_as_duplicate' event on a given issue or pull request.
+type getProjectItemsNodesUnmarkedAsDuplicateEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnmarkedAsDuplicateEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnmarkedAsDuplicateEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnpinnedEvent includes the requested fields of the GraphQL type UnpinnedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unpinned' event on a given issue or pull request.
+type getProjectItemsNodesUnpinnedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnpinnedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnpinnedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUnsubscribedEvent includes the requested fields of the GraphQL type UnsubscribedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents an 'unsubscribed' event on a given `Subscribable`.
+type getProjectItemsNodesUnsubscribedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUnsubscribedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUnsubscribedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getProjectItemsNodesUser struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUser.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUser) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUserBlockedEvent includes the requested fields of the GraphQL type UserBlockedEvent.
+// The GraphQL type's documentation follows.
+//
+// Represents a 'user_blocked' event on a given user.
+type getProjectItemsNodesUserBlockedEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUserBlockedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUserBlockedEvent) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUserContentEdit includes the requested fields of the GraphQL type UserContentEdit.
+// The GraphQL type's documentation follows.
+//
+// An edit on user content
+type getProjectItemsNodesUserContentEdit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUserContentEdit.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUserContentEdit) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUserList includes the requested fields of the GraphQL type UserList.
+// The GraphQL type's documentation follows.
+//
+// A user-curated list of repositories
+type getProjectItemsNodesUserList struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUserList.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUserList) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesUserStatus includes the requested fields of the GraphQL type UserStatus.
+// The GraphQL type's documentation follows.
+//
+// The user's description of what they're currently doing.
+type getProjectItemsNodesUserStatus struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesUserStatus.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesUserStatus) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesVerifiableDomain includes the requested fields of the GraphQL type VerifiableDomain.
+// The GraphQL type's documentation follows.
+//
+// A domain that can be verified or approved for an organization or an enterprise.
+type getProjectItemsNodesVerifiableDomain struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesVerifiableDomain.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesVerifiableDomain) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesWorkflow includes the requested fields of the GraphQL type Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow contains meta information about an Actions workflow file.
+type getProjectItemsNodesWorkflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesWorkflow.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesWorkflow) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesWorkflowRun includes the requested fields of the GraphQL type WorkflowRun.
+// The GraphQL type's documentation follows.
+//
+// A workflow run.
+type getProjectItemsNodesWorkflowRun struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesWorkflowRun.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesWorkflowRun) GetTypename() string { return v.Typename }
+
+// getProjectItemsNodesWorkflowRunFile includes the requested fields of the GraphQL type WorkflowRunFile.
+// The GraphQL type's documentation follows.
+//
+// An executed workflow file for a workflow run.
+type getProjectItemsNodesWorkflowRunFile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getProjectItemsNodesWorkflowRunFile.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectItemsNodesWorkflowRunFile) GetTypename() string { return v.Typename }
+
+// getProjectItemsResponse is returned by getProjectItems on success.
+type getProjectItemsResponse struct {
+	// Lookup nodes by a list of IDs.
+	Nodes []getProjectItemsNodesNode `json:"-"`
+}
+
+// GetNodes returns getProjectItemsResponse.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectItemsResponse) GetNodes() []getProjectItemsNodesNode { return v.Nodes }
+
+func (v *getProjectItemsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectItemsResponse
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectItemsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]getProjectItemsNodesNode,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetProjectItemsNodesNode(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal getProjectItemsResponse.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetProjectItemsResponse struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *getProjectItemsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectItemsResponse) __premarshalJSON() (*__premarshalgetProjectItemsResponse, error) {
+	var retval __premarshalgetProjectItemsResponse
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetProjectItemsNodesNode(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal getProjectItemsResponse.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getRepositoryProjectItemVersionsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryProjectItemVersionsRepository struct {
+	// Finds and returns the Project according to the provided Project number.
+	ProjectV2 getRepositoryProjectItemVersionsRepositoryProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getRepositoryProjectItemVersionsRepository.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsRepository) GetProjectV2() getRepositoryProjectItemVersionsRepositoryProjectV2 {
+	return v.ProjectV2
+}
+
+// getRepositoryProjectItemVersionsRepositoryProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getRepositoryProjectItemVersionsRepositoryProjectV2 struct {
+	ProjectItemVersions `json:"-"`
+}
+
+// GetItems returns getRepositoryProjectItemVersionsRepositoryProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) GetItems() ProjectItemVersionsItemsProjectV2ItemConnection {
+	return v.ProjectItemVersions.Items
+}
+
+// GetId returns getRepositoryProjectItemVersionsRepositoryProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) GetId() string {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getRepositoryProjectItemVersionsRepositoryProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) GetTitle() string {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getRepositoryProjectItemVersionsRepositoryProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectItemVersions.ProjectFieldDefinitions.Fields
+}
+
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryProjectItemVersionsRepositoryProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryProjectItemVersionsRepositoryProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItemVersions)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryProjectItemVersionsRepositoryProjectV2 struct {
+	Items ProjectItemVersionsItemsProjectV2ItemConnection `json:"items"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryProjectItemVersionsRepositoryProjectV2) __premarshalJSON() (*__premarshalgetRepositoryProjectItemVersionsRepositoryProjectV2, error) {
+	var retval __premarshalgetRepositoryProjectItemVersionsRepositoryProjectV2
+
+	retval.Items = v.ProjectItemVersions.Items
+	retval.Id = v.ProjectItemVersions.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectItemVersions.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectItemVersions.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getRepositoryProjectItemVersionsResponse is returned by getRepositoryProjectItemVersions on success.
+type getRepositoryProjectItemVersionsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepositoryProjectItemVersionsRepository `json:"repository"`
+}
+
+// GetRepository returns getRepositoryProjectItemVersionsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectItemVersionsResponse) GetRepository() getRepositoryProjectItemVersionsRepository {
+	return v.Repository
+}
+
+// getRepositoryProjectRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryProjectRepository struct {
+	// Finds and returns the Project according to the provided Project number.
+	ProjectV2 getRepositoryProjectRepositoryProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getRepositoryProjectRepository.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectRepository) GetProjectV2() getRepositoryProjectRepositoryProjectV2 {
+	return v.ProjectV2
+}
+
+// getRepositoryProjectRepositoryProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getRepositoryProjectRepositoryProjectV2 struct {
+	ProjectFields `json:"-"`
+}
+
+// GetItems returns getRepositoryProjectRepositoryProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectRepositoryProjectV2) GetItems() ProjectFieldsItemsProjectV2ItemConnection {
+	return v.ProjectFields.Items
+}
+
+// GetId returns getRepositoryProjectRepositoryProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectRepositoryProjectV2) GetId() string {
+	return v.ProjectFields.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getRepositoryProjectRepositoryProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectRepositoryProjectV2) GetTitle() string {
+	return v.ProjectFields.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getRepositoryProjectRepositoryProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectRepositoryProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectFields.ProjectFieldDefinitions.Fields
+}
+
+func (v *getRepositoryProjectRepositoryProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryProjectRepositoryProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryProjectRepositoryProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryProjectRepositoryProjectV2 struct {
+	Items ProjectFieldsItemsProjectV2ItemConnection `json:"items"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getRepositoryProjectRepositoryProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryProjectRepositoryProjectV2) __premarshalJSON() (*__premarshalgetRepositoryProjectRepositoryProjectV2, error) {
+	var retval __premarshalgetRepositoryProjectRepositoryProjectV2
+
+	retval.Items = v.ProjectFields.Items
+	retval.Id = v.ProjectFields.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectFields.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectFields.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getRepositoryProjectResponse is returned by getRepositoryProject on success.
+type getRepositoryProjectResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepositoryProjectRepository `json:"repository"`
+}
+
+// GetRepository returns getRepositoryProjectResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectResponse) GetRepository() getRepositoryProjectRepository {
+	return v.Repository
+}
+
+// getRepositoryProjectSettingsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryProjectSettingsRepository struct {
+	// Finds and returns the Project according to the provided Project number.
+	ProjectV2 getRepositoryProjectSettingsRepositoryProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getRepositoryProjectSettingsRepository.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectSettingsRepository) GetProjectV2() getRepositoryProjectSettingsRepositoryProjectV2 {
+	return v.ProjectV2
+}
+
+// getRepositoryProjectSettingsRepositoryProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getRepositoryProjectSettingsRepositoryProjectV2 struct {
+	ProjectSettings `json:"-"`
+}
+
+// GetId returns getRepositoryProjectSettingsRepositoryProjectV2.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) GetId() string {
+	return v.ProjectSettings.ProjectFieldDefinitions.Id
+}
+
+// GetTitle returns getRepositoryProjectSettingsRepositoryProjectV2.Title, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) GetTitle() string {
+	return v.ProjectSettings.ProjectFieldDefinitions.Title
+}
+
+// GetFields returns getRepositoryProjectSettingsRepositoryProjectV2.Fields, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) GetFields() ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection {
+	return v.ProjectSettings.ProjectFieldDefinitions.Fields
+}
+
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryProjectSettingsRepositoryProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryProjectSettingsRepositoryProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectSettings)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryProjectSettingsRepositoryProjectV2 struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Fields ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection `json:"fields"`
+}
+
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryProjectSettingsRepositoryProjectV2) __premarshalJSON() (*__premarshalgetRepositoryProjectSettingsRepositoryProjectV2, error) {
+	var retval __premarshalgetRepositoryProjectSettingsRepositoryProjectV2
+
+	retval.Id = v.ProjectSettings.ProjectFieldDefinitions.Id
+	retval.Title = v.ProjectSettings.ProjectFieldDefinitions.Title
+	retval.Fields = v.ProjectSettings.ProjectFieldDefinitions.Fields
+	return &retval, nil
+}
+
+// getRepositoryProjectSettingsResponse is returned by getRepositoryProjectSettings on success.
+type getRepositoryProjectSettingsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepositoryProjectSettingsRepository `json:"repository"`
+}
+
+// GetRepository returns getRepositoryProjectSettingsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectSettingsResponse) GetRepository() getRepositoryProjectSettingsRepository {
+	return v.Repository
+}
+
+// getRepositoryProjectTimelineRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryProjectTimelineRepository struct {
+	// Finds and returns the Project according to the provided Project number.
+	ProjectV2 getRepositoryProjectTimelineRepositoryProjectV2 `json:"projectV2"`
+}
+
+// GetProjectV2 returns getRepositoryProjectTimelineRepository.ProjectV2, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectTimelineRepository) GetProjectV2() getRepositoryProjectTimelineRepositoryProjectV2 {
+	return v.ProjectV2
+}
+
+// getRepositoryProjectTimelineRepositoryProjectV2 includes the requested fields of the GraphQL type ProjectV2.
+// The GraphQL type's documentation follows.
+//
+// New projects that manage issues, pull requests and drafts using tables and boards.
+type getRepositoryProjectTimelineRepositoryProjectV2 struct {
+	ProjectItemsTimeline `json:"-"`
+}
+
+// GetItems returns getRepositoryProjectTimelineRepositoryProjectV2.Items, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectTimelineRepositoryProjectV2) GetItems() ProjectItemsTimelineItemsProjectV2ItemConnection {
+	return v.ProjectItemsTimeline.Items
+}
+
+func (v *getRepositoryProjectTimelineRepositoryProjectV2) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryProjectTimelineRepositoryProjectV2
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryProjectTimelineRepositoryProjectV2 = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectItemsTimeline)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryProjectTimelineRepositoryProjectV2 struct {
+	Items ProjectItemsTimelineItemsProjectV2ItemConnection `json:"items"`
+}
+
+func (v *getRepositoryProjectTimelineRepositoryProjectV2) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryProjectTimelineRepositoryProjectV2) __premarshalJSON() (*__premarshalgetRepositoryProjectTimelineRepositoryProjectV2, error) {
+	var retval __premarshalgetRepositoryProjectTimelineRepositoryProjectV2
+
+	retval.Items = v.ProjectItemsTimeline.Items
+	return &retval, nil
+}
+
+// getRepositoryProjectTimelineResponse is returned by getRepositoryProjectTimeline on success.
+type getRepositoryProjectTimelineResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepositoryProjectTimelineRepository `json:"repository"`
+}
+
+// GetRepository returns getRepositoryProjectTimelineResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryProjectTimelineResponse) GetRepository() getRepositoryProjectTimelineRepository {
+	return v.Repository
+}
+
+// The query or mutation executed by getOrganizationProject.
+const getOrganizationProject_Operation = `
+query getOrganizationProject ($organization_name: String!, $project_number: Int!, $labels_per_issue_count: Int!, $assignees_per_issue_count: Int!, $cursor: String) {
+	organization(login: $organization_name) {
+		projectV2(number: $project_number) {
+			... ProjectFields
+		}
+	}
+}
+fragment ProjectFields on ProjectV2 {
+	... ProjectFieldDefinitions
+	items(first: 100, after: $cursor) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			... ProjectItem
+		}
+	}
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
+	id
+	title
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
+			}
+		}
+	}
+}
+fragment ProjectItem on ProjectV2Item {
+	id
+	isArchived
+	updatedAt
+	fieldValues(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2ItemFieldSingleSelectValue {
+				name
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldNumberValue {
+				number
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldIterationValue {
+				iterationId
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+	content {
+		__typename
+		... on Issue {
+			id
+			title
+			createdAt
+			closedAt
+			updatedAt
+			parent {
+				id
+			}
+			trackedInIssues(first: 1) {
+				nodes {
+					id
+				}
+			}
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on PullRequest {
+			title
+			createdAt
+			closedAt
+			updatedAt
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on DraftIssue {
+			title
+			createdAt
+			updatedAt
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+	}
+}
+`
+
+func getOrganizationProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organization_name string,
+	project_number int,
+	labels_per_issue_count int,
+	assignees_per_issue_count int,
+	cursor string,
+) (*getOrganizationProjectResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationProject",
+		Query:  getOrganizationProject_Operation,
+		Variables: &__getOrganizationProjectInput{
+			Organization_name:         organization_name,
+			Project_number:            project_number,
+			Labels_per_issue_count:    labels_per_issue_count,
+			Assignees_per_issue_count: assignees_per_issue_count,
+			Cursor:                    cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationProjectResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationProjectItemVersions.
+const getOrganizationProjectItemVersions_Operation = `
+query getOrganizationProjectItemVersions ($organization_name: String!, $project_number: Int!, $cursor: String) {
+	organization(login: $organization_name) {
+		projectV2(number: $project_number) {
+			... ProjectItemVersions
+		}
+	}
+}
+fragment ProjectItemVersions on ProjectV2 {
+	... ProjectFieldDefinitions
+	items(first: 100, after: $cursor) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			... ProjectItemVersion
+		}
+	}
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
+	id
+	title
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
+			}
+		}
+	}
+}
+fragment ProjectItemVersion on ProjectV2Item {
+	id
+	updatedAt
+	content {
+		__typename
+		... on Issue {
+			updatedAt
+		}
+		... on PullRequest {
+			updatedAt
+		}
+		... on DraftIssue {
+			updatedAt
+		}
+	}
+}
+`
+
+func getOrganizationProjectItemVersions(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organization_name string,
+	project_number int,
+	cursor string,
+) (*getOrganizationProjectItemVersionsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationProjectItemVersions",
+		Query:  getOrganizationProjectItemVersions_Operation,
+		Variables: &__getOrganizationProjectItemVersionsInput{
+			Organization_name: organization_name,
+			Project_number:    project_number,
+			Cursor:            cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationProjectItemVersionsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationProjectSettings.
+const getOrganizationProjectSettings_Operation = `
+query getOrganizationProjectSettings ($organization_name: String!, $project_number: Int!) {
+	organization(login: $organization_name) {
+		projectV2(number: $project_number) {
+			... ProjectSettings
+		}
+	}
+}
+fragment ProjectSettings on ProjectV2 {
+	... ProjectFieldDefinitions
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
+	id
+	title
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func getOrganizationProjectSettings(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organization_name string,
+	project_number int,
+) (*getOrganizationProjectSettingsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationProjectSettings",
+		Query:  getOrganizationProjectSettings_Operation,
+		Variables: &__getOrganizationProjectSettingsInput{
+			Organization_name: organization_name,
+			Project_number:    project_number,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationProjectSettingsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationProjectTimeline.
+const getOrganizationProjectTimeline_Operation = `
+query getOrganizationProjectTimeline ($organization_name: String!, $project_number: Int!, $cursor: String) {
+	organization(login: $organization_name) {
+		projectV2(number: $project_number) {
+			... ProjectItemsTimeline
+		}
+	}
+}
+fragment ProjectItemsTimeline on ProjectV2 {
+	items(first: 50, after: $cursor) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			... ProjectItemTimeline
+		}
+	}
+}
+fragment ProjectItemTimeline on ProjectV2Item {
+	id
+	createdAt
+	content {
+		__typename
+		... on Issue {
+			timelineItems(first: 100, itemTypes: [CLOSED_EVENT,REOPENED_EVENT,LABELED_EVENT,UNLABELED_EVENT]) {
+				nodes {
+					__typename
+					... on ClosedEvent {
+						createdAt
+					}
+					... on ReopenedEvent {
+						createdAt
+					}
+					... on LabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+					... on UnlabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+				}
+			}
+		}
+		... on PullRequest {
+			timelineItems(first: 100, itemTypes: [CLOSED_EVENT,REOPENED_EVENT,LABELED_EVENT,UNLABELED_EVENT]) {
+				nodes {
+					__typename
+					... on ClosedEvent {
+						createdAt
+					}
+					... on ReopenedEvent {
+						createdAt
+					}
+					... on LabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+					... on UnlabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func getOrganizationProjectTimeline(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organization_name string,
+	project_number int,
+	cursor string,
+) (*getOrganizationProjectTimelineResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationProjectTimeline",
+		Query:  getOrganizationProjectTimeline_Operation,
+		Variables: &__getOrganizationProjectTimelineInput{
+			Organization_name: organization_name,
+			Project_number:    project_number,
+			Cursor:            cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationProjectTimelineResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationProjects.
+const getOrganizationProjects_Operation = `
+query getOrganizationProjects ($organization_name: String!, $cursor: String) {
+	organization(login: $organization_name) {
+		projectsV2(first: 100, after: $cursor) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				number
+				title
+				closed
+			}
+		}
+	}
+}
+`
+
+func getOrganizationProjects(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organization_name string,
+	cursor string,
+) (*getOrganizationProjectsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationProjects",
+		Query:  getOrganizationProjects_Operation,
+		Variables: &__getOrganizationProjectsInput{
+			Organization_name: organization_name,
+			Cursor:            cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationProjectsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getProjectItem.
+const getProjectItem_Operation = `
+query getProjectItem ($item_id: ID!, $labels_per_issue_count: Int!, $assignees_per_issue_count: Int!) {
+	node(id: $item_id) {
+		__typename
+		... on ProjectV2Item {
+			... ProjectItem
+			project {
+				... ProjectFieldDefinitions
+			}
+		}
+	}
+}
+fragment ProjectItem on ProjectV2Item {
+	id
+	isArchived
+	updatedAt
+	fieldValues(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2ItemFieldSingleSelectValue {
+				name
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldNumberValue {
+				number
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldIterationValue {
+				iterationId
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+	content {
+		__typename
+		... on Issue {
+			id
+			title
+			createdAt
+			closedAt
+			updatedAt
+			parent {
+				id
+			}
+			trackedInIssues(first: 1) {
+				nodes {
+					id
+				}
+			}
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on PullRequest {
+			title
+			createdAt
+			closedAt
+			updatedAt
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on DraftIssue {
+			title
+			createdAt
+			updatedAt
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+	}
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
+	id
+	title
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func getProjectItem(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	item_id string,
+	labels_per_issue_count int,
+	assignees_per_issue_count int,
+) (*getProjectItemResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getProjectItem",
+		Query:  getProjectItem_Operation,
+		Variables: &__getProjectItemInput{
+			Item_id:                   item_id,
+			Labels_per_issue_count:    labels_per_issue_count,
+			Assignees_per_issue_count: assignees_per_issue_count,
+		},
+	}
+	var err_ error
+
+	var data_ getProjectItemResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getProjectItems.
+const getProjectItems_Operation = `
+query getProjectItems ($item_ids: [ID!]!, $labels_per_issue_count: Int!, $assignees_per_issue_count: Int!) {
+	nodes(ids: $item_ids) {
+		__typename
+		... on ProjectV2Item {
+			... ProjectItem
+			project {
+				id
+			}
+		}
+	}
+}
+fragment ProjectItem on ProjectV2Item {
+	id
+	isArchived
+	updatedAt
+	fieldValues(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2ItemFieldSingleSelectValue {
+				name
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldNumberValue {
+				number
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldIterationValue {
+				iterationId
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+	content {
+		__typename
+		... on Issue {
+			id
+			title
+			createdAt
+			closedAt
+			updatedAt
+			parent {
+				id
+			}
+			trackedInIssues(first: 1) {
+				nodes {
+					id
+				}
+			}
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on PullRequest {
+			title
+			createdAt
+			closedAt
+			updatedAt
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
+				}
+			}
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+		... on DraftIssue {
+			title
+			createdAt
+			updatedAt
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
+				}
+			}
+		}
+	}
 }
+`
 
-func (v *getRepositoryProjectRepositoryProjectV2) __premarshalJSON() (*__premarshalgetRepositoryProjectRepositoryProjectV2, error) {
-	var retval __premarshalgetRepositoryProjectRepositoryProjectV2
-
-	retval.Id = v.ProjectFields.Id
-	retval.Title = v.ProjectFields.Title
-	{
-
-		dst := &retval.Status
-		src := v.ProjectFields.Status
-		var err error
-		*dst, err = __marshalProjectFieldsStatusProjectV2FieldConfiguration(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getRepositoryProjectRepositoryProjectV2.ProjectFields.Status: %w", err)
-		}
+func getProjectItems(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	item_ids []string,
+	labels_per_issue_count int,
+	assignees_per_issue_count int,
+) (*getProjectItemsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getProjectItems",
+		Query:  getProjectItems_Operation,
+		Variables: &__getProjectItemsInput{
+			Item_ids:                  item_ids,
+			Labels_per_issue_count:    labels_per_issue_count,
+			Assignees_per_issue_count: assignees_per_issue_count,
+		},
 	}
-	{
+	var err_ error
 
-		dst := &retval.Iteration
-		src := v.ProjectFields.Iteration
-		var err error
-		*dst, err = __marshalProjectFieldsIterationProjectV2FieldConfiguration(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getRepositoryProjectRepositoryProjectV2.ProjectFields.Iteration: %w", err)
-		}
-	}
-	retval.Items = v.ProjectFields.Items
-	return &retval, nil
-}
+	var data_ getProjectItemsResponse
+	resp_ := &graphql.Response{Data: &data_}
 
-// getRepositoryProjectResponse is returned by getRepositoryProject on success.
-type getRepositoryProjectResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository getRepositoryProjectRepository `json:"repository"`
-}
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// GetRepository returns getRepositoryProjectResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getRepositoryProjectResponse) GetRepository() getRepositoryProjectRepository {
-	return v.Repository
+	return &data_, err_
 }
 
-// The query or mutation executed by getOrganizationProject.
-const getOrganizationProject_Operation = `
-query getOrganizationProject ($organization_name: String!, $project_number: Int!, $labels_per_issue_count: Int!, $cursor: String) {
-	organization(login: $organization_name) {
+// The query or mutation executed by getRepositoryProject.
+const getRepositoryProject_Operation = `
+query getRepositoryProject ($repo_owner: String!, $repo_name: String!, $project_number: Int!, $labels_per_issue_count: Int!, $assignees_per_issue_count: Int!, $cursor: String) {
+	repository(owner: $repo_owner, name: $repo_name) {
 		projectV2(number: $project_number) {
 			... ProjectFields
 		}
 	}
 }
 fragment ProjectFields on ProjectV2 {
+	... ProjectFieldDefinitions
+	items(first: 100, after: $cursor) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			... ProjectItem
+		}
+	}
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
 	id
 	title
-	status: field(name: "Status") {
-		__typename
-		... on ProjectV2SingleSelectField {
-			name
-			options {
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
 				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
 			}
 		}
 	}
-	iteration: field(name: "Iteration") {
-		__typename
-		... on ProjectV2IterationField {
-			name
-			configuration {
-				iterations {
-					id
-					title
-					startDate
-					duration
+}
+fragment ProjectItem on ProjectV2Item {
+	id
+	isArchived
+	updatedAt
+	fieldValues(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2ItemFieldSingleSelectValue {
+				name
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
 				}
-				completedIterations {
-					id
-					title
-					startDate
-					duration
+			}
+			... on ProjectV2ItemFieldNumberValue {
+				number
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
+				}
+			}
+			... on ProjectV2ItemFieldIterationValue {
+				iterationId
+				field {
+					__typename
+					... on ProjectV2FieldCommon {
+						id
+						name
+					}
 				}
 			}
 		}
 	}
-	items(first: 100, after: $cursor) {
-		pageInfo {
-			hasNextPage
-			endCursor
-		}
-		nodes {
+	content {
+		__typename
+		... on Issue {
 			id
-			status: fieldValueByName(name: "Status") {
-				__typename
-				... on ProjectV2ItemFieldSingleSelectValue {
+			title
+			createdAt
+			closedAt
+			updatedAt
+			parent {
+				id
+			}
+			trackedInIssues(first: 1) {
+				nodes {
+					id
+				}
+			}
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
 					name
 				}
 			}
-			effort: fieldValueByName(name: "Effort") {
-				__typename
-				... on ProjectV2ItemFieldNumberValue {
-					number
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
 				}
 			}
-			remaining: fieldValueByName(name: "RemainingHours") {
-				__typename
-				... on ProjectV2ItemFieldNumberValue {
-					number
+		}
+		... on PullRequest {
+			title
+			createdAt
+			closedAt
+			updatedAt
+			milestone {
+				id
+				title
+				state
+				createdAt
+				dueOn
+			}
+			labels(first: $labels_per_issue_count) {
+				nodes {
+					name
 				}
 			}
-			iteration: fieldValueByName(name: "Iteration") {
-				__typename
-				... on ProjectV2ItemFieldIterationValue {
-					iterationId
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
 				}
 			}
-			content {
-				__typename
-				... on Issue {
-					title
-					createdAt
-					closedAt
-					labels(first: $labels_per_issue_count) {
-						nodes {
-							name
-						}
-					}
+		}
+		... on DraftIssue {
+			title
+			createdAt
+			updatedAt
+			assignees(first: $assignees_per_issue_count) {
+				nodes {
+					login
 				}
 			}
 		}
@@ -2780,27 +19856,31 @@ fragment ProjectFields on ProjectV2 {
 }
 `
 
-func getOrganizationProject(
+func getRepositoryProject(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	organization_name string,
+	repo_owner string,
+	repo_name string,
 	project_number int,
 	labels_per_issue_count int,
+	assignees_per_issue_count int,
 	cursor string,
-) (*getOrganizationProjectResponse, error) {
+) (*getRepositoryProjectResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getOrganizationProject",
-		Query:  getOrganizationProject_Operation,
-		Variables: &__getOrganizationProjectInput{
-			Organization_name:      organization_name,
-			Project_number:         project_number,
-			Labels_per_issue_count: labels_per_issue_count,
-			Cursor:                 cursor,
+		OpName: "getRepositoryProject",
+		Query:  getRepositoryProject_Operation,
+		Variables: &__getRepositoryProjectInput{
+			Repo_owner:                repo_owner,
+			Repo_name:                 repo_name,
+			Project_number:            project_number,
+			Labels_per_issue_count:    labels_per_issue_count,
+			Assignees_per_issue_count: assignees_per_issue_count,
+			Cursor:                    cursor,
 		},
 	}
 	var err_ error
 
-	var data_ getOrganizationProjectResponse
+	var data_ getRepositoryProjectResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -2812,86 +19892,268 @@ func getOrganizationProject(
 	return &data_, err_
 }
 
-// The query or mutation executed by getRepositoryProject.
-const getRepositoryProject_Operation = `
-query getRepositoryProject ($repo_owner: String!, $repo_name: String!, $project_number: Int!, $labels_per_issue_count: Int!, $cursor: String) {
+// The query or mutation executed by getRepositoryProjectItemVersions.
+const getRepositoryProjectItemVersions_Operation = `
+query getRepositoryProjectItemVersions ($repo_owner: String!, $repo_name: String!, $project_number: Int!, $cursor: String) {
 	repository(owner: $repo_owner, name: $repo_name) {
 		projectV2(number: $project_number) {
-			... ProjectFields
+			... ProjectItemVersions
 		}
 	}
 }
-fragment ProjectFields on ProjectV2 {
+fragment ProjectItemVersions on ProjectV2 {
+	... ProjectFieldDefinitions
+	items(first: 100, after: $cursor) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			... ProjectItemVersion
+		}
+	}
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
 	id
 	title
-	status: field(name: "Status") {
-		__typename
-		... on ProjectV2SingleSelectField {
-			name
-			options {
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
+				}
+			}
+			... on ProjectV2IterationField {
+				id
 				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
+				}
 			}
 		}
 	}
-	iteration: field(name: "Iteration") {
+}
+fragment ProjectItemVersion on ProjectV2Item {
+	id
+	updatedAt
+	content {
 		__typename
-		... on ProjectV2IterationField {
-			name
-			configuration {
-				iterations {
-					id
-					title
-					startDate
-					duration
+		... on Issue {
+			updatedAt
+		}
+		... on PullRequest {
+			updatedAt
+		}
+		... on DraftIssue {
+			updatedAt
+		}
+	}
+}
+`
+
+func getRepositoryProjectItemVersions(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repo_owner string,
+	repo_name string,
+	project_number int,
+	cursor string,
+) (*getRepositoryProjectItemVersionsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getRepositoryProjectItemVersions",
+		Query:  getRepositoryProjectItemVersions_Operation,
+		Variables: &__getRepositoryProjectItemVersionsInput{
+			Repo_owner:     repo_owner,
+			Repo_name:      repo_name,
+			Project_number: project_number,
+			Cursor:         cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getRepositoryProjectItemVersionsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getRepositoryProjectSettings.
+const getRepositoryProjectSettings_Operation = `
+query getRepositoryProjectSettings ($repo_owner: String!, $repo_name: String!, $project_number: Int!) {
+	repository(owner: $repo_owner, name: $repo_name) {
+		projectV2(number: $project_number) {
+			... ProjectSettings
+		}
+	}
+}
+fragment ProjectSettings on ProjectV2 {
+	... ProjectFieldDefinitions
+}
+fragment ProjectFieldDefinitions on ProjectV2 {
+	id
+	title
+	fields(first: 50) {
+		nodes {
+			__typename
+			... on ProjectV2Field {
+				id
+				name
+				dataType
+			}
+			... on ProjectV2SingleSelectField {
+				id
+				name
+				options {
+					name
 				}
-				completedIterations {
-					id
-					title
-					startDate
-					duration
+			}
+			... on ProjectV2IterationField {
+				id
+				name
+				configuration {
+					iterations {
+						id
+						title
+						startDate
+						duration
+					}
+					completedIterations {
+						id
+						title
+						startDate
+						duration
+					}
 				}
 			}
 		}
 	}
-	items(first: 100, after: $cursor) {
+}
+`
+
+func getRepositoryProjectSettings(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repo_owner string,
+	repo_name string,
+	project_number int,
+) (*getRepositoryProjectSettingsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getRepositoryProjectSettings",
+		Query:  getRepositoryProjectSettings_Operation,
+		Variables: &__getRepositoryProjectSettingsInput{
+			Repo_owner:     repo_owner,
+			Repo_name:      repo_name,
+			Project_number: project_number,
+		},
+	}
+	var err_ error
+
+	var data_ getRepositoryProjectSettingsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getRepositoryProjectTimeline.
+const getRepositoryProjectTimeline_Operation = `
+query getRepositoryProjectTimeline ($repo_owner: String!, $repo_name: String!, $project_number: Int!, $cursor: String) {
+	repository(owner: $repo_owner, name: $repo_name) {
+		projectV2(number: $project_number) {
+			... ProjectItemsTimeline
+		}
+	}
+}
+fragment ProjectItemsTimeline on ProjectV2 {
+	items(first: 50, after: $cursor) {
 		pageInfo {
 			hasNextPage
 			endCursor
 		}
 		nodes {
-			id
-			status: fieldValueByName(name: "Status") {
-				__typename
-				... on ProjectV2ItemFieldSingleSelectValue {
-					name
-				}
-			}
-			effort: fieldValueByName(name: "Effort") {
-				__typename
-				... on ProjectV2ItemFieldNumberValue {
-					number
-				}
-			}
-			remaining: fieldValueByName(name: "RemainingHours") {
-				__typename
-				... on ProjectV2ItemFieldNumberValue {
-					number
-				}
-			}
-			iteration: fieldValueByName(name: "Iteration") {
-				__typename
-				... on ProjectV2ItemFieldIterationValue {
-					iterationId
+			... ProjectItemTimeline
+		}
+	}
+}
+fragment ProjectItemTimeline on ProjectV2Item {
+	id
+	createdAt
+	content {
+		__typename
+		... on Issue {
+			timelineItems(first: 100, itemTypes: [CLOSED_EVENT,REOPENED_EVENT,LABELED_EVENT,UNLABELED_EVENT]) {
+				nodes {
+					__typename
+					... on ClosedEvent {
+						createdAt
+					}
+					... on ReopenedEvent {
+						createdAt
+					}
+					... on LabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+					... on UnlabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
 				}
 			}
-			content {
-				__typename
-				... on Issue {
-					title
-					createdAt
-					closedAt
-					labels(first: $labels_per_issue_count) {
-						nodes {
+		}
+		... on PullRequest {
+			timelineItems(first: 100, itemTypes: [CLOSED_EVENT,REOPENED_EVENT,LABELED_EVENT,UNLABELED_EVENT]) {
+				nodes {
+					__typename
+					... on ClosedEvent {
+						createdAt
+					}
+					... on ReopenedEvent {
+						createdAt
+					}
+					... on LabeledEvent {
+						createdAt
+						label {
+							name
+						}
+					}
+					... on UnlabeledEvent {
+						createdAt
+						label {
 							name
 						}
 					}
@@ -2902,29 +20164,27 @@ fragment ProjectFields on ProjectV2 {
 }
 `
 
-func getRepositoryProject(
+func getRepositoryProjectTimeline(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	repo_owner string,
 	repo_name string,
 	project_number int,
-	labels_per_issue_count int,
 	cursor string,
-) (*getRepositoryProjectResponse, error) {
+) (*getRepositoryProjectTimelineResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getRepositoryProject",
-		Query:  getRepositoryProject_Operation,
-		Variables: &__getRepositoryProjectInput{
-			Repo_owner:             repo_owner,
-			Repo_name:              repo_name,
-			Project_number:         project_number,
-			Labels_per_issue_count: labels_per_issue_count,
-			Cursor:                 cursor,
+		OpName: "getRepositoryProjectTimeline",
+		Query:  getRepositoryProjectTimeline_Operation,
+		Variables: &__getRepositoryProjectTimelineInput{
+			Repo_owner:     repo_owner,
+			Repo_name:      repo_name,
+			Project_number: project_number,
+			Cursor:         cursor,
 		},
 	}
 	var err_ error
 
-	var data_ getRepositoryProjectResponse
+	var data_ getRepositoryProjectTimelineResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// getItemParent returns the node ids of the issue behind a project item and
+// of its parent issue. A sub-issue parent takes precedence over the first
+// issue tracking the item in a task list.
+func getItemParent(item *ProjectItem) (string, string) {
+	content, ok := item.Content.(*ProjectItemContentIssue)
+	if !ok {
+		return "", ""
+	}
+
+	if content.Parent != nil {
+		return content.Id, content.Parent.Id
+	}
+
+	if len(content.TrackedInIssues.Nodes) > 0 {
+		return content.Id, content.TrackedInIssues.Nodes[0].Id
+	}
+
+	return content.Id, ""
+}
+
+// linkParents points each issue to the project item of its parent issue and
+// to the top level item of its hierarchy. Parents that are not items of the
+// project are ignored.
+func linkParents(issues []models.Issue, itemIds map[string]string, parentIds map[string]string) {
+	indexes := make(map[string]int)
+	for i := range issues {
+		indexes[issues[i].Id] = i
+		parentItemId, ok := itemIds[parentIds[issues[i].Id]]
+
+		if ok && parentItemId != issues[i].Id {
+			issues[i].ParentId = parentItemId
+		}
+	}
+
+	breakParentCycles(issues, indexes)
+
+	for i := range issues {
+		issues[i].RootId = getRootId(issues, indexes, i)
+	}
+}
+
+// breakParentCycles unlinks the item with the lowest id of each cycle of
+// tracked issues, e.g. two issues tracking each other, from its parent. The
+// item becomes the top level item of the hierarchy so that the rollup counts
+// the cycle once instead of not at all.
+func breakParentCycles(issues []models.Issue, indexes map[string]int) {
+	visited := make(map[int]bool)
+	for start := range issues {
+		positions := make(map[int]int)
+		path := []int{}
+
+		for current, ok := start, true; ok && !visited[current]; current, ok = indexes[issues[current].ParentId] {
+			if position, seen := positions[current]; seen {
+				first := slices.MinFunc(path[position:], func(a int, b int) int {
+					return strings.Compare(issues[a].Id, issues[b].Id)
+				})
+				issues[first].ParentId = ""
+				break
+			}
+
+			positions[current] = len(path)
+			path = append(path, current)
+		}
+
+		for _, index := range path {
+			visited[index] = true
+		}
+	}
+}
+
+// getRootId returns the id of the top level item above an issue, or an empty
+// string when the issue is a top level item itself.
+func getRootId(issues []models.Issue, indexes map[string]int, index int) string {
+	root := index
+	for parent, ok := indexes[issues[root].ParentId]; ok; parent, ok = indexes[issues[root].ParentId] {
+		root = parent
+	}
+
+	if root == index {
+		return ""
+	}
+
+	return issues[root].Id
+}
@@ -0,0 +1,165 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// linkHierarchyTestIssues links issues whose items and contents share ids to
+// the given parents.
+func linkHierarchyTestIssues(parentIds map[string]string, ids ...string) []models.Issue {
+	issues := []models.Issue{}
+	itemIds := make(map[string]string)
+	for _, id := range ids {
+		issues = append(issues, models.Issue{Id: id})
+		itemIds[id] = id
+	}
+
+	linkParents(issues, itemIds, parentIds)
+
+	return issues
+}
+
+func getIssueLinks(issues []models.Issue) map[string][2]string {
+	result := make(map[string][2]string)
+	for _, issue := range issues {
+		result[issue.Id] = [2]string{issue.ParentId, issue.RootId}
+	}
+
+	return result
+}
+
+func TestLinkParentsRoots(t *testing.T) {
+	issues := linkHierarchyTestIssues(map[string]string{
+		"feature": "epic",
+		"task1":   "feature",
+		"task2":   "feature",
+	}, "epic", "feature", "task1", "task2", "standalone")
+
+	assert.Equal(t, map[string][2]string{
+		"epic":       {"", ""},
+		"feature":    {"epic", "epic"},
+		"task1":      {"feature", "epic"},
+		"task2":      {"feature", "epic"},
+		"standalone": {"", ""},
+	}, getIssueLinks(issues))
+}
+
+func TestLinkParentsBreaksCycles(t *testing.T) {
+	// a tracks b and b tracks a, c hangs from the cycle
+	issues := linkHierarchyTestIssues(map[string]string{
+		"a": "b",
+		"b": "a",
+		"c": "b",
+	}, "c", "b", "a")
+
+	assert.Equal(t, map[string][2]string{
+		"a": {"", ""},
+		"b": {"a", "a"},
+		"c": {"b", "a"},
+	}, getIssueLinks(issues))
+}
+
+func TestLinkParentsBreaksLongCycles(t *testing.T) {
+	issues := linkHierarchyTestIssues(map[string]string{
+		"a": "c",
+		"b": "a",
+		"c": "b",
+	}, "b", "c", "a")
+
+	assert.Equal(t, map[string][2]string{
+		"a": {"", ""},
+		"b": {"a", "a"},
+		"c": {"b", "a"},
+	}, getIssueLinks(issues))
+}
+
+func TestParseProjectInformationLinksParents(t *testing.T) {
+	client := getSyncTestClient(nil)
+	projectFields := &client.result.Organization.ProjectV2.ProjectFields
+	projectFields.Items.Nodes = []ProjectItem{
+		{
+			Id:      "item1",
+			Content: &ProjectItemContentIssue{Typename: "Issue", Id: "issue1", Title: "Epic"},
+		},
+		{
+			Id: "item2",
+			Content: &ProjectItemContentIssue{
+				Typename: "Issue",
+				Id:       "issue2",
+				Title:    "Sub-issue",
+				Parent:   &ProjectItemParent{Id: "issue1"},
+			},
+		},
+		{
+			Id: "item3",
+			Content: &ProjectItemContentIssue{
+				Typename: "Issue",
+				Id:       "issue3",
+				Title:    "Tracked issue",
+				TrackedInIssues: ProjectItemContentIssueTrackedInIssuesIssueConnection{
+					Nodes: []ProjectItemParent{{Id: "issue2"}},
+				},
+			},
+		},
+		{
+			Id: "item4",
+			Content: &ProjectItemContentIssue{
+				Typename: "Issue",
+				Id:       "issue4",
+				Title:    "Sub-issue of another project",
+				Parent:   &ProjectItemParent{Id: "other"},
+			},
+		},
+	}
+
+	project, err := parseProjectInformation(projectFields, models.FieldMapping{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", project.Issues[0].ParentId)
+	assert.Equal(t, "item1", project.Issues[1].ParentId)
+	assert.Equal(t, "item2", project.Issues[2].ParentId)
+	assert.Equal(t, "", project.Issues[3].ParentId)
+	assert.Equal(t, "item1", project.Issues[2].RootId)
+}
+
+func getEffortTestValue(number float64) ProjectItemFieldValuesProjectV2ItemFieldValueConnection {
+	return ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+		Nodes: []ProjectItemFieldValue{
+			&ProjectItemFieldValueProjectV2ItemFieldNumberValue{
+				Number: number,
+				Field:  &ProjectItemFieldReferenceProjectV2Field{Id: "effort"},
+			},
+		},
+	}
+}
+
+func TestExecuteRollupSavesRawEffort(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := newProjectTestJob(t, querier, []ProjectItem{
+		{
+			Id:          "item1",
+			FieldValues: getEffortTestValue(8),
+			Content:     &ProjectItemContentIssue{Typename: "Issue", Id: "issue1", Title: "Epic"},
+		},
+		{
+			Id:          "item2",
+			FieldValues: getEffortTestValue(3),
+			Content:     &ProjectItemContentIssue{Typename: "Issue", Id: "issue2", Title: "Task", Parent: &ProjectItemParent{Id: "issue1"}},
+		},
+	}, useProjectClient)
+	dataPullJob.projects[0].Rollup = models.RollupDerived
+
+	executeAndWait(t, dataPullJob)
+
+	// the rollup is applied when the charts are read
+	assert.Equal(t, pgtype.Text{String: "derived", Valid: true}, querier.UpsertProjectValue.Rollup)
+	assert.Len(t, querier.UpsertWorkItemsValue, 2)
+	assert.Equal(t, pgtype.Int4{Int32: 8, Valid: true}, querier.UpsertWorkItemsValue[0].Effort)
+	assert.Equal(t, pgtype.Int4{Int32: 3, Valid: true}, querier.UpsertWorkItemsValue[1].Effort)
+	assert.Equal(t, pgtype.Text{String: "item1", Valid: true}, querier.UpsertWorkItemsValue[1].ParentGhID)
+	assert.Equal(t, pgtype.Text{String: "item1", Valid: true}, querier.UpsertWorkItemsValue[1].RootGhID)
+}
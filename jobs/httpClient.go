@@ -0,0 +1,85 @@
+package jobs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+const defaultApiUrl = "https://api.github.com"
+
+// getApiUrl returns the REST API base url, e.g. https://api.github.com for
+// github.com or https://github.example.com/api/v3 for GitHub Enterprise Server.
+func getApiUrl(connection models.ConnectionConfig) string {
+	if connection.ApiUrl == "" {
+		return defaultApiUrl
+	}
+
+	return strings.TrimSuffix(connection.ApiUrl, "/")
+}
+
+// getGraphqlUrl returns the configured GraphQL endpoint or derives it from the
+// REST API base url. GitHub Enterprise Server serves GraphQL from /api/graphql
+// while its REST API lives under /api/v3.
+func getGraphqlUrl(connection models.ConnectionConfig) string {
+	if connection.GraphqlUrl != "" {
+		return connection.GraphqlUrl
+	}
+
+	apiUrl := getApiUrl(connection)
+	if base, ok := strings.CutSuffix(apiUrl, "/api/v3"); ok {
+		return base + "/api/graphql"
+	}
+
+	return apiUrl + "/graphql"
+}
+
+func newHttpTransport(connection models.ConnectionConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if connection.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(connection.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if connection.CaBundleFile != "" {
+		rootCAs, err := loadCaBundle(connection.CaBundleFile)
+		if err != nil {
+			return nil, err
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+
+	return transport, nil
+}
+
+// loadCaBundle adds the certificates in the bundle to the system pool so that
+// a custom CA does not break access to publicly trusted hosts.
+func loadCaBundle(caBundleFile string) (*x509.CertPool, error) {
+	content, err := os.ReadFile(caBundleFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !rootCAs.AppendCertsFromPEM(content) {
+		return nil, fmt.Errorf("CA bundle %s does not contain any PEM certificate", caBundleFile)
+	}
+
+	return rootCAs, nil
+}
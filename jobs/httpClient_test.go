@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const fakeOrganizationProjectResponse = `{"data":{"organization":{"projectV2":{
+	"id":"1",
+	"title":"Enterprise Project",
+	"fields":{"nodes":[
+		{"__typename":"ProjectV2SingleSelectField","id":"status","name":"Status","options":[]},
+		{"__typename":"ProjectV2IterationField","id":"iteration","name":"Iteration","configuration":{"iterations":[],"completedIterations":[]}},
+		{"__typename":"ProjectV2Field","id":"effort","name":"Effort"},
+		{"__typename":"ProjectV2Field","id":"remaining","name":"RemainingHours"}
+	]},
+	"items":{"pageInfo":{"hasNextPage":false,"endCursor":null},"nodes":[]}
+}}}}`
+
+func writeServerCertificate(t *testing.T, server *httptest.Server) string {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	content := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.Nil(t, os.WriteFile(file, content, 0600))
+
+	return file
+}
+
+func TestGetGraphqlUrl(t *testing.T) {
+	assert.Equal(t, "https://api.github.com/graphql", getGraphqlUrl(models.ConnectionConfig{}))
+	assert.Equal(t, "https://github.example.com/api/graphql", getGraphqlUrl(models.ConnectionConfig{ApiUrl: "https://github.example.com/api/v3/"}))
+	assert.Equal(t, "http://localhost:8080/graphql", getGraphqlUrl(models.ConnectionConfig{ApiUrl: "http://localhost:8080"}))
+	assert.Equal(t, "http://localhost:8080/query", getGraphqlUrl(models.ConnectionConfig{ApiUrl: "http://localhost:8080", GraphqlUrl: "http://localhost:8080/query"}))
+}
+
+func TestExecuteWillUseConfiguredEndpointAndCaBundle(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/graphql", r.URL.Path)
+		assert.Equal(t, "bearer token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fakeOrganizationProjectResponse))
+	}))
+	defer server.Close()
+
+	querier := &MockQuerier{}
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{
+			OrgName: "org",
+			Project: "1",
+			Token:   "token",
+			Connection: models.ConnectionConfig{
+				ApiUrl:       server.URL + "/api/v3",
+				CaBundleFile: writeServerCertificate(t, server),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, 1, requests)
+	assert.NotNil(t, querier.UpsertProjectValue)
+	assert.Equal(t, "Enterprise Project", querier.UpsertProjectValue.Name)
+}
+
+func TestExecuteWillRejectUntrustedCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(fakeOrganizationProjectResponse))
+	}))
+	defer server.Close()
+
+	querier := &MockQuerier{}
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{
+			OrgName:    "org",
+			Project:    "1",
+			Token:      "token",
+			Connection: models.ConnectionConfig{GraphqlUrl: server.URL},
+		},
+	})
+	assert.Nil(t, err)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Empty(t, querier.UpsertProjectValue.GhID)
+}
+
+func TestInitInvalidCaBundle(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	assert.Nil(t, os.WriteFile(file, []byte("not a certificate"), 0600))
+
+	_, err := NewDataPullJob("* * * * *", &MockQuerier{}, []models.JobConfigItem{
+		{
+			OrgName:    "org",
+			Project:    "1",
+			Token:      "token",
+			Connection: models.ConnectionConfig{CaBundleFile: file},
+		},
+	})
+
+	assert.EqualError(t, err, "CA bundle "+file+" does not contain any PEM certificate")
+}
@@ -0,0 +1,338 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// itemsPerRequestCount is the most node ids GitHub resolves in one request.
+const itemsPerRequestCount = 100
+
+// projectPull is what a pull fetched from GitHub for a project. Items that
+// did not change since the previous pull are only listed by id and carried
+// forward from their last snapshot.
+type projectPull struct {
+	project        *models.Project
+	carriedItemIds []string
+	syncState      *db.UpsertProjectSyncStateParams
+	// capturedAt is set when the project keeps intraday snapshots
+	capturedAt time.Time
+}
+
+func (p *projectPull) itemCount() int {
+	return len(p.project.Issues) + len(p.carriedItemIds)
+}
+
+// getProjectPull fetches every item of the project when a full resync is due
+// and only the items updated since the previous pull otherwise.
+func (c *DataPullJob) getProjectPull(ctx context.Context, project models.JobConfigItem, now time.Time) (*projectPull, error) {
+	state, err := c.queries.GetProjectSyncState(ctx, project.GetUniqueName())
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("Error on GetProjectSyncState", "error", err)
+		return nil, err
+	}
+
+	// rollups need every item of a hierarchy, so they are never incremental
+	if project.Rollup != models.RollupNone || c.isFullPullDue(state, now) {
+		return c.getFullProjectPull(ctx, project, now)
+	}
+
+	return c.getIncrementalProjectPull(ctx, project, state)
+}
+
+// isFullPullDue tells whether every item of a project must be pulled, either
+// because it was never pulled or because its last full pull is too old.
+func (c *DataPullJob) isFullPullDue(state db.ProjectSyncState, now time.Time) bool {
+	return c.fullSyncInterval <= 0 ||
+		!state.ItemsUpdatedAt.Valid ||
+		!state.FullSyncedAt.Valid ||
+		now.Sub(state.FullSyncedAt.Time) >= c.fullSyncInterval
+}
+
+func (c *DataPullJob) getFullProjectPull(ctx context.Context, project models.JobConfigItem, now time.Time) (*projectPull, error) {
+	projectId, _ := strconv.Atoi(project.Project)
+	var projectFields *ProjectFields
+	var err error
+	if project.OrgName != "" {
+		slog.Info("Data pull job started", "orgName", project.OrgName, "projectId", projectId)
+		var orgProject *getOrganizationProjectResponse
+		orgProject, err = getOrgProject(ctx, c.graphqlClient(project), project.OrgName, projectId)
+
+		if err == nil {
+			projectFields = &orgProject.Organization.ProjectV2.ProjectFields
+		}
+	} else {
+		slog.Info("Data pull job started", "repoOwner", project.RepoOwner, "repoName", project.RepoName, "projectId", projectId)
+		var repoProject *getRepositoryProjectResponse
+		repoProject, err = getRepoProject(ctx, c.graphqlClient(project), project.RepoOwner, project.RepoName, projectId)
+
+		if err == nil {
+			projectFields = &repoProject.Repository.ProjectV2.ProjectFields
+		}
+	}
+
+	if err != nil {
+		slog.Error("Error fetching project information", "error", err)
+		return nil, err
+	}
+
+	parsedProject, err := parseProjectInformation(projectFields, project.Fields)
+	if err != nil {
+		slog.Error("Error parsing project information", "error", err)
+		return nil, err
+	}
+
+	itemsUpdatedAt := time.Time{}
+	for _, item := range projectFields.Items.Nodes {
+		itemsUpdatedAt = latestTime(itemsUpdatedAt, getItemUpdatedAt(item.UpdatedAt, item.Content))
+	}
+
+	return &projectPull{
+		project: parsedProject,
+		syncState: &db.UpsertProjectSyncStateParams{
+			ProjectName:    project.GetUniqueName(),
+			ItemsUpdatedAt: pgtype.Timestamp{Time: itemsUpdatedAt, Valid: true},
+			FullSyncedAt:   pgtype.Timestamp{Time: now, Valid: true},
+		},
+	}, nil
+}
+
+// getIncrementalProjectPull lists the items of the project with when they
+// were last updated and only fetches the items updated since the high-water
+// mark of the previous pull. GitHub cannot filter project items by date so
+// the listing still pages through every item, but without their fields,
+// content and labels.
+func (c *DataPullJob) getIncrementalProjectPull(ctx context.Context, project models.JobConfigItem, state db.ProjectSyncState) (*projectPull, error) {
+	projectId, _ := strconv.Atoi(project.Project)
+	var versions *ProjectItemVersions
+	var err error
+	if project.OrgName != "" {
+		slog.Info("Incremental data pull job started", "orgName", project.OrgName, "projectId", projectId, "since", state.ItemsUpdatedAt.Time)
+		versions, err = getOrgProjectItemVersions(ctx, c.graphqlClient(project), project.OrgName, projectId)
+	} else {
+		slog.Info("Incremental data pull job started", "repoOwner", project.RepoOwner, "repoName", project.RepoName, "projectId", projectId, "since", state.ItemsUpdatedAt.Time)
+		versions, err = getRepoProjectItemVersions(ctx, c.graphqlClient(project), project.RepoOwner, project.RepoName, projectId)
+	}
+
+	if err != nil {
+		slog.Error("Error fetching project item versions", "error", err)
+		return nil, err
+	}
+
+	itemsUpdatedAt := state.ItemsUpdatedAt.Time
+	changedItemIds := []string{}
+	carriedItemIds := []string{}
+	for _, item := range versions.Items.Nodes {
+		updatedAt := getItemUpdatedAt(item.UpdatedAt, item.Content)
+
+		// items updated on the second of the high-water mark are pulled again
+		// as they may have changed after the previous pull read them
+		if updatedAt.Before(state.ItemsUpdatedAt.Time) {
+			carriedItemIds = append(carriedItemIds, item.Id)
+			continue
+		}
+
+		changedItemIds = append(changedItemIds, item.Id)
+		itemsUpdatedAt = latestTime(itemsUpdatedAt, updatedAt)
+	}
+
+	items, err := getChangedProjectItems(ctx, c.graphqlClient(project), changedItemIds)
+	if err != nil {
+		slog.Error("Error fetching changed project items", "error", err)
+		return nil, err
+	}
+
+	parsedProject, err := parseProjectInformation(&ProjectFields{
+		ProjectFieldDefinitions: versions.ProjectFieldDefinitions,
+		Items: ProjectFieldsItemsProjectV2ItemConnection{
+			Nodes: items,
+		},
+	}, project.Fields)
+	if err != nil {
+		slog.Error("Error parsing project information", "error", err)
+		return nil, err
+	}
+
+	slog.Info("Incremental data pull fetched changed items", "project", project.GetUniqueName(), "changed", len(changedItemIds), "unchanged", len(carriedItemIds))
+
+	return &projectPull{
+		project:        parsedProject,
+		carriedItemIds: carriedItemIds,
+		syncState: &db.UpsertProjectSyncStateParams{
+			ProjectName:    project.GetUniqueName(),
+			ItemsUpdatedAt: pgtype.Timestamp{Time: itemsUpdatedAt, Valid: true},
+			FullSyncedAt:   state.FullSyncedAt,
+		},
+	}, nil
+}
+
+// getItemUpdatedAt returns when a project item or its content last changed.
+// Changes to an issue such as a new label do not update the project item.
+func getItemUpdatedAt(updatedAt time.Time, content any) time.Time {
+	if content, ok := content.(interface{ GetUpdatedAt() time.Time }); ok {
+		return latestTime(updatedAt, content.GetUpdatedAt())
+	}
+
+	return updatedAt
+}
+
+func latestTime(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
+
+func getOrgProjectItemVersions(ctx context.Context, graphqlClient graphql.Client, orgName string, projectId int) (*ProjectItemVersions, error) {
+	hasNextPage := true
+	cursor := ""
+	var versions *ProjectItemVersions
+
+	for hasNextPage {
+		result, err := getOrganizationProjectItemVersions(ctx, graphqlClient, orgName, projectId, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		page := &result.Organization.ProjectV2.ProjectItemVersions
+		if versions == nil {
+			versions = page
+		} else {
+			versions.Items.Nodes = append(versions.Items.Nodes, page.Items.Nodes...)
+		}
+
+		hasNextPage = page.Items.PageInfo.HasNextPage
+		cursor = page.Items.PageInfo.EndCursor
+	}
+
+	return versions, nil
+}
+
+func getRepoProjectItemVersions(ctx context.Context, graphqlClient graphql.Client, repoOwner string, repoName string, projectId int) (*ProjectItemVersions, error) {
+	hasNextPage := true
+	cursor := ""
+	var versions *ProjectItemVersions
+
+	for hasNextPage {
+		result, err := getRepositoryProjectItemVersions(ctx, graphqlClient, repoOwner, repoName, projectId, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		page := &result.Repository.ProjectV2.ProjectItemVersions
+		if versions == nil {
+			versions = page
+		} else {
+			versions.Items.Nodes = append(versions.Items.Nodes, page.Items.Nodes...)
+		}
+
+		hasNextPage = page.Items.PageInfo.HasNextPage
+		cursor = page.Items.PageInfo.EndCursor
+	}
+
+	return versions, nil
+}
+
+// getChangedProjectItems fetches the given project items in batches. Items
+// deleted since they were listed are skipped.
+func getChangedProjectItems(ctx context.Context, graphqlClient graphql.Client, itemIds []string) ([]ProjectItem, error) {
+	items := []ProjectItem{}
+
+	for start := 0; start < len(itemIds); start += itemsPerRequestCount {
+		end := min(start+itemsPerRequestCount, len(itemIds))
+
+		result, err := getProjectItems(ctx, graphqlClient, itemIds[start:end], labelsPerIssueCount, assigneesPerIssueCount)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range result.Nodes {
+			if item, ok := node.(*getProjectItemsNodesProjectV2Item); ok {
+				items = append(items, item.ProjectItem)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// carryForwardWorkItems copies the last snapshot of the items that did not
+// change since the previous pull into today's snapshot.
+func carryForwardWorkItems(ctx context.Context, queries db.Querier, projectId int32, itemIds []string, today time.Time) error {
+	if len(itemIds) == 0 {
+		return nil
+	}
+
+	carried, err := queries.CarryForwardWorkItems(ctx, db.CarryForwardWorkItemsParams{
+		ProjectID:  projectId,
+		GhIds:      itemIds,
+		ChangeDate: pgtype.Date{Time: today, Valid: true},
+	})
+
+	if err != nil {
+		slog.Error("Error on CarryForwardWorkItems", "error", err)
+		return err
+	}
+
+	// such items are missing from today's snapshot until a full pull saves them
+	if missing := int64(len(itemIds)) - carried; missing > 0 {
+		slog.Warn("Unchanged items have no earlier snapshot to carry forward", "project", projectId, "missing", missing)
+	}
+
+	return nil
+}
+
+// saveIntradaySnapshot copies today's snapshot of the pulled items into the
+// intraday history at the time of the pull.
+func saveIntradaySnapshot(ctx context.Context, queries db.Querier, projectId int32, pull *projectPull, today time.Time) error {
+	if pull.capturedAt.IsZero() {
+		return nil
+	}
+
+	itemIds := slices.Clone(pull.carriedItemIds)
+	for _, issue := range pull.project.Issues {
+		itemIds = append(itemIds, issue.Id)
+	}
+
+	err := queries.SaveIntradaySnapshot(ctx, db.SaveIntradaySnapshotParams{
+		CapturedAt: pgtype.Timestamp{Time: pull.capturedAt, Valid: true},
+		ProjectID:  projectId,
+		ChangeDate: pgtype.Date{Time: today, Valid: true},
+		GhIds:      itemIds,
+	})
+
+	if err != nil {
+		slog.Error("Error on SaveIntradaySnapshot", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// saveProjectSyncState moves the high-water mark of the project forward.
+// Pulls of single items have no state to save.
+func saveProjectSyncState(ctx context.Context, queries db.Querier, state *db.UpsertProjectSyncStateParams) error {
+	if state == nil {
+		return nil
+	}
+
+	err := queries.UpsertProjectSyncState(ctx, *state)
+
+	if err != nil {
+		slog.Error("Error on UpsertProjectSyncState", "error", err)
+		return err
+	}
+
+	return nil
+}
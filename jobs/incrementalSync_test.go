@@ -0,0 +1,257 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphqlIncrementalClient struct {
+	mockGraphqlOrgClient
+	versions   ProjectItemVersions
+	items      map[string]ProjectItem
+	operations []string
+	lookups    [][]string
+}
+
+func (m *mockGraphqlIncrementalClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	m.operations = append(m.operations, req.OpName)
+
+	switch data := resp.Data.(type) {
+	case *getOrganizationProjectItemVersionsResponse:
+		data.Organization.ProjectV2.ProjectItemVersions = m.versions
+		return nil
+	case *getProjectItemsResponse:
+		ids := req.Variables.(*__getProjectItemsInput).Item_ids
+		m.lookups = append(m.lookups, ids)
+
+		for _, id := range ids {
+			data.Nodes = append(data.Nodes, &getProjectItemsNodesProjectV2Item{
+				Typename:    "ProjectV2Item",
+				ProjectItem: m.items[id],
+			})
+		}
+
+		return nil
+	}
+
+	return m.mockGraphqlOrgClient.MakeRequest(ctx, req, resp)
+}
+
+var incrementalTestMark = time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+func getIncrementalTestItem(id string, updatedAt time.Time, contentUpdatedAt time.Time) ProjectItem {
+	return ProjectItem{
+		Id:        id,
+		UpdatedAt: updatedAt,
+		Content: &ProjectItemContentIssue{
+			Typename:  "Issue",
+			Title:     id,
+			UpdatedAt: contentUpdatedAt,
+		},
+	}
+}
+
+func getIncrementalTestJob(t *testing.T, querier *MockQuerier) (*DataPullJob, *mockGraphqlIncrementalClient) {
+	changed := getIncrementalTestItem("changed", incrementalTestMark.Add(-time.Hour), incrementalTestMark.Add(time.Hour))
+	items := []ProjectItem{
+		getIncrementalTestItem("unchanged", incrementalTestMark.Add(-time.Hour), incrementalTestMark.Add(-2*time.Hour)),
+		changed,
+	}
+
+	return newProjectTestJob(t, querier, items, func(projectClient mockGraphqlOrgClient) *mockGraphqlIncrementalClient {
+		return &mockGraphqlIncrementalClient{
+			mockGraphqlOrgClient: projectClient,
+			versions: ProjectItemVersions{
+				ProjectFieldDefinitions: projectClient.result.Organization.ProjectV2.ProjectFieldDefinitions,
+				Items: ProjectItemVersionsItemsProjectV2ItemConnection{
+					Nodes: []ProjectItemVersion{
+						{
+							Id:        "unchanged",
+							UpdatedAt: incrementalTestMark.Add(-time.Hour),
+							Content:   &ProjectItemVersionContentIssue{Typename: "Issue", UpdatedAt: incrementalTestMark.Add(-2 * time.Hour)},
+						},
+						{
+							Id:        "changed",
+							UpdatedAt: incrementalTestMark.Add(-time.Hour),
+							Content:   &ProjectItemVersionContentIssue{Typename: "Issue", UpdatedAt: incrementalTestMark.Add(time.Hour)},
+						},
+					},
+				},
+			},
+			items: map[string]ProjectItem{"changed": changed},
+		}
+	})
+}
+
+func getIncrementalTestState(fullSyncedAt time.Time) db.ProjectSyncState {
+	return db.ProjectSyncState{
+		ProjectName:    "org/1",
+		ItemsUpdatedAt: pgtype.Timestamp{Time: incrementalTestMark, Valid: true},
+		FullSyncedAt:   pgtype.Timestamp{Time: fullSyncedAt, Valid: true},
+	}
+}
+
+func TestExecuteFirstPullIsFull(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, "org/1", querier.GetProjectSyncStateValue)
+	assert.Equal(t, []string{"getOrganizationProject"}, client.operations)
+	assert.Len(t, querier.UpsertWorkItemsValue, 2)
+	assert.Nil(t, querier.CarryForwardWorkItemsValue)
+	assert.Len(t, querier.UpsertProjectSyncStateValue, 1)
+	assert.Equal(t, "org/1", querier.UpsertProjectSyncStateValue[0].ProjectName)
+	assert.Equal(t, incrementalTestMark.Add(time.Hour), querier.UpsertProjectSyncStateValue[0].ItemsUpdatedAt.Time)
+	assert.WithinDuration(t, time.Now(), querier.UpsertProjectSyncStateValue[0].FullSyncedAt.Time, time.Minute)
+}
+
+func TestExecuteIncrementalPull(t *testing.T) {
+	fullSyncedAt := time.Now().UTC().Add(-time.Hour)
+	querier := &MockQuerier{GetProjectSyncStateResult: getIncrementalTestState(fullSyncedAt)}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	assert.Equal(t, []string{"getOrganizationProjectItemVersions", "getProjectItems"}, client.operations)
+	assert.Equal(t, [][]string{{"changed"}}, client.lookups)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+	assert.Equal(t, "changed", querier.UpsertWorkItemsValue[0].GhID)
+	assert.Equal(t, []db.CarryForwardWorkItemsParams{
+		{ProjectID: 1, GhIds: []string{"unchanged"}, ChangeDate: pgtype.Date{Time: today, Valid: true}},
+	}, querier.CarryForwardWorkItemsValue)
+	assert.Equal(t, []db.UpsertProjectSyncStateParams{
+		{
+			ProjectName:    "org/1",
+			ItemsUpdatedAt: pgtype.Timestamp{Time: incrementalTestMark.Add(time.Hour), Valid: true},
+			FullSyncedAt:   pgtype.Timestamp{Time: fullSyncedAt, Valid: true},
+		},
+	}, querier.UpsertProjectSyncStateValue)
+	assert.Equal(t, int32(2), querier.InsertSyncRunValue[0].ItemCount)
+}
+
+func TestExecuteIncrementalPullRefetchesItemsAtMark(t *testing.T) {
+	querier := &MockQuerier{GetProjectSyncStateResult: getIncrementalTestState(time.Now().UTC())}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+	client.versions.Items.Nodes[1].Content = &ProjectItemVersionContentIssue{Typename: "Issue", UpdatedAt: incrementalTestMark}
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, [][]string{{"changed"}}, client.lookups)
+	assert.Equal(t, incrementalTestMark, querier.UpsertProjectSyncStateValue[0].ItemsUpdatedAt.Time)
+}
+
+func TestExecuteIncrementalPullWithoutChanges(t *testing.T) {
+	querier := &MockQuerier{GetProjectSyncStateResult: getIncrementalTestState(time.Now().UTC())}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+	client.versions.Items.Nodes = client.versions.Items.Nodes[:1]
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, []string{"getOrganizationProjectItemVersions"}, client.operations)
+	assert.Nil(t, querier.UpsertWorkItemsValue)
+	assert.Len(t, querier.CarryForwardWorkItemsValue, 1)
+	assert.Equal(t, incrementalTestMark, querier.UpsertProjectSyncStateValue[0].ItemsUpdatedAt.Time)
+}
+
+func TestExecuteIncrementalPullKeepsCarriedItemsInScope(t *testing.T) {
+	querier := &MockQuerier{
+		GetProjectSyncStateResult: getIncrementalTestState(time.Now().UTC()),
+		GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+			{
+				GhID:          "unchanged",
+				Name:          "unchanged",
+				IterationID:   pgtype.Int4{Int32: 3, Valid: true},
+				IterationGhID: pgtype.Text{String: "iteration", Valid: true},
+			},
+		},
+	}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, [][]string{{"changed"}}, client.lookups)
+	assert.Nil(t, querier.InsertWorkItemEventValue)
+}
+
+func TestExecuteFullPullWhenDue(t *testing.T) {
+	querier := &MockQuerier{GetProjectSyncStateResult: getIncrementalTestState(time.Now().UTC().Add(-25 * time.Hour))}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, []string{"getOrganizationProject"}, client.operations)
+	assert.Len(t, querier.UpsertWorkItemsValue, 2)
+	assert.WithinDuration(t, time.Now(), querier.UpsertProjectSyncStateValue[0].FullSyncedAt.Time, time.Minute)
+}
+
+func TestExecuteFullPullWhenIncrementalDisabled(t *testing.T) {
+	querier := &MockQuerier{GetProjectSyncStateResult: getIncrementalTestState(time.Now().UTC())}
+	dataPullJob, client := getIncrementalTestJob(t, querier)
+	dataPullJob.SetFullSyncInterval(0)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, []string{"getOrganizationProject"}, client.operations)
+}
+
+func TestGetChangedProjectItemsInBatches(t *testing.T) {
+	client := &mockGraphqlIncrementalClient{items: map[string]ProjectItem{}}
+	itemIds := []string{}
+	for i := range 150 {
+		itemIds = append(itemIds, fmt.Sprintf("item%d", i))
+	}
+
+	items, err := getChangedProjectItems(context.Background(), client, itemIds)
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 150)
+	assert.Len(t, client.lookups, 2)
+	assert.Len(t, client.lookups[0], 100)
+	assert.Len(t, client.lookups[1], 50)
+}
+
+func TestSaveIntradaySnapshot(t *testing.T) {
+	querier := &MockQuerier{}
+	capturedAt := time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	pull := &projectPull{
+		project:        &models.Project{Issues: []models.Issue{{Id: "changed"}}},
+		carriedItemIds: []string{"carried"},
+		capturedAt:     capturedAt,
+	}
+
+	err := saveIntradaySnapshot(context.Background(), querier, 1, pull, today)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.SaveIntradaySnapshotParams{{
+		CapturedAt: pgtype.Timestamp{Time: capturedAt, Valid: true},
+		ProjectID:  1,
+		ChangeDate: pgtype.Date{Time: today, Valid: true},
+		GhIds:      []string{"carried", "changed"},
+	}}, querier.SaveIntradaySnapshotValue)
+}
+
+func TestSaveIntradaySnapshotDisabled(t *testing.T) {
+	querier := &MockQuerier{}
+	pull := &projectPull{project: &models.Project{Issues: []models.Issue{{Id: "changed"}}}}
+
+	err := saveIntradaySnapshot(context.Background(), querier, 1, pull, time.Now())
+
+	assert.Nil(t, err)
+	assert.Empty(t, querier.SaveIntradaySnapshotValue)
+}
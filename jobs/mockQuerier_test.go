@@ -2,7 +2,10 @@ package jobs
 
 import (
 	"context"
+	"sync"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jlucaspains/github-charts/db"
 )
 
@@ -19,10 +22,167 @@ type MockQuerier struct {
 
 	UpsertWorkItemsValue []db.UpsertWorkItemParams
 	UpsertWorkItemsError error
+
+	DeleteWorkItemLabelsValue []int32
+	DeleteWorkItemLabelsError error
+
+	UpsertLabelValue []string
+	UpsertLabelError error
+
+	InsertWorkItemLabelValue []db.InsertWorkItemLabelParams
+	InsertWorkItemLabelError error
+
+	DeleteWorkItemAssigneesValue []int32
+	DeleteWorkItemAssigneesError error
+
+	UpsertAssigneeValue []string
+	UpsertAssigneeError error
+
+	InsertWorkItemAssigneeValue []db.InsertWorkItemAssigneeParams
+	InsertWorkItemAssigneeError error
+
+	UpsertWorkItemDatesValue []db.UpsertWorkItemDatesParams
+	UpsertWorkItemDatesError error
+
+	UpsertWorkItemStatusChangeValue []db.UpsertWorkItemStatusChangeParams
+	UpsertWorkItemStatusChangeError error
+
+	GetProjectFirstChangeDateValue  string
+	GetProjectFirstChangeDateResult pgtype.Date
+	GetProjectFirstChangeDateError  error
+
+	ExecTxCount     int
+	ExecTxRollbacks int
+	ExecTxError     error
+
+	mutex              sync.Mutex
+	InsertSyncRunValue []db.InsertSyncRunParams
+	InsertSyncRunError error
+
+	GetPreviousWorkItemsValue  db.GetPreviousWorkItemsParams
+	GetPreviousWorkItemsResult []db.GetPreviousWorkItemsRow
+	GetPreviousWorkItemsError  error
+
+	InsertWorkItemEventValue []db.InsertWorkItemEventParams
+	InsertWorkItemEventError error
+
+	DeleteWorkItemSnapshotValue []db.DeleteWorkItemSnapshotParams
+	DeleteWorkItemSnapshotError error
+
+	GetProjectSyncStateValue  string
+	GetProjectSyncStateResult db.ProjectSyncState
+	GetProjectSyncStateError  error
+
+	UpsertProjectSyncStateValue []db.UpsertProjectSyncStateParams
+	UpsertProjectSyncStateError error
+
+	CarryForwardWorkItemsValue  []db.CarryForwardWorkItemsParams
+	CarryForwardWorkItemsResult int64
+	CarryForwardWorkItemsError  error
+
+	UpsertMilestoneValue []db.UpsertMilestoneParams
+	UpsertMilestoneError error
+
+	RegisteredProjects           []db.RegisteredProject
+	GetRegisteredProjectsError   error
+	InsertRegisteredProjectError error
+
+	SaveIntradaySnapshotValue []db.SaveIntradaySnapshotParams
+	SaveIntradaySnapshotError error
+
+	FillWorkItemHistoryGapsValue  []db.FillWorkItemHistoryGapsParams
+	FillWorkItemHistoryGapsResult []pgtype.Date
+	FillWorkItemHistoryGapsError  error
+
+	GetSyncedProjectIdsValue  []string
+	GetSyncedProjectIdsResult []db.GetSyncedProjectIdsRow
+	GetSyncedProjectIdsError  error
+
+	GetTrackedProjectsResult []db.GetTrackedProjectsRow
+	GetTrackedProjectsError  error
+	GetTrackedProjectsCount  int
+}
+
+// CarryForwardWorkItems implements Querier.
+func (m *MockQuerier) CarryForwardWorkItems(ctx context.Context, arg db.CarryForwardWorkItemsParams) (int64, error) {
+	m.CarryForwardWorkItemsValue = append(m.CarryForwardWorkItemsValue, arg)
+	return m.CarryForwardWorkItemsResult, m.CarryForwardWorkItemsError
+}
+
+// DeleteRegisteredProject implements Querier.
+func (m *MockQuerier) DeleteRegisteredProject(ctx context.Context, id int32) (int64, error) {
+	for index, project := range m.RegisteredProjects {
+		if project.ID == id {
+			m.RegisteredProjects = append(m.RegisteredProjects[:index], m.RegisteredProjects[index+1:]...)
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// DeleteWorkItemAssignees implements Querier.
+func (m *MockQuerier) DeleteWorkItemAssignees(ctx context.Context, workItemHistoryID int32) error {
+	m.DeleteWorkItemAssigneesValue = append(m.DeleteWorkItemAssigneesValue, workItemHistoryID)
+	return m.DeleteWorkItemAssigneesError
+}
+
+// DeleteWorkItemLabels implements Querier.
+func (m *MockQuerier) DeleteWorkItemLabels(ctx context.Context, workItemHistoryID int32) error {
+	m.DeleteWorkItemLabelsValue = append(m.DeleteWorkItemLabelsValue, workItemHistoryID)
+	return m.DeleteWorkItemLabelsError
+}
+
+// DeleteWorkItemSnapshot implements Querier.
+func (m *MockQuerier) DeleteWorkItemSnapshot(ctx context.Context, arg db.DeleteWorkItemSnapshotParams) error {
+	m.DeleteWorkItemSnapshotValue = append(m.DeleteWorkItemSnapshotValue, arg)
+	return m.DeleteWorkItemSnapshotError
+}
+
+// FillWorkItemHistoryGaps implements Querier.
+func (m *MockQuerier) FillWorkItemHistoryGaps(ctx context.Context, arg db.FillWorkItemHistoryGapsParams) ([]pgtype.Date, error) {
+	m.FillWorkItemHistoryGapsValue = append(m.FillWorkItemHistoryGapsValue, arg)
+	return m.FillWorkItemHistoryGapsResult, m.FillWorkItemHistoryGapsError
+}
+
+// ExecTx implements TxQuerier.
+func (m *MockQuerier) ExecTx(ctx context.Context, fn func(db.Querier) error) error {
+	m.ExecTxCount++
+
+	if m.ExecTxError != nil {
+		return m.ExecTxError
+	}
+
+	err := fn(m)
+	if err != nil {
+		m.ExecTxRollbacks++
+	}
+
+	return err
+}
+
+// GetCycleTimeItems implements Querier.
+func (m *MockQuerier) GetCycleTimeItems(ctx context.Context, arg db.GetCycleTimeItemsParams) ([]db.GetCycleTimeItemsRow, error) {
+	panic("unimplemented")
 }
 
 // GetIterationBurndown implements Querier.
-func (m *MockQuerier) GetIterationBurndown(ctx context.Context, id int32) ([]db.GetIterationBurndownRow, error) {
+func (m *MockQuerier) GetIterationBurndown(ctx context.Context, arg db.GetIterationBurndownParams) ([]db.GetIterationBurndownRow, error) {
+	panic("unimplemented")
+}
+
+// GetIterationIntradayBurndown implements Querier.
+func (m *MockQuerier) GetIterationIntradayBurndown(ctx context.Context, arg db.GetIterationIntradayBurndownParams) ([]db.GetIterationIntradayBurndownRow, error) {
+	panic("unimplemented")
+}
+
+// GetIterationScopeRemoved implements Querier.
+func (m *MockQuerier) GetIterationScopeRemoved(ctx context.Context, arg db.GetIterationScopeRemovedParams) ([]db.GetIterationScopeRemovedRow, error) {
+	panic("unimplemented")
+}
+
+// GetIterationWorkload implements Querier.
+func (m *MockQuerier) GetIterationWorkload(ctx context.Context, arg db.GetIterationWorkloadParams) ([]db.GetIterationWorkloadRow, error) {
 	panic("unimplemented")
 }
 
@@ -31,21 +191,187 @@ func (m *MockQuerier) GetIterations(ctx context.Context, id int32) ([]db.Iterati
 	panic("unimplemented")
 }
 
+// GetMilestoneBurndown implements Querier.
+func (m *MockQuerier) GetMilestoneBurndown(ctx context.Context, arg db.GetMilestoneBurndownParams) ([]db.GetMilestoneBurndownRow, error) {
+	panic("unimplemented")
+}
+
+// GetMilestones implements Querier.
+func (m *MockQuerier) GetMilestones(ctx context.Context, projectID int32) ([]db.Milestone, error) {
+	panic("unimplemented")
+}
+
+// GetPreviousWorkItems implements Querier.
+func (m *MockQuerier) GetPreviousWorkItems(ctx context.Context, arg db.GetPreviousWorkItemsParams) ([]db.GetPreviousWorkItemsRow, error) {
+	m.GetPreviousWorkItemsValue = arg
+	return m.GetPreviousWorkItemsResult, m.GetPreviousWorkItemsError
+}
+
 // GetProjectBurnup implements Querier.
 func (m *MockQuerier) GetProjectBurnup(ctx context.Context, arg db.GetProjectBurnupParams) ([]db.GetProjectBurnupRow, error) {
 	panic("unimplemented")
 }
 
+// GetProjectFirstChangeDate implements Querier.
+func (m *MockQuerier) GetProjectFirstChangeDate(ctx context.Context, ghID string) (pgtype.Date, error) {
+	m.GetProjectFirstChangeDateValue = ghID
+	return m.GetProjectFirstChangeDateResult, m.GetProjectFirstChangeDateError
+}
+
+// GetProjectIntradayBurnup implements Querier.
+func (m *MockQuerier) GetProjectIntradayBurnup(ctx context.Context, arg db.GetProjectIntradayBurnupParams) ([]db.GetProjectIntradayBurnupRow, error) {
+	panic("unimplemented")
+}
+
+// GetProjectPriorityBreakdown implements Querier.
+func (m *MockQuerier) GetProjectPriorityBreakdown(ctx context.Context, arg db.GetProjectPriorityBreakdownParams) ([]db.GetProjectPriorityBreakdownRow, error) {
+	panic("unimplemented")
+}
+
+// GetProjectScopeRemoved implements Querier.
+func (m *MockQuerier) GetProjectScopeRemoved(ctx context.Context, arg db.GetProjectScopeRemovedParams) ([]db.GetProjectScopeRemovedRow, error) {
+	panic("unimplemented")
+}
+
+// GetProjectSyncRuns implements Querier.
+func (m *MockQuerier) GetProjectSyncRuns(ctx context.Context, arg db.GetProjectSyncRunsParams) ([]db.SyncRun, error) {
+	panic("unimplemented")
+}
+
+// GetProjectSyncState implements Querier.
+func (m *MockQuerier) GetProjectSyncState(ctx context.Context, projectName string) (db.ProjectSyncState, error) {
+	m.GetProjectSyncStateValue = projectName
+	return m.GetProjectSyncStateResult, m.GetProjectSyncStateError
+}
+
+// GetProjectTimeZone implements Querier.
+func (m *MockQuerier) GetProjectTimeZone(ctx context.Context, id int32) (string, error) {
+	panic("unimplemented")
+}
+
 // GetProjects implements Querier.
 func (m *MockQuerier) GetProjects(ctx context.Context) ([]db.Project, error) {
 	panic("unimplemented")
 }
 
+// GetRegisteredProject implements Querier.
+func (m *MockQuerier) GetRegisteredProject(ctx context.Context, id int32) (db.RegisteredProject, error) {
+	for _, project := range m.RegisteredProjects {
+		if project.ID == id {
+			return project, nil
+		}
+	}
+
+	return db.RegisteredProject{}, pgx.ErrNoRows
+}
+
+// GetRegisteredProjects implements Querier.
+func (m *MockQuerier) GetRegisteredProjects(ctx context.Context) ([]db.RegisteredProject, error) {
+	return m.RegisteredProjects, m.GetRegisteredProjectsError
+}
+
+// GetSyncRuns implements Querier.
+func (m *MockQuerier) GetSyncRuns(ctx context.Context, rowLimit int32) ([]db.SyncRun, error) {
+	panic("unimplemented")
+}
+
+// GetSyncedProjectIds implements Querier.
+func (m *MockQuerier) GetSyncedProjectIds(ctx context.Context, projectNames []string) ([]db.GetSyncedProjectIdsRow, error) {
+	m.GetSyncedProjectIdsValue = projectNames
+	return m.GetSyncedProjectIdsResult, m.GetSyncedProjectIdsError
+}
+
+// GetTrackedProjects implements Querier.
+func (m *MockQuerier) GetTrackedProjects(ctx context.Context) ([]db.GetTrackedProjectsRow, error) {
+	m.GetTrackedProjectsCount++
+	return m.GetTrackedProjectsResult, m.GetTrackedProjectsError
+}
+
+// GetWorkItemTree implements Querier.
+func (m *MockQuerier) GetWorkItemTree(ctx context.Context, projectID int32) ([]db.GetWorkItemTreeRow, error) {
+	panic("unimplemented")
+}
+
 // GetWorkItemsForIteration implements Querier.
 func (m *MockQuerier) GetWorkItemsForIteration(ctx context.Context, name string) ([]db.GetWorkItemsForIterationRow, error) {
 	panic("unimplemented")
 }
 
+// InsertRegisteredProject implements Querier.
+func (m *MockQuerier) InsertRegisteredProject(ctx context.Context, arg db.InsertRegisteredProjectParams) (db.RegisteredProject, error) {
+	if m.InsertRegisteredProjectError != nil {
+		return db.RegisteredProject{}, m.InsertRegisteredProjectError
+	}
+
+	project := db.RegisteredProject{
+		ID:             int32(len(m.RegisteredProjects) + 1),
+		Name:           arg.Name,
+		Settings:       arg.Settings,
+		EncryptedToken: arg.EncryptedToken,
+	}
+	m.RegisteredProjects = append(m.RegisteredProjects, project)
+
+	return project, nil
+}
+
+// InsertSyncRun implements Querier. Projects are pulled concurrently so
+// recording their runs is synchronized.
+func (m *MockQuerier) InsertSyncRun(ctx context.Context, arg db.InsertSyncRunParams) (db.SyncRun, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.InsertSyncRunValue = append(m.InsertSyncRunValue, arg)
+	return db.SyncRun{ID: int32(len(m.InsertSyncRunValue))}, m.InsertSyncRunError
+}
+
+// InsertWorkItemAssignee implements Querier.
+func (m *MockQuerier) InsertWorkItemAssignee(ctx context.Context, arg db.InsertWorkItemAssigneeParams) error {
+	m.InsertWorkItemAssigneeValue = append(m.InsertWorkItemAssigneeValue, arg)
+	return m.InsertWorkItemAssigneeError
+}
+
+// InsertWorkItemEvent implements Querier.
+func (m *MockQuerier) InsertWorkItemEvent(ctx context.Context, arg db.InsertWorkItemEventParams) error {
+	m.InsertWorkItemEventValue = append(m.InsertWorkItemEventValue, arg)
+	return m.InsertWorkItemEventError
+}
+
+// InsertWorkItemLabel implements Querier.
+func (m *MockQuerier) InsertWorkItemLabel(ctx context.Context, arg db.InsertWorkItemLabelParams) error {
+	m.InsertWorkItemLabelValue = append(m.InsertWorkItemLabelValue, arg)
+	return m.InsertWorkItemLabelError
+}
+
+// SaveIntradaySnapshot implements Querier.
+func (m *MockQuerier) SaveIntradaySnapshot(ctx context.Context, arg db.SaveIntradaySnapshotParams) error {
+	m.SaveIntradaySnapshotValue = append(m.SaveIntradaySnapshotValue, arg)
+	return m.SaveIntradaySnapshotError
+}
+
+// UpdateRegisteredProject implements Querier.
+func (m *MockQuerier) UpdateRegisteredProject(ctx context.Context, arg db.UpdateRegisteredProjectParams) (db.RegisteredProject, error) {
+	for index, project := range m.RegisteredProjects {
+		if project.ID == arg.ID {
+			project.Name = arg.Name
+			project.Settings = arg.Settings
+			project.EncryptedToken = arg.EncryptedToken
+			m.RegisteredProjects[index] = project
+			return project, nil
+		}
+	}
+
+	return db.RegisteredProject{}, pgx.ErrNoRows
+}
+
+// UpsertAssignee implements Querier.
+func (m *MockQuerier) UpsertAssignee(ctx context.Context, login string) (db.Assignee, error) {
+	m.UpsertAssigneeValue = append(m.UpsertAssigneeValue, login)
+	return db.Assignee{
+		ID:    int32(len(m.UpsertAssigneeValue)),
+		Login: login,
+	}, m.UpsertAssigneeError
+}
+
 // UpsertIteration implements Querier.
 func (m *MockQuerier) UpsertIteration(ctx context.Context, arg db.UpsertIterationParams) (db.Iteration, error) {
 	m.UpsertWorkItemIterationsValue = append(m.UpsertWorkItemIterationsValue, arg)
@@ -59,10 +385,39 @@ func (m *MockQuerier) UpsertIteration(ctx context.Context, arg db.UpsertIteratio
 	}, m.UpsertWorkItemIterationsError
 }
 
+// UpsertMilestone implements Querier.
+func (m *MockQuerier) UpsertMilestone(ctx context.Context, arg db.UpsertMilestoneParams) (db.Milestone, error) {
+	m.UpsertMilestoneValue = append(m.UpsertMilestoneValue, arg)
+	return db.Milestone{
+		ID:   int32(len(m.UpsertMilestoneValue)),
+		GhID: arg.GhID,
+		Name: arg.Name,
+	}, m.UpsertMilestoneError
+}
+
+// UpsertLabel implements Querier.
+func (m *MockQuerier) UpsertLabel(ctx context.Context, name string) (db.Label, error) {
+	m.UpsertLabelValue = append(m.UpsertLabelValue, name)
+	return db.Label{
+		ID:   int32(len(m.UpsertLabelValue)),
+		Name: name,
+	}, m.UpsertLabelError
+}
+
 // UpsertProject implements Querier.
 func (m *MockQuerier) UpsertProject(ctx context.Context, arg db.UpsertProjectParams) (db.Project, error) {
 	m.UpsertProjectValue = arg
-	return db.Project{}, m.UpsertProjectError
+	return db.Project{
+		ID:   1,
+		GhID: arg.GhID,
+		Name: arg.Name,
+	}, m.UpsertProjectError
+}
+
+// UpsertProjectSyncState implements Querier.
+func (m *MockQuerier) UpsertProjectSyncState(ctx context.Context, arg db.UpsertProjectSyncStateParams) error {
+	m.UpsertProjectSyncStateValue = append(m.UpsertProjectSyncStateValue, arg)
+	return m.UpsertProjectSyncStateError
 }
 
 // UpsertWorkItem implements Querier.
@@ -70,7 +425,7 @@ func (m *MockQuerier) UpsertWorkItem(ctx context.Context, arg db.UpsertWorkItemP
 	m.UpsertWorkItemsValue = append(m.UpsertWorkItemsValue, arg)
 
 	return db.WorkItemHistory{
-		ID:             1,
+		ID:             int32(len(m.UpsertWorkItemsValue)),
 		GhID:           arg.GhID,
 		IterationID:    arg.IterationID,
 		Status:         arg.Status,
@@ -79,9 +434,18 @@ func (m *MockQuerier) UpsertWorkItem(ctx context.Context, arg db.UpsertWorkItemP
 		Name:           arg.Name,
 		Priority:       arg.Priority,
 		RemainingHours: arg.RemainingHours,
+		ContentType:    arg.ContentType,
+		Inferred:       arg.Inferred,
+		MilestoneID:    arg.MilestoneID,
 	}, m.UpsertWorkItemsError
 }
 
+// UpsertWorkItemDates implements Querier.
+func (m *MockQuerier) UpsertWorkItemDates(ctx context.Context, arg db.UpsertWorkItemDatesParams) error {
+	m.UpsertWorkItemDatesValue = append(m.UpsertWorkItemDatesValue, arg)
+	return m.UpsertWorkItemDatesError
+}
+
 // UpsertWorkItemStatus implements Querier.
 func (m *MockQuerier) UpsertWorkItemStatus(ctx context.Context, name string) (db.WorkItemStatus, error) {
 	m.UpsertWorkItemStatusValue = append(m.UpsertWorkItemStatusValue, name)
@@ -90,3 +454,9 @@ func (m *MockQuerier) UpsertWorkItemStatus(ctx context.Context, name string) (db
 		Name: name,
 	}, m.UpsertWorkItemStatusError
 }
+
+// UpsertWorkItemStatusChange implements Querier.
+func (m *MockQuerier) UpsertWorkItemStatusChange(ctx context.Context, arg db.UpsertWorkItemStatusChangeParams) error {
+	m.UpsertWorkItemStatusChangeValue = append(m.UpsertWorkItemStatusChangeValue, arg)
+	return m.UpsertWorkItemStatusChangeError
+}
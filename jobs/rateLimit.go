@@ -0,0 +1,307 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxRetries            = 5
+	initialBackoff        = time.Second
+	maxBackoff            = time.Minute
+	lowRateLimitThreshold = 100
+	// GitHub asks to wait at least a minute after hitting a secondary limit
+	// that does not say how long to wait
+	secondaryRateLimitWait = time.Minute
+)
+
+type rateLimit struct {
+	limit     int
+	remaining int
+	used      int
+	reset     time.Time
+}
+
+// rateLimitTransport retries transient GitHub failures and keeps pulls within
+// the rate limit budget reported by the API.
+type rateLimitTransport struct {
+	name    string
+	wrapped http.RoundTripper
+	sleep   func(ctx context.Context, duration time.Duration) error
+	now     func() time.Time
+
+	mutex sync.Mutex
+	last  *rateLimit
+}
+
+func newRateLimitTransport(name string, wrapped http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{
+		name:    name,
+		wrapped: wrapped,
+		sleep:   sleepContext,
+		now:     time.Now,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.waitForBudget(req.Context()); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.wrapped.RoundTrip(attemptReq)
+		if resp != nil {
+			t.recordRateLimit(resp)
+		}
+
+		wait, retry := t.shouldRetry(req.Context(), resp, err, attempt)
+		if !retry || !canRewind(req) {
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		slog.Warn("Retrying GitHub request", "project", t.name, "attempt", attempt+1, "wait", wait, "error", describeFailure(resp, err))
+
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForBudget pauses until the rate limit resets when the remaining budget
+// reported by the last response is running low.
+func (t *rateLimitTransport) waitForBudget(ctx context.Context) error {
+	t.mutex.Lock()
+	last := t.last
+	t.mutex.Unlock()
+
+	if last == nil || last.remaining >= lowRateLimitThreshold {
+		return nil
+	}
+
+	wait := last.reset.Sub(t.now())
+	if wait <= 0 {
+		return nil
+	}
+
+	slog.Warn("GitHub rate limit is low, pausing pull", "project", t.name, "remaining", last.remaining, "reset", last.reset)
+
+	return t.sleep(ctx, wait)
+}
+
+func (t *rateLimitTransport) recordRateLimit(resp *http.Response) {
+	current, ok := parseRateLimit(resp.Header)
+	if !ok {
+		return
+	}
+
+	t.mutex.Lock()
+	previous := t.last
+	t.last = current
+	t.mutex.Unlock()
+
+	// the budget is shared by every request in the reset window, so the cost of
+	// a query is the difference in usage since the previous response
+	cost := current.used
+	if previous != nil && previous.reset.Equal(current.reset) {
+		cost = current.used - previous.used
+	}
+
+	slog.Info("GitHub rate limit", "project", t.name, "cost", cost, "remaining", current.remaining, "limit", current.limit, "reset", current.reset)
+}
+
+func (t *rateLimitTransport) shouldRetry(ctx context.Context, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= maxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		// the caller gave up or the server is not trusted, retrying would only
+		// delay the inevitable
+		var certificateError *tls.CertificateVerificationError
+		if ctx.Err() != nil || errors.As(err, &certificateError) {
+			return 0, false
+		}
+
+		return backoff(attempt), true
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return backoff(attempt), true
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		// GraphQL reports rate limits as errors of a successful response
+		if !isGraphqlRateLimited(resp) {
+			return 0, false
+		}
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusForbidden:
+		// other 403s are permission errors that a retry would not fix
+		if !isRateLimited(resp) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	// primary limit exhausted, wait for the window to reset
+	if limit, ok := parseRateLimit(resp.Header); ok && limit.remaining == 0 {
+		return max(limit.reset.Sub(t.now()), 0) + time.Second, true
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return backoff(attempt), true
+	}
+
+	return secondaryRateLimitWait + backoff(attempt), true
+}
+
+// isRateLimited tells a forbidden response caused by a rate limit from one
+// caused by missing permissions.
+func isRateLimited(resp *http.Response) bool {
+	if resp.Header.Get("Retry-After") != "" {
+		return true
+	}
+
+	if limit, ok := parseRateLimit(resp.Header); ok && limit.remaining == 0 {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(string(peekBody(resp))), "secondary rate limit")
+}
+
+func isGraphqlRateLimited(resp *http.Response) bool {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return false
+	}
+
+	body := peekBody(resp)
+	if !bytes.Contains(body, []byte("RATE_LIMITED")) {
+		return false
+	}
+
+	var result struct {
+		Errors []struct {
+			Type string `json:"type"`
+		} `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return false
+	}
+
+	for _, graphqlError := range result.Errors {
+		if graphqlError.Type == "RATE_LIMITED" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peekBody reads the body of the response and puts it back for the caller.
+func peekBody(resp *http.Response) []byte {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
+
+func parseRateLimit(header http.Header) (*rateLimit, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return nil, false
+	}
+
+	limit, _ := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	used, _ := strconv.Atoi(header.Get("X-RateLimit-Used"))
+	reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+
+	return &rateLimit{
+		limit:     limit,
+		remaining: remaining,
+		used:      used,
+		reset:     time.Unix(reset, 0),
+	}, true
+}
+
+// backoff doubles the wait on every attempt and randomizes the upper half so that
+// concurrent pulls do not retry in lockstep.
+func backoff(attempt int) time.Duration {
+	wait := min(initialBackoff<<attempt, maxBackoff)
+
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+
+	return clone, nil
+}
+
+func describeFailure(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("unexpected status %d", resp.StatusCode)
+}
+
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,257 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type rateLimitTestResponse struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+func startRateLimitServer(t *testing.T, responses []rateLimitTestResponse, bodies *[]string) *httptest.Server {
+	calls := 0
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, string(body))
+
+		response := responses[min(calls, len(responses)-1)]
+		calls++
+
+		for key, value := range response.headers {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(response.status)
+		w.Write([]byte(response.body))
+	}))
+}
+
+func newTestRateLimitTransport(now time.Time, waits *[]time.Duration) *rateLimitTransport {
+	transport := newRateLimitTransport("test", http.DefaultTransport)
+	transport.now = func() time.Time { return now }
+	transport.sleep = func(ctx context.Context, duration time.Duration) error {
+		*waits = append(*waits, duration)
+		return nil
+	}
+
+	return transport
+}
+
+func postTestRequest(t *testing.T, transport http.RoundTripper, url string) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"query":"test"}`))
+	assert.Nil(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	assert.Nil(t, err)
+
+	return resp
+}
+
+func TestRateLimitTransportRetriesServerErrors(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusBadGateway},
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusOK},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"query":"test"}`, `{"query":"test"}`, `{"query":"test"}`}, bodies)
+	assert.Len(t, waits, 2)
+	assert.GreaterOrEqual(t, waits[0], initialBackoff/2)
+	assert.LessOrEqual(t, waits[0], initialBackoff)
+	assert.GreaterOrEqual(t, waits[1], initialBackoff)
+	assert.LessOrEqual(t, waits[1], 2*initialBackoff)
+}
+
+func TestRateLimitTransportGivesUpAfterMaxRetries(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusInternalServerError},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Len(t, bodies, maxRetries+1)
+	assert.Len(t, waits, maxRetries)
+}
+
+func TestRateLimitTransportHonorsRetryAfter(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusForbidden, headers: map[string]string{"Retry-After": "30"}},
+		{status: http.StatusOK},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []time.Duration{30 * time.Second}, waits)
+}
+
+func TestRateLimitTransportWaitsForExhaustedLimit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusForbidden, headers: map[string]string{
+			"X-RateLimit-Remaining": "0",
+			"X-RateLimit-Reset":     strconv.FormatInt(now.Add(time.Minute).Unix(), 10),
+		}},
+		{status: http.StatusOK},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(now, &waits), server.URL)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []time.Duration{time.Minute + time.Second}, waits)
+}
+
+func TestRateLimitTransportWaitsForSecondaryLimit(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{
+			status:  http.StatusForbidden,
+			headers: map[string]string{"X-RateLimit-Remaining": "4000"},
+			body:    `{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`,
+		},
+		{status: http.StatusOK},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, waits, 1)
+	assert.GreaterOrEqual(t, waits[0], secondaryRateLimitWait)
+	assert.LessOrEqual(t, waits[0], secondaryRateLimitWait+initialBackoff)
+}
+
+func TestRateLimitTransportDoesNotRetryForbidden(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{
+			status:  http.StatusForbidden,
+			headers: map[string]string{"X-RateLimit-Remaining": "4000"},
+			body:    `{"message":"Resource not accessible by integration"}`,
+		},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, `{"message":"Resource not accessible by integration"}`, string(body))
+	assert.Len(t, bodies, 1)
+	assert.Empty(t, waits)
+}
+
+func TestRateLimitTransportRetriesGraphqlRateLimit(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{
+			status:  http.StatusOK,
+			headers: map[string]string{"Content-Type": "application/json", "X-RateLimit-Remaining": "10"},
+			body:    `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`,
+		},
+		{
+			status:  http.StatusOK,
+			headers: map[string]string{"Content-Type": "application/json"},
+			body:    `{"data":{}}`,
+		},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, `{"data":{}}`, string(body))
+	assert.Len(t, bodies, 2)
+	assert.Len(t, waits, 1)
+	assert.GreaterOrEqual(t, waits[0], secondaryRateLimitWait)
+}
+
+func TestRateLimitTransportWaitsForExhaustedGraphqlLimit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Content-Type":          "application/json",
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(now.Add(5*time.Minute).Unix(), 10),
+			},
+			body: `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`,
+		},
+		{status: http.StatusOK},
+	}, &bodies)
+	defer server.Close()
+
+	postTestRequest(t, newTestRateLimitTransport(now, &waits), server.URL)
+
+	assert.Equal(t, []time.Duration{5*time.Minute + time.Second}, waits)
+}
+
+func TestRateLimitTransportDoesNotRetryClientErrors(t *testing.T) {
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusUnauthorized},
+	}, &bodies)
+	defer server.Close()
+
+	resp := postTestRequest(t, newTestRateLimitTransport(time.Now(), &waits), server.URL)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Len(t, bodies, 1)
+	assert.Empty(t, waits)
+}
+
+func TestRateLimitTransportPausesWhenBudgetIsLow(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bodies := []string{}
+	waits := []time.Duration{}
+	server := startRateLimitServer(t, []rateLimitTestResponse{
+		{status: http.StatusOK, headers: map[string]string{
+			"X-RateLimit-Limit":     "5000",
+			"X-RateLimit-Remaining": "10",
+			"X-RateLimit-Used":      "4990",
+			"X-RateLimit-Reset":     strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10),
+		}},
+	}, &bodies)
+	defer server.Close()
+
+	transport := newTestRateLimitTransport(now, &waits)
+	postTestRequest(t, transport, server.URL)
+	assert.Empty(t, waits)
+
+	postTestRequest(t, transport, server.URL)
+	assert.Equal(t, []time.Duration{10 * time.Minute}, waits)
+}
@@ -0,0 +1,375 @@
+package jobs
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// ProjectTokenKeySize is the size of the AES-256 key that encrypts the tokens
+// of registered projects.
+const ProjectTokenKeySize = 32
+
+const uniqueViolationCode = "23505"
+
+// projectRegistry reads and writes the projects registered through the admin
+// API. Tokens are encrypted with AES-GCM before they are saved.
+type projectRegistry struct {
+	aead              cipher.AEAD
+	defaultConnection models.ConnectionConfig
+}
+
+// EnableRegistry loads the projects registered through the admin API on
+// every run, in addition to the configured ones. Projects without their own
+// connection settings use defaultConnection.
+func (c *DataPullJob) EnableRegistry(key []byte, defaultConnection models.ConnectionConfig) error {
+	if len(key) != ProjectTokenKeySize {
+		return fmt.Errorf("project token key should be %d bytes", ProjectTokenKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	c.registry = &projectRegistry{
+		aead:              aead,
+		defaultConnection: defaultConnection,
+	}
+
+	return nil
+}
+
+// GetRegisteredProjects returns the projects registered through the admin
+// API without their tokens.
+func (c *DataPullJob) GetRegisteredProjects(ctx context.Context) ([]models.ProjectRegistration, error) {
+	rows, err := c.queries.GetRegisteredProjects(ctx)
+	if err != nil {
+		slog.Error("Error on GetRegisteredProjects", "error", err)
+		return nil, err
+	}
+
+	result := []models.ProjectRegistration{}
+	for _, row := range rows {
+		project := models.JobConfigItem{}
+		if err := json.Unmarshal(row.Settings, &project); err != nil {
+			return nil, err
+		}
+
+		result = append(result, newRegistrationResult(row, project))
+	}
+
+	return result, nil
+}
+
+// RegisterProject validates a project against GitHub and saves it so that
+// the next run pulls it.
+func (c *DataPullJob) RegisterProject(ctx context.Context, registration *models.ProjectRegistration) (*models.ProjectRegistration, error) {
+	project := registration.ToConfig()
+	if err := c.validateRegistration(ctx, project); err != nil {
+		return nil, err
+	}
+
+	settings, err := json.Marshal(withoutToken(project))
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedToken, err := c.registry.encrypt(project.Token)
+	if err != nil {
+		slog.Error("Error encrypting project token", "error", err)
+		return nil, err
+	}
+
+	pulled := c.registry.withDefaults(project)
+	row, err := c.queries.InsertRegisteredProject(ctx, db.InsertRegisteredProjectParams{
+		Name:           pulled.GetUniqueName(),
+		Settings:       settings,
+		EncryptedToken: encryptedToken,
+	})
+
+	if err != nil {
+		return nil, registrationSaveError("InsertRegisteredProject", err)
+	}
+
+	c.loadRegisteredProjects(ctx)
+
+	result := newRegistrationResult(row, project)
+	return &result, nil
+}
+
+// UpdateRegisteredProject replaces a registered project. The stored token is
+// kept when the registration does not include credentials.
+func (c *DataPullJob) UpdateRegisteredProject(ctx context.Context, id int32, registration *models.ProjectRegistration) (*models.ProjectRegistration, error) {
+	current, err := c.queries.GetRegisteredProject(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrProjectNotFound
+	}
+
+	if err != nil {
+		slog.Error("Error on GetRegisteredProject", "error", err)
+		return nil, err
+	}
+
+	project := registration.ToConfig()
+	if project.Token == "" && !project.UsesGitHubApp() {
+		project.Token, err = c.registry.decrypt(current.EncryptedToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.validateRegistration(ctx, project); err != nil {
+		return nil, err
+	}
+
+	settings, err := json.Marshal(withoutToken(project))
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedToken, err := c.registry.encrypt(project.Token)
+	if err != nil {
+		slog.Error("Error encrypting project token", "error", err)
+		return nil, err
+	}
+
+	pulled := c.registry.withDefaults(project)
+	row, err := c.queries.UpdateRegisteredProject(ctx, db.UpdateRegisteredProjectParams{
+		ID:             id,
+		Name:           pulled.GetUniqueName(),
+		Settings:       settings,
+		EncryptedToken: encryptedToken,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrProjectNotFound
+	}
+
+	if err != nil {
+		return nil, registrationSaveError("UpdateRegisteredProject", err)
+	}
+
+	c.loadRegisteredProjects(ctx)
+
+	result := newRegistrationResult(row, project)
+	return &result, nil
+}
+
+// DeleteRegisteredProject stops pulling a registered project. Its snapshots
+// are kept.
+func (c *DataPullJob) DeleteRegisteredProject(ctx context.Context, id int32) error {
+	deleted, err := c.queries.DeleteRegisteredProject(ctx, id)
+	if err != nil {
+		slog.Error("Error on DeleteRegisteredProject", "error", err)
+		return err
+	}
+
+	if deleted == 0 {
+		return models.ErrProjectNotFound
+	}
+
+	c.loadRegisteredProjects(ctx)
+
+	return nil
+}
+
+// loadRegisteredProjects refreshes the registered projects so that each run
+// pulls the current registrations. The previous registrations are kept when
+// they cannot be read.
+func (c *DataPullJob) loadRegisteredProjects(ctx context.Context) {
+	if c.registry == nil {
+		return
+	}
+
+	rows, err := c.queries.GetRegisteredProjects(ctx)
+	if err != nil {
+		slog.Error("Error on GetRegisteredProjects", "error", err)
+		return
+	}
+
+	registered := []models.JobConfigItem{}
+	for _, row := range rows {
+		project, err := c.registry.parseProject(row)
+		if err != nil {
+			slog.Error("Skipping invalid registered project", "project", row.Name, "error", err)
+			continue
+		}
+
+		registered = append(registered, c.registry.withDefaults(project))
+	}
+
+	c.reloadMutex.Lock()
+	defer c.reloadMutex.Unlock()
+
+	c.mutex.Lock()
+	configured := c.projects
+	discovered := c.discoveredProjects
+	c.mutex.Unlock()
+
+	// registered projects never fail the change
+	_ = c.replaceProjects(configured, registered, discovered)
+}
+
+// validateRegistration checks the settings of a project and that it can be
+// read from GitHub with the given credentials and field mapping.
+func (c *DataPullJob) validateRegistration(ctx context.Context, project models.JobConfigItem) error {
+	if err := project.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", models.ErrRegistrationRejected, err)
+	}
+
+	project = c.registry.withDefaults(project)
+
+	c.mutex.Lock()
+	configured := c.projects
+	c.mutex.Unlock()
+
+	if slices.ContainsFunc(configured, func(other models.JobConfigItem) bool {
+		return other.GetUniqueName() == project.GetUniqueName()
+	}) {
+		return models.ErrProjectAlreadyRegistered
+	}
+
+	graphqlClient, err := c.newClient(project)
+	if err != nil {
+		return fmt.Errorf("%w: %w", models.ErrRegistrationRejected, err)
+	}
+
+	if err := checkProjectSettings(ctx, graphqlClient, project); err != nil {
+		return fmt.Errorf("%w: project could not be read from GitHub: %w", models.ErrRegistrationRejected, err)
+	}
+
+	return nil
+}
+
+// checkProjectSettings reads the project fields from GitHub and resolves the
+// field mapping of the project against them.
+func checkProjectSettings(ctx context.Context, graphqlClient graphql.Client, project models.JobConfigItem) error {
+	projectNumber, err := strconv.Atoi(project.Project)
+	if err != nil {
+		return fmt.Errorf("project %q is not a project number", project.Project)
+	}
+
+	var settings ProjectSettings
+	if project.OrgName != "" {
+		result, err := getOrganizationProjectSettings(ctx, graphqlClient, project.OrgName, projectNumber)
+		if err != nil {
+			return err
+		}
+
+		settings = result.Organization.ProjectV2.ProjectSettings
+	} else {
+		result, err := getRepositoryProjectSettings(ctx, graphqlClient, project.RepoOwner, project.RepoName, projectNumber)
+		if err != nil {
+			return err
+		}
+
+		settings = result.Repository.ProjectV2.ProjectSettings
+	}
+
+	if settings.Id == "" {
+		return models.ErrProjectNotFound
+	}
+
+	_, err = resolveFields(settings.Fields.Nodes, project.Fields)
+
+	return err
+}
+
+func (r *projectRegistry) withDefaults(project models.JobConfigItem) models.JobConfigItem {
+	project.Connection = project.Connection.WithDefaults(r.defaultConnection)
+	return project
+}
+
+// parseProject reads a registered project with its decrypted token.
+func (r *projectRegistry) parseProject(row db.RegisteredProject) (models.JobConfigItem, error) {
+	project := models.JobConfigItem{}
+	if err := json.Unmarshal(row.Settings, &project); err != nil {
+		return project, err
+	}
+
+	token, err := r.decrypt(row.EncryptedToken)
+	if err != nil {
+		return project, err
+	}
+
+	project.Token = token
+
+	return project, nil
+}
+
+// encrypt seals a token with a random nonce prepended to the result.
+func (r *projectRegistry) encrypt(token string) ([]byte, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+
+	return r.aead.Seal(nonce, nonce, []byte(token), nil), nil
+}
+
+func (r *projectRegistry) decrypt(encrypted []byte) (string, error) {
+	if len(encrypted) == 0 {
+		return "", nil
+	}
+
+	nonceSize := r.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return "", errors.New("encrypted token is too short")
+	}
+
+	token, err := r.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt token: %w", err)
+	}
+
+	return string(token), nil
+}
+
+func withoutToken(project models.JobConfigItem) models.JobConfigItem {
+	project.Token = ""
+	return project
+}
+
+func newRegistrationResult(row db.RegisteredProject, project models.JobConfigItem) models.ProjectRegistration {
+	result := models.NewProjectRegistration(project)
+	result.Id = row.ID
+	result.Name = row.Name
+	result.HasToken = len(row.EncryptedToken) > 0
+	result.CreatedAt = row.CreatedAt.Time
+	result.UpdatedAt = row.UpdatedAt.Time
+
+	return result
+}
+
+func registrationSaveError(query string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return models.ErrProjectAlreadyRegistered
+	}
+
+	slog.Error("Error on "+query, "error", err)
+	return err
+}
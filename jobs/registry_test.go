@@ -0,0 +1,223 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTokenKey = bytes.Repeat([]byte{7}, ProjectTokenKeySize)
+
+type mockGraphqlSettingsClient struct {
+	fields []ProjectField
+	err    error
+}
+
+func (m mockGraphqlSettingsClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	settings := ProjectSettings{ProjectFieldDefinitions: ProjectFieldDefinitions{Id: "project"}}
+	settings.Fields.Nodes = m.fields
+
+	switch data := resp.Data.(type) {
+	case *getOrganizationProjectSettingsResponse:
+		data.Organization.ProjectV2.ProjectSettings = settings
+	case *getRepositoryProjectSettingsResponse:
+		data.Repository.ProjectV2.ProjectSettings = settings
+	}
+
+	return m.err
+}
+
+func getRegistryJob(t *testing.T, querier *MockQuerier, configured []models.JobConfigItem) *DataPullJob {
+	dataPullJob, _ := NewDataPullJob("* * * * *", querier, configured)
+	assert.Nil(t, dataPullJob.EnableRegistry(testTokenKey, models.ConnectionConfig{}))
+
+	dataPullJob.newClient = func(project models.JobConfigItem) (graphql.Client, error) {
+		return mockGraphqlSettingsClient{fields: getTestFields()}, nil
+	}
+
+	return dataPullJob
+}
+
+func getTestRegistration() *models.ProjectRegistration {
+	return &models.ProjectRegistration{
+		OrgName:        "org",
+		Project:        "5",
+		Token:          "secret-token",
+		StatusField:    "Stage",
+		EffortField:    "Story Points",
+		IterationField: "Sprint",
+	}
+}
+
+func TestEnableRegistryInvalidKey(t *testing.T) {
+	dataPullJob, _ := NewDataPullJob("* * * * *", &MockQuerier{}, []models.JobConfigItem{})
+
+	err := dataPullJob.EnableRegistry([]byte("short"), models.ConnectionConfig{})
+
+	assert.EqualError(t, err, "project token key should be 32 bytes")
+}
+
+func TestRegisterProjectEncryptsToken(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+
+	result, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), result.Id)
+	assert.Equal(t, "org/5", result.Name)
+	assert.Empty(t, result.Token)
+	assert.True(t, result.HasToken)
+	assert.Equal(t, "Stage", result.StatusField)
+
+	assert.Len(t, querier.RegisteredProjects, 1)
+	saved := querier.RegisteredProjects[0]
+	assert.NotContains(t, string(saved.Settings), "secret-token")
+	assert.NotContains(t, string(saved.EncryptedToken), "secret-token")
+
+	token, err := dataPullJob.registry.decrypt(saved.EncryptedToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "secret-token", token)
+}
+
+func TestRegisterProjectIsPulled(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{{OrgName: "org", Project: "1", Token: "token"}})
+
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+	assert.Nil(t, err)
+
+	projects, clients := dataPullJob.getProjects()
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "org/5", projects[1].GetUniqueName())
+	assert.Equal(t, "secret-token", projects[1].Token)
+	assert.Contains(t, clients, "org/5")
+
+	err = dataPullJob.DeleteRegisteredProject(context.Background(), 1)
+	assert.Nil(t, err)
+
+	projects, _ = dataPullJob.getProjects()
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "org/1", projects[0].GetUniqueName())
+}
+
+func TestRegisterProjectInvalid(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+
+	_, err := dataPullJob.RegisterProject(context.Background(), &models.ProjectRegistration{OrgName: "org", Project: "5"})
+
+	assert.ErrorIs(t, err, models.ErrRegistrationRejected)
+	assert.EqualError(t, err, "project registration rejected: invalid configuration: token or GitHub App credentials are required")
+	assert.Empty(t, querier.RegisteredProjects)
+}
+
+func TestRegisterProjectMissingField(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+	registration := getTestRegistration()
+	registration.EffortField = "Size"
+
+	_, err := dataPullJob.RegisterProject(context.Background(), registration)
+
+	assert.ErrorIs(t, err, models.ErrRegistrationRejected)
+	assert.EqualError(t, err, "project registration rejected: project could not be read from GitHub: effort field \"Size\" does not exist in project")
+	assert.Empty(t, querier.RegisteredProjects)
+}
+
+func TestRegisterProjectUnreachable(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+	dataPullJob.newClient = func(project models.JobConfigItem) (graphql.Client, error) {
+		return mockGraphqlSettingsClient{err: errors.New("Bad credentials")}, nil
+	}
+
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+
+	assert.ErrorIs(t, err, models.ErrRegistrationRejected)
+	assert.Empty(t, querier.RegisteredProjects)
+}
+
+func TestRegisterProjectAlreadyConfigured(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{{OrgName: "org", Project: "5", Token: "token"}})
+
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+
+	assert.ErrorIs(t, err, models.ErrProjectAlreadyRegistered)
+	assert.Empty(t, querier.RegisteredProjects)
+}
+
+func TestUpdateRegisteredProjectKeepsToken(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+	assert.Nil(t, err)
+
+	registration := getTestRegistration()
+	registration.Token = ""
+	registration.BackfillDays = 30
+
+	result, err := dataPullJob.UpdateRegisteredProject(context.Background(), 1, registration)
+
+	assert.Nil(t, err)
+	assert.True(t, result.HasToken)
+	assert.Equal(t, 30, result.BackfillDays)
+
+	projects, _ := dataPullJob.getProjects()
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "secret-token", projects[0].Token)
+	assert.Equal(t, 30, projects[0].BackfillDays)
+}
+
+func TestUpdateRegisteredProjectNotFound(t *testing.T) {
+	dataPullJob := getRegistryJob(t, &MockQuerier{}, []models.JobConfigItem{})
+
+	_, err := dataPullJob.UpdateRegisteredProject(context.Background(), 3, getTestRegistration())
+
+	assert.ErrorIs(t, err, models.ErrProjectNotFound)
+}
+
+func TestDeleteRegisteredProjectNotFound(t *testing.T) {
+	dataPullJob := getRegistryJob(t, &MockQuerier{}, []models.JobConfigItem{})
+
+	err := dataPullJob.DeleteRegisteredProject(context.Background(), 3)
+
+	assert.ErrorIs(t, err, models.ErrProjectNotFound)
+}
+
+func TestGetRegisteredProjects(t *testing.T) {
+	dataPullJob := getRegistryJob(t, &MockQuerier{}, []models.JobConfigItem{})
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+	assert.Nil(t, err)
+
+	result, err := dataPullJob.GetRegisteredProjects(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "org/5", result[0].Name)
+	assert.Empty(t, result[0].Token)
+	assert.True(t, result[0].HasToken)
+}
+
+func TestLoadRegisteredProjectsKeepsProjectsOnError(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := getRegistryJob(t, querier, []models.JobConfigItem{})
+	_, err := dataPullJob.RegisterProject(context.Background(), getTestRegistration())
+	assert.Nil(t, err)
+
+	querier.GetRegisteredProjectsError = errors.New("connection refused")
+	dataPullJob.loadRegisteredProjects(context.Background())
+
+	projects, _ := dataPullJob.getProjects()
+	assert.Len(t, projects, 1)
+}
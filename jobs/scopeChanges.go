@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	removedEvent          = "removed"
+	archivedEvent         = "archived"
+	iterationRemovedEvent = "iteration_removed"
+)
+
+// scopeChange is an item that left the project or its iteration since the
+// previous snapshot.
+type scopeChange struct {
+	eventType string
+	item      db.GetPreviousWorkItemsRow
+}
+
+// getScopeChanges diffs the items pulled today against the previous snapshot.
+// Items missing from the pull are looked up by id because archived items and
+// items whose content is not accessible are not listed either.
+// Items carried forward did not change so they are still in their iteration.
+func (c *DataPullJob) getScopeChanges(ctx context.Context, project models.JobConfigItem, pull *projectPull, today time.Time) ([]scopeChange, error) {
+	parsedProject := pull.project
+	previousItems, err := c.queries.GetPreviousWorkItems(ctx, db.GetPreviousWorkItemsParams{
+		ProjectGhID: parsedProject.Id,
+		ChangeDate:  pgtype.Date{Time: today, Valid: true},
+	})
+
+	if err != nil {
+		slog.Error("Error on GetPreviousWorkItems", "error", err)
+		return nil, err
+	}
+
+	currentItems := make(map[string]models.Issue)
+	for _, issue := range parsedProject.Issues {
+		currentItems[issue.Id] = issue
+	}
+
+	carriedItems := make(map[string]bool)
+	for _, itemId := range pull.carriedItemIds {
+		carriedItems[itemId] = true
+	}
+
+	missingItemIds := []string{}
+	for _, item := range previousItems {
+		if _, ok := currentItems[item.GhID]; !ok && !carriedItems[item.GhID] {
+			missingItemIds = append(missingItemIds, item.GhID)
+		}
+	}
+
+	removalTypes, err := c.getRemovalTypes(ctx, project, parsedProject.Id, missingItemIds)
+	if err != nil {
+		slog.Error("Error fetching removed project items", "error", err)
+		return nil, err
+	}
+
+	result := []scopeChange{}
+	for _, item := range previousItems {
+		if carriedItems[item.GhID] {
+			continue
+		}
+
+		issue, ok := currentItems[item.GhID]
+
+		if !ok {
+			if eventType := removalTypes[item.GhID]; eventType != "" {
+				result = append(result, scopeChange{eventType: eventType, item: item})
+			}
+
+			continue
+		}
+
+		if item.IterationGhID.Valid && item.IterationGhID.String != issue.IterationId {
+			result = append(result, scopeChange{eventType: iterationRemovedEvent, item: item})
+		}
+	}
+
+	return result, nil
+}
+
+// getRemovalTypes tells whether each item missing from the project items was
+// deleted or archived. Items still in the project get an empty type.
+func (c *DataPullJob) getRemovalTypes(ctx context.Context, project models.JobConfigItem, projectGhId string, itemIds []string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	for start := 0; start < len(itemIds); start += itemsPerRequestCount {
+		end := min(start+itemsPerRequestCount, len(itemIds))
+
+		response, err := getProjectItems(ctx, c.graphqlClient(project), itemIds[start:end], labelsPerIssueCount, assigneesPerIssueCount)
+
+		// GitHub fails to resolve the nodes of deleted items but still returns
+		// the others
+		var graphqlErrors gqlerror.List
+		if err != nil && !(errors.As(err, &graphqlErrors) && len(response.Nodes) == end-start) {
+			return nil, err
+		}
+
+		for index, itemId := range itemIds[start:end] {
+			item, ok := response.Nodes[index].(*getProjectItemsNodesProjectV2Item)
+			switch {
+			case !ok || item.Project.Id != projectGhId:
+				result[itemId] = removedEvent
+			case item.IsArchived:
+				result[itemId] = archivedEvent
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// saveScopeChanges records the scope changes of the day. Today's snapshot of
+// removed items is deleted in case it was saved by an earlier pull.
+func saveScopeChanges(ctx context.Context, queries db.Querier, projectId int32, changes []scopeChange, today time.Time) error {
+	for _, change := range changes {
+		err := queries.InsertWorkItemEvent(ctx, db.InsertWorkItemEventParams{
+			GhID:        change.item.GhID,
+			Name:        change.item.Name,
+			EventType:   change.eventType,
+			EventDate:   pgtype.Date{Time: today, Valid: true},
+			Effort:      change.item.Effort,
+			ContentType: change.item.ContentType,
+			IterationID: change.item.IterationID,
+			ProjectID:   projectId,
+		})
+
+		if err != nil {
+			slog.Error("Error on InsertWorkItemEvent", "error", err)
+			return err
+		}
+
+		if change.eventType == iterationRemovedEvent {
+			continue
+		}
+
+		err = queries.DeleteWorkItemSnapshot(ctx, db.DeleteWorkItemSnapshotParams{
+			GhID:       change.item.GhID,
+			ChangeDate: pgtype.Date{Time: today, Valid: true},
+		})
+
+		if err != nil {
+			slog.Error("Error on DeleteWorkItemSnapshot", "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
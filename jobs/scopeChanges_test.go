@@ -0,0 +1,194 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type mockGraphqlRemovedItemClient struct {
+	mockGraphqlOrgClient
+	items   map[string]*getProjectItemsNodesProjectV2Item
+	err     error
+	lookups [][]string
+}
+
+func (m *mockGraphqlRemovedItemClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if itemsResponse, ok := resp.Data.(*getProjectItemsResponse); ok {
+		ids := req.Variables.(*__getProjectItemsInput).Item_ids
+		m.lookups = append(m.lookups, ids)
+
+		if m.err != nil {
+			return m.err
+		}
+
+		errors := gqlerror.List{}
+		for _, id := range ids {
+			node, ok := m.items[id]
+			if !ok {
+				itemsResponse.Nodes = append(itemsResponse.Nodes, nil)
+				errors = append(errors, &gqlerror.Error{Message: fmt.Sprintf("Could not resolve to a node with the global id of '%s'", id)})
+				continue
+			}
+
+			itemsResponse.Nodes = append(itemsResponse.Nodes, node)
+		}
+
+		if len(errors) > 0 {
+			return errors
+		}
+
+		return nil
+	}
+
+	return m.mockGraphqlOrgClient.MakeRequest(ctx, req, resp)
+}
+
+func getRemovedItemNode(archived bool) *getProjectItemsNodesProjectV2Item {
+	return &getProjectItemsNodesProjectV2Item{
+		Typename: "ProjectV2Item",
+		ProjectItem: ProjectItem{
+			Id:         "item",
+			IsArchived: archived,
+		},
+		Project: getProjectItemsNodesProjectV2ItemProjectProjectV2{Id: "1"},
+	}
+}
+
+func getScopeChangesTestJob(t *testing.T, querier *MockQuerier, items map[string]*getProjectItemsNodesProjectV2Item) (*DataPullJob, *mockGraphqlRemovedItemClient) {
+	return newProjectTestJob(t, querier, []ProjectItem{getIssueTestItem("current")}, func(projectClient mockGraphqlOrgClient) *mockGraphqlRemovedItemClient {
+		return &mockGraphqlRemovedItemClient{mockGraphqlOrgClient: projectClient, items: items}
+	})
+}
+
+func TestExecuteRecordsRemovedItem(t *testing.T) {
+	querier := &MockQuerier{GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+		{GhID: "current", Name: "Issue 1"},
+		{
+			GhID:        "deleted",
+			Name:        "Issue 2",
+			Effort:      pgtype.Int4{Int32: 5, Valid: true},
+			IterationID: pgtype.Int4{Int32: 3, Valid: true},
+			ContentType: "Issue",
+		},
+	}}
+	dataPullJob, client := getScopeChangesTestJob(t, querier, nil)
+
+	executeAndWait(t, dataPullJob)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	assert.Equal(t, "1", querier.GetPreviousWorkItemsValue.ProjectGhID)
+	assert.Equal(t, today, querier.GetPreviousWorkItemsValue.ChangeDate.Time)
+	assert.Equal(t, [][]string{{"deleted"}}, client.lookups)
+	assert.Equal(t, []db.InsertWorkItemEventParams{
+		{
+			GhID:        "deleted",
+			Name:        "Issue 2",
+			EventType:   "removed",
+			EventDate:   pgtype.Date{Time: today, Valid: true},
+			Effort:      pgtype.Int4{Int32: 5, Valid: true},
+			ContentType: "Issue",
+			IterationID: pgtype.Int4{Int32: 3, Valid: true},
+			ProjectID:   1,
+		},
+	}, querier.InsertWorkItemEventValue)
+	assert.Equal(t, []db.DeleteWorkItemSnapshotParams{
+		{GhID: "deleted", ChangeDate: pgtype.Date{Time: today, Valid: true}},
+	}, querier.DeleteWorkItemSnapshotValue)
+}
+
+func TestExecuteRecordsArchivedItem(t *testing.T) {
+	querier := &MockQuerier{GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+		{GhID: "archived", Name: "Issue 2"},
+	}}
+	dataPullJob, _ := getScopeChangesTestJob(t, querier, map[string]*getProjectItemsNodesProjectV2Item{
+		"archived": getRemovedItemNode(true),
+	})
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Len(t, querier.InsertWorkItemEventValue, 1)
+	assert.Equal(t, "archived", querier.InsertWorkItemEventValue[0].EventType)
+	assert.Len(t, querier.DeleteWorkItemSnapshotValue, 1)
+}
+
+func TestExecuteIgnoresItemStillInProject(t *testing.T) {
+	querier := &MockQuerier{GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+		{GhID: "inaccessible", Name: "Issue 2"},
+	}}
+	dataPullJob, client := getScopeChangesTestJob(t, querier, map[string]*getProjectItemsNodesProjectV2Item{
+		"inaccessible": getRemovedItemNode(false),
+	})
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Equal(t, [][]string{{"inaccessible"}}, client.lookups)
+	assert.Nil(t, querier.InsertWorkItemEventValue)
+	assert.Nil(t, querier.DeleteWorkItemSnapshotValue)
+}
+
+func TestExecuteBatchesRemovedItemLookups(t *testing.T) {
+	querier := &MockQuerier{}
+	for i := range 150 {
+		querier.GetPreviousWorkItemsResult = append(querier.GetPreviousWorkItemsResult, db.GetPreviousWorkItemsRow{
+			GhID: fmt.Sprintf("deleted%d", i),
+			Name: fmt.Sprintf("Issue %d", i),
+		})
+	}
+	dataPullJob, client := getScopeChangesTestJob(t, querier, map[string]*getProjectItemsNodesProjectV2Item{
+		"deleted0": getRemovedItemNode(false),
+	})
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Len(t, client.lookups, 2)
+	assert.Len(t, client.lookups[0], 100)
+	assert.Len(t, client.lookups[1], 50)
+	assert.Len(t, querier.InsertWorkItemEventValue, 149)
+	assert.Equal(t, "deleted1", querier.InsertWorkItemEventValue[0].GhID)
+}
+
+func TestExecuteRecordsItemMovedOutOfIteration(t *testing.T) {
+	querier := &MockQuerier{GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+		{
+			GhID:          "current",
+			Name:          "Issue 1",
+			IterationID:   pgtype.Int4{Int32: 3, Valid: true},
+			IterationGhID: pgtype.Text{String: "iteration", Valid: true},
+		},
+	}}
+	dataPullJob, client := getScopeChangesTestJob(t, querier, nil)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Nil(t, client.lookups)
+	assert.Len(t, querier.InsertWorkItemEventValue, 1)
+	assert.Equal(t, "iteration_removed", querier.InsertWorkItemEventValue[0].EventType)
+	assert.Equal(t, int32(3), querier.InsertWorkItemEventValue[0].IterationID.Int32)
+	assert.Nil(t, querier.DeleteWorkItemSnapshotValue)
+}
+
+func TestExecuteFailsWhenRemovedItemLookupFails(t *testing.T) {
+	querier := &MockQuerier{GetPreviousWorkItemsResult: []db.GetPreviousWorkItemsRow{
+		{GhID: "deleted", Name: "Issue 2"},
+	}}
+	dataPullJob, client := getScopeChangesTestJob(t, querier, nil)
+	client.err = fmt.Errorf("unavailable")
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Nil(t, querier.InsertWorkItemEventValue)
+	assert.Nil(t, querier.UpsertWorkItemsValue)
+	assert.Equal(t, "failed", querier.InsertSyncRunValue[0].Status)
+}
@@ -0,0 +1,291 @@
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// finished syncs kept in memory so that clients can poll their result
+const maxFinishedSyncs = 100
+
+// SyncProject queues an immediate pull of the project with the given database
+// id.
+func (c *DataPullJob) SyncProject(projectId int32) (*models.Sync, error) {
+	ctx := context.Background()
+	c.loadRegisteredProjects(ctx)
+
+	name, ok := c.findTrackedProject(ctx, func() (string, bool) {
+		name, ok := c.projectNames[projectId]
+		return name, ok
+	})
+
+	if !ok {
+		return nil, models.ErrProjectNotFound
+	}
+
+	projects, _ := c.getProjects()
+	index := slices.IndexFunc(projects, func(project models.JobConfigItem) bool {
+		return project.GetUniqueName() == name
+	})
+
+	// the project was removed from the configuration since its last pull
+	if index < 0 {
+		return nil, models.ErrProjectNotFound
+	}
+
+	return c.startSync(projects[index : index+1])
+}
+
+// SyncAll queues an immediate pull of every configured, registered and
+// discovered project.
+func (c *DataPullJob) SyncAll() (*models.Sync, error) {
+	c.refreshProjects()
+
+	projects, _ := c.getProjects()
+	return c.startSync(projects)
+}
+
+// GetSync returns a snapshot of the progress of a sync.
+func (c *DataPullJob) GetSync(id string) (*models.Sync, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	sync, ok := c.syncs[id]
+	if !ok {
+		return nil, false
+	}
+
+	return copySync(sync), true
+}
+
+func (c *DataPullJob) startSync(projects []models.JobConfigItem) (*models.Sync, error) {
+	sync, err := c.queueSync(projects, models.SyncTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := c.GetSync(sync.Id)
+
+	go c.runSync(sync, projects)
+
+	return result, nil
+}
+
+// queueScheduledSync registers a sync for the projects that are not being
+// synced already, scheduled pulls skip those instead of failing.
+func (c *DataPullJob) queueScheduledSync() (*models.Sync, []models.JobConfigItem, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	projects := []models.JobConfigItem{}
+	for _, project := range c.pulledProjects() {
+		if _, running := c.runningProjects[project.GetUniqueName()]; running {
+			slog.Info("Skipping project with a sync in progress", "project", project.GetUniqueName())
+			continue
+		}
+
+		projects = append(projects, project)
+	}
+
+	sync, err := c.addSync(projects, models.SyncTriggerSchedule)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sync, projects, nil
+}
+
+// queueSync registers a sync for the projects, failing when any of them is
+// already being synced.
+func (c *DataPullJob) queueSync(projects []models.JobConfigItem, trigger models.SyncTrigger) (*models.Sync, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, project := range projects {
+		if _, running := c.runningProjects[project.GetUniqueName()]; running {
+			return nil, models.ErrSyncInProgress
+		}
+	}
+
+	return c.addSync(projects, trigger)
+}
+
+// addSync marks the projects as running under a new sync. The caller must
+// hold c.mutex and have checked that none of them is running.
+func (c *DataPullJob) addSync(projects []models.JobConfigItem, trigger models.SyncTrigger) (*models.Sync, error) {
+	id, err := newSyncId()
+	if err != nil {
+		return nil, err
+	}
+
+	sync := &models.Sync{
+		Id:       id,
+		Trigger:  trigger,
+		Status:   models.SyncStatusQueued,
+		Projects: []models.SyncProject{},
+		QueuedAt: time.Now(),
+	}
+
+	for _, project := range projects {
+		c.runningProjects[project.GetUniqueName()] = sync.Id
+		sync.Projects = append(sync.Projects, models.SyncProject{
+			Name:   project.GetUniqueName(),
+			Status: models.SyncStatusQueued,
+		})
+	}
+
+	c.syncs[sync.Id] = sync
+	c.syncOrder = append(c.syncOrder, sync.Id)
+	c.pruneSyncs()
+
+	return sync, nil
+}
+
+func (c *DataPullJob) runSync(sync *models.Sync, projects []models.JobConfigItem) {
+	c.updateSync(func() {
+		startedAt := time.Now()
+		sync.Status = models.SyncStatusRunning
+		sync.StartedAt = &startedAt
+	})
+
+	failed := false
+	c.pullProjects(sync.Id, sync.Trigger, projects, func(index int) {
+		c.updateSync(func() {
+			startedAt := time.Now()
+			sync.Projects[index].Status = models.SyncStatusRunning
+			sync.Projects[index].StartedAt = &startedAt
+		})
+	}, func(index int, projectId int32, err error) {
+		c.updateSync(func() {
+			finishedAt := time.Now()
+			sync.Projects[index].FinishedAt = &finishedAt
+			delete(c.runningProjects, projects[index].GetUniqueName())
+
+			if err != nil {
+				failed = true
+				sync.Projects[index].Status = models.SyncStatusFailed
+				sync.Projects[index].Error = err.Error()
+				return
+			}
+
+			sync.Projects[index].ProjectId = projectId
+			sync.Projects[index].Status = models.SyncStatusSucceeded
+		})
+	})
+
+	c.updateSync(func() {
+		finishedAt := time.Now()
+		sync.FinishedAt = &finishedAt
+		sync.Status = models.SyncStatusSucceeded
+
+		if failed {
+			sync.Status = models.SyncStatusFailed
+		}
+	})
+}
+
+// pullProjects pulls the projects with at most c.concurrency workers. Each
+// pull gets its own deadline so that a slow project only fails itself.
+func (c *DataPullJob) pullProjects(syncId string, trigger models.SyncTrigger, projects []models.JobConfigItem, started func(index int), finished func(index int, projectId int32, err error)) {
+	indexes := make(chan int)
+	var workers sync.WaitGroup
+
+	for range min(max(c.concurrency, 1), len(projects)) {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for index := range indexes {
+				started(index)
+				projectId, err := c.pullProjectWithTimeout(syncRun{
+					syncId:  syncId,
+					trigger: trigger,
+					project: projects[index],
+				})
+				finished(index, projectId, err)
+			}
+		}()
+	}
+
+	for index := range projects {
+		indexes <- index
+	}
+
+	close(indexes)
+	workers.Wait()
+}
+
+func (c *DataPullJob) pullProjectWithTimeout(run syncRun) (projectId int32, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.pullTimeout)
+	defer cancel()
+
+	ctx, _ = withGraphqlCallCounter(ctx)
+	project := run.project
+	run.startedAt = time.Now()
+
+	defer func() {
+		// a bug while ingesting a project must not stop the other workers
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("project pull panicked: %v", recovered)
+		}
+
+		run.projectId = projectId
+		c.saveSyncRun(ctx, run, err)
+
+		if err != nil {
+			slog.Error("Project pull failed", "project", project.GetUniqueName(), "duration", time.Since(run.startedAt), "error", err)
+			return
+		}
+
+		slog.Info("Project pull succeeded", "project", project.GetUniqueName(), "duration", time.Since(run.startedAt))
+	}()
+
+	projectId, run.itemCount, err = c.pullProject(ctx, project)
+
+	return projectId, err
+}
+
+func (c *DataPullJob) updateSync(update func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	update()
+}
+
+// pruneSyncs forgets the oldest finished syncs once there are too many.
+func (c *DataPullJob) pruneSyncs() {
+	for len(c.syncOrder) > maxFinishedSyncs {
+		oldest := c.syncs[c.syncOrder[0]]
+		if oldest.FinishedAt == nil {
+			return
+		}
+
+		delete(c.syncs, oldest.Id)
+		c.syncOrder = c.syncOrder[1:]
+	}
+}
+
+func copySync(sync *models.Sync) *models.Sync {
+	result := *sync
+	result.Projects = slices.Clone(sync.Projects)
+
+	return &result
+}
+
+func newSyncId() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("unable to generate sync id: %w", err)
+	}
+
+	return hex.EncodeToString(id), nil
+}
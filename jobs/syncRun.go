@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+type graphqlCallsKey struct{}
+
+// syncRun is the outcome of pulling a single project, saved in the sync_run
+// table.
+type syncRun struct {
+	syncId    string
+	trigger   models.SyncTrigger
+	project   models.JobConfigItem
+	startedAt time.Time
+	projectId int32
+	itemCount int
+}
+
+// countingGraphqlClient counts the requests made through it in the counter
+// added to the request context by withGraphqlCallCounter.
+type countingGraphqlClient struct {
+	wrapped graphql.Client
+}
+
+func (c countingGraphqlClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if counter, ok := ctx.Value(graphqlCallsKey{}).(*atomic.Int32); ok {
+		counter.Add(1)
+	}
+
+	return c.wrapped.MakeRequest(ctx, req, resp)
+}
+
+func withGraphqlCallCounter(ctx context.Context) (context.Context, *atomic.Int32) {
+	counter := &atomic.Int32{}
+
+	return context.WithValue(ctx, graphqlCallsKey{}, counter), counter
+}
+
+func (c *DataPullJob) graphqlClient(project models.JobConfigItem) graphql.Client {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return countingGraphqlClient{wrapped: c.graphqlClients[project.GetUniqueName()]}
+}
+
+// saveSyncRun records the outcome of a project pull. Failing to record it is
+// only logged so that it never fails the pull itself.
+func (c *DataPullJob) saveSyncRun(ctx context.Context, run syncRun, err error) {
+	if run.projectId == 0 {
+		run.projectId, _ = c.getProjectDbId(run.project.GetUniqueName())
+	}
+
+	status := models.SyncStatusSucceeded
+	errorText := pgtype.Text{}
+	if err != nil {
+		status = models.SyncStatusFailed
+		errorText = pgtype.Text{String: err.Error(), Valid: true}
+	}
+
+	graphqlCalls := int32(0)
+	if counter, ok := ctx.Value(graphqlCallsKey{}).(*atomic.Int32); ok {
+		graphqlCalls = counter.Load()
+	}
+
+	// the pull context may have timed out already
+	_, insertErr := c.queries.InsertSyncRun(context.WithoutCancel(ctx), db.InsertSyncRunParams{
+		SyncID:       pgtype.Text{String: run.syncId, Valid: run.syncId != ""},
+		ProjectName:  run.project.GetUniqueName(),
+		ProjectID:    pgtype.Int4{Int32: run.projectId, Valid: run.projectId != 0},
+		Trigger:      string(run.trigger),
+		Status:       string(status),
+		StartedAt:    pgtype.Timestamp{Time: run.startedAt, Valid: true},
+		FinishedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		ItemCount:    int32(run.itemCount),
+		GraphqlCalls: graphqlCalls,
+		Error:        errorText,
+	})
+
+	if insertErr != nil {
+		slog.Error("Error on InsertSyncRun", "project", run.project.GetUniqueName(), "error", insertErr)
+	}
+}
+
+// getProjectDbId returns the database id of a project pulled since startup.
+func (c *DataPullJob) getProjectDbId(name string) (int32, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for id, projectName := range c.projectNames {
+		if projectName == name {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
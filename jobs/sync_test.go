@@ -0,0 +1,342 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingGraphqlClient struct {
+	release chan struct{}
+	wrapped graphql.Client
+}
+
+func (m blockingGraphqlClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	<-m.release
+	return m.wrapped.MakeRequest(ctx, req, resp)
+}
+
+func getSyncTestClient(err error) mockGraphqlOrgClient {
+	return mockGraphqlOrgClient{
+		err: err,
+		result: getOrganizationProjectResponse{
+			Organization: getOrganizationProjectOrganization{
+				ProjectV2: getOrganizationProjectOrganizationProjectV2{
+					ProjectFields: ProjectFields{
+						ProjectFieldDefinitions: ProjectFieldDefinitions{
+							Id:    "1",
+							Title: "Project 1",
+							Fields: ProjectFieldDefinitionsFieldsProjectV2FieldConfigurationConnection{
+								Nodes: []ProjectField{
+									&ProjectFieldProjectV2SingleSelectField{Id: "status", Name: "Status"},
+									&ProjectFieldProjectV2IterationField{Id: "iteration", Name: "Iteration"},
+									&ProjectFieldProjectV2Field{Id: "effort", Name: "Effort", DataType: ProjectV2FieldTypeNumber},
+									&ProjectFieldProjectV2Field{Id: "remaining", Name: "RemainingHours", DataType: ProjectV2FieldTypeNumber},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newProjectTestJob returns a job pulling the org/1 project with the items.
+// wrap builds the GraphQL client of the test around the project client.
+func newProjectTestJob[T graphql.Client](t *testing.T, querier *MockQuerier, items []ProjectItem, wrap func(projectClient mockGraphqlOrgClient) T) (*DataPullJob, T) {
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{
+			OrgName: "org",
+			Project: "1",
+			Token:   "token",
+		},
+	})
+	assert.Nil(t, err)
+
+	projectClient := getSyncTestClient(nil)
+	projectClient.result.Organization.ProjectV2.Items.Nodes = items
+	client := wrap(projectClient)
+	dataPullJob.graphqlClients["org/1"] = client
+
+	return dataPullJob, client
+}
+
+func useProjectClient(projectClient mockGraphqlOrgClient) mockGraphqlOrgClient {
+	return projectClient
+}
+
+func getIssueTestItem(id string) ProjectItem {
+	return ProjectItem{
+		Id: id,
+		Content: &ProjectItemContentIssue{
+			Typename: "Issue",
+			Title:    "Issue 1",
+		},
+	}
+}
+
+func newSyncTestJob(t *testing.T, querier *MockQuerier) *DataPullJob {
+	dataPullJob, _ := newProjectTestJob(t, querier, nil, useProjectClient)
+	return dataPullJob
+}
+
+func waitForSync(t *testing.T, dataPullJob *DataPullJob, id string) *models.Sync {
+	var result *models.Sync
+	assert.Eventually(t, func() bool {
+		result, _ = dataPullJob.GetSync(id)
+		return result.FinishedAt != nil
+	}, time.Second, time.Millisecond)
+
+	return result
+}
+
+// executeAndWait runs a scheduled sync and waits for it to finish.
+func executeAndWait(t *testing.T, dataPullJob *DataPullJob) *models.Sync {
+	return waitForScheduledSync(t, dataPullJob, dataPullJob.execute())
+}
+
+func waitForScheduledSync(t *testing.T, dataPullJob *DataPullJob, sync *models.Sync) *models.Sync {
+	if !assert.NotNil(t, sync) {
+		return nil
+	}
+
+	return waitForSync(t, dataPullJob, sync.Id)
+}
+
+func TestSyncProjectNotPulled(t *testing.T) {
+	dataPullJob := newSyncTestJob(t, &MockQuerier{})
+
+	_, err := dataPullJob.SyncProject(1)
+
+	assert.ErrorIs(t, err, models.ErrProjectNotFound)
+}
+
+func TestSyncProjectPulledBeforeRestart(t *testing.T) {
+	querier := &MockQuerier{
+		GetTrackedProjectsResult: []db.GetTrackedProjectsRow{{ID: 1, GhID: "1", ProjectName: "org/1"}},
+	}
+	dataPullJob := newSyncTestJob(t, querier)
+
+	sync, err := dataPullJob.SyncProject(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "org/1", sync.Projects[0].Name)
+	assert.Equal(t, 1, querier.GetTrackedProjectsCount)
+	waitForSync(t, dataPullJob, sync.Id)
+}
+
+func TestSyncProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := newSyncTestJob(t, querier)
+	executeAndWait(t, dataPullJob)
+	querier.UpsertProjectValue.GhID = ""
+
+	sync, err := dataPullJob.SyncProject(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, models.SyncTriggerManual, sync.Trigger)
+	assert.Equal(t, models.SyncStatusQueued, sync.Status)
+
+	result := waitForSync(t, dataPullJob, sync.Id)
+	assert.Equal(t, models.SyncStatusSucceeded, result.Status)
+	assert.Len(t, result.Projects, 1)
+	assert.Equal(t, "org/1", result.Projects[0].Name)
+	assert.Equal(t, int32(1), result.Projects[0].ProjectId)
+	assert.Equal(t, models.SyncStatusSucceeded, result.Projects[0].Status)
+	assert.NotNil(t, result.Projects[0].StartedAt)
+	assert.NotNil(t, result.Projects[0].FinishedAt)
+	assert.Equal(t, "1", querier.UpsertProjectValue.GhID)
+}
+
+func TestSyncAllFailure(t *testing.T) {
+	dataPullJob := newSyncTestJob(t, &MockQuerier{})
+	dataPullJob.graphqlClients["org/1"] = getSyncTestClient(fmt.Errorf("unavailable"))
+
+	sync, err := dataPullJob.SyncAll()
+	assert.Nil(t, err)
+
+	result := waitForSync(t, dataPullJob, sync.Id)
+	assert.Equal(t, models.SyncStatusFailed, result.Status)
+	assert.Equal(t, models.SyncStatusFailed, result.Projects[0].Status)
+	assert.Equal(t, "unavailable", result.Projects[0].Error)
+}
+
+func TestSyncRejectsConcurrentSync(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := newSyncTestJob(t, querier)
+	release := make(chan struct{})
+	dataPullJob.graphqlClients["org/1"] = blockingGraphqlClient{release: release, wrapped: getSyncTestClient(nil)}
+
+	sync, err := dataPullJob.SyncAll()
+	assert.Nil(t, err)
+
+	_, err = dataPullJob.SyncAll()
+	assert.ErrorIs(t, err, models.ErrSyncInProgress)
+
+	// scheduled pulls skip the project instead of waiting for it
+	executeAndWait(t, dataPullJob)
+
+	close(release)
+	result := waitForSync(t, dataPullJob, sync.Id)
+	assert.Equal(t, models.SyncStatusSucceeded, result.Status)
+
+	next, err := dataPullJob.SyncAll()
+	assert.Nil(t, err)
+	waitForSync(t, dataPullJob, next.Id)
+}
+
+func TestGetSyncNotFound(t *testing.T) {
+	dataPullJob := newSyncTestJob(t, &MockQuerier{})
+
+	_, ok := dataPullJob.GetSync("missing")
+
+	assert.False(t, ok)
+}
+
+type hangingGraphqlClient struct{}
+
+func (m hangingGraphqlClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestSyncAllTimesOutHungProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{OrgName: "hung", Project: "1", Token: "token"},
+		{OrgName: "org", Project: "1", Token: "token"},
+	})
+	assert.Nil(t, err)
+	dataPullJob.SetPullLimits(2, 50*time.Millisecond)
+	dataPullJob.graphqlClients["hung/1"] = hangingGraphqlClient{}
+	dataPullJob.graphqlClients["org/1"] = getSyncTestClient(nil)
+
+	sync, err := dataPullJob.SyncAll()
+	assert.Nil(t, err)
+
+	result := waitForSync(t, dataPullJob, sync.Id)
+	assert.Equal(t, models.SyncStatusFailed, result.Status)
+	assert.Equal(t, models.SyncStatusFailed, result.Projects[0].Status)
+	assert.Contains(t, result.Projects[0].Error, "context deadline exceeded")
+	assert.Equal(t, models.SyncStatusSucceeded, result.Projects[1].Status)
+	assert.Equal(t, "1", querier.UpsertProjectValue.GhID)
+}
+
+func TestSyncAllPullsProjectsConcurrently(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{OrgName: "org", Project: "1", Token: "token"},
+		{OrgName: "other", Project: "2", Token: "token"},
+	})
+	assert.Nil(t, err)
+	dataPullJob.SetPullLimits(2, time.Second)
+
+	// the first project only completes once the second one started
+	release := make(chan struct{})
+	dataPullJob.graphqlClients["org/1"] = blockingGraphqlClient{release: release, wrapped: getSyncTestClient(nil)}
+	dataPullJob.graphqlClients["other/2"] = startedGraphqlClient{started: release, wrapped: getSyncTestClient(fmt.Errorf("unavailable"))}
+
+	sync, err := dataPullJob.SyncAll()
+	assert.Nil(t, err)
+
+	result := waitForSync(t, dataPullJob, sync.Id)
+	assert.Equal(t, models.SyncStatusSucceeded, result.Projects[0].Status)
+	assert.Equal(t, models.SyncStatusFailed, result.Projects[1].Status)
+}
+
+type startedGraphqlClient struct {
+	started chan struct{}
+	wrapped graphql.Client
+}
+
+func (m startedGraphqlClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	close(m.started)
+	return m.wrapped.MakeRequest(ctx, req, resp)
+}
+
+func TestExecuteRecordsSyncRun(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := newSyncTestJob(t, querier)
+
+	executeAndWait(t, dataPullJob)
+
+	assert.Len(t, querier.InsertSyncRunValue, 1)
+	run := querier.InsertSyncRunValue[0]
+	assert.Equal(t, "org/1", run.ProjectName)
+	assert.Equal(t, int32(1), run.ProjectID.Int32)
+	assert.Equal(t, "schedule", run.Trigger)
+	assert.Equal(t, "succeeded", run.Status)
+	assert.True(t, run.SyncID.Valid)
+	assert.Equal(t, int32(1), run.GraphqlCalls)
+	assert.False(t, run.Error.Valid)
+	assert.False(t, run.FinishedAt.Time.Before(run.StartedAt.Time))
+}
+
+func TestSyncProjectRecordsFailedSyncRun(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob := newSyncTestJob(t, querier)
+	executeAndWait(t, dataPullJob)
+	dataPullJob.graphqlClients["org/1"] = getSyncTestClient(fmt.Errorf("unavailable"))
+
+	sync, err := dataPullJob.SyncProject(1)
+	assert.Nil(t, err)
+	waitForSync(t, dataPullJob, sync.Id)
+
+	assert.Len(t, querier.InsertSyncRunValue, 2)
+	run := querier.InsertSyncRunValue[1]
+	assert.Equal(t, sync.Id, run.SyncID.String)
+	assert.Equal(t, "manual", run.Trigger)
+	assert.Equal(t, "failed", run.Status)
+	assert.Equal(t, "unavailable", run.Error.String)
+	assert.Equal(t, int32(1), run.ProjectID.Int32)
+}
+
+func TestExecuteStartsProjectsNotStillRunning(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, err := NewDataPullJob("* * * * *", querier, []models.JobConfigItem{
+		{OrgName: "org", Project: "1", Token: "token"},
+		{OrgName: "org", Project: "2", Token: "token"},
+	})
+	assert.Nil(t, err)
+	dataPullJob.SetPullLimits(2, time.Minute)
+
+	release := make(chan struct{})
+	dataPullJob.graphqlClients["org/1"] = blockingGraphqlClient{release: release, wrapped: getSyncTestClient(nil)}
+	dataPullJob.graphqlClients["org/2"] = getSyncTestClient(nil)
+
+	// the tick returns while the slow project is still being pulled
+	first := dataPullJob.execute()
+	assert.Eventually(t, func() bool {
+		sync, _ := dataPullJob.GetSync(first.Id)
+		return sync.Projects[1].FinishedAt != nil
+	}, time.Second, time.Millisecond)
+
+	second := executeAndWait(t, dataPullJob)
+
+	assert.Len(t, second.Projects, 1)
+	assert.Equal(t, "org/2", second.Projects[0].Name)
+	assert.Equal(t, models.SyncStatusSucceeded, second.Status)
+
+	close(release)
+	result := waitForSync(t, dataPullJob, first.Id)
+	assert.Equal(t, models.SyncStatusSucceeded, result.Status)
+}
@@ -0,0 +1,187 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+)
+
+// delivery ids remembered to ignore redeliveries of processed events
+const maxDeliveries = 1000
+
+// ProcessProjectItemEvent refreshes today's snapshot of the item changed by a
+// projects_v2_item webhook by fetching only that item from GitHub.
+func (c *DataPullJob) ProcessProjectItemEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectItemEvent) (err error) {
+	if !c.reserveDelivery(deliveryId) {
+		return fmt.Errorf("%w: delivery %s was already processed", models.ErrWebhookIgnored, deliveryId)
+	}
+
+	// a failed or ignored delivery can be processed again when redelivered
+	defer func() {
+		if err != nil {
+			c.releaseDelivery(deliveryId)
+		}
+	}()
+
+	// the item no longer exists in the project, the next pull reflects that
+	if event.Action == "deleted" || event.Action == "archived" {
+		return fmt.Errorf("%w: action %s is not supported", models.ErrWebhookIgnored, event.Action)
+	}
+
+	project, ok := c.getTrackedProject(ctx, event.Item.ProjectNodeId)
+	if !ok {
+		return fmt.Errorf("%w: project %s is not tracked", models.ErrWebhookIgnored, event.Item.ProjectNodeId)
+	}
+
+	// a single item is not enough to roll up its hierarchy, the next pull does
+	if project.Rollup != models.RollupNone {
+		return fmt.Errorf("%w: project %s rolls up sub-issues", models.ErrWebhookIgnored, event.Item.ProjectNodeId)
+	}
+
+	ctx, _ = withGraphqlCallCounter(ctx)
+	run := syncRun{
+		trigger:   models.SyncTriggerWebhook,
+		project:   project,
+		startedAt: time.Now(),
+	}
+
+	run.projectId, err = c.pullProjectItem(ctx, project, event.Item.NodeId)
+	if err != nil {
+		c.saveSyncRun(ctx, run, err)
+		return err
+	}
+
+	run.itemCount = 1
+	c.saveSyncRun(ctx, run, nil)
+
+	return nil
+}
+
+// pullProjectItem fetches a single project item from GitHub and saves it,
+// returning the database id of the project.
+func (c *DataPullJob) pullProjectItem(ctx context.Context, project models.JobConfigItem, itemId string) (int32, error) {
+	result, err := getProjectItem(ctx, c.graphqlClient(project), itemId, labelsPerIssueCount, assigneesPerIssueCount)
+	if err != nil {
+		slog.Error("Error fetching project item", "item", itemId, "error", err)
+		return 0, err
+	}
+
+	item, ok := result.Node.(*getProjectItemNodeProjectV2Item)
+	if !ok {
+		return 0, fmt.Errorf("project item %s not found", itemId)
+	}
+
+	parsedProject, err := parseProjectInformation(&ProjectFields{
+		ProjectFieldDefinitions: item.Project.ProjectFieldDefinitions,
+		Items: ProjectFieldsItemsProjectV2ItemConnection{
+			Nodes: []ProjectItem{item.ProjectItem},
+		},
+	}, project.Fields)
+	if err != nil {
+		slog.Error("Error parsing project item", "item", itemId, "error", err)
+		return 0, err
+	}
+
+	parsedProject.TimeZone = project.Location().String()
+	parsedProject.Rollup = project.Rollup
+
+	return saveProjectInformation(ctx, &projectPull{project: parsedProject}, nil, project.Today(time.Now()), c.queries)
+}
+
+// ProcessProjectEvent keeps the name of a tracked project up to date.
+func (c *DataPullJob) ProcessProjectEvent(ctx context.Context, deliveryId string, event *models.WebhookProjectEvent) (err error) {
+	if !c.reserveDelivery(deliveryId) {
+		return fmt.Errorf("%w: delivery %s was already processed", models.ErrWebhookIgnored, deliveryId)
+	}
+
+	// a failed or ignored delivery can be processed again when redelivered
+	defer func() {
+		if err != nil {
+			c.releaseDelivery(deliveryId)
+		}
+	}()
+
+	if event.Action == "deleted" {
+		return fmt.Errorf("%w: action %s is not supported", models.ErrWebhookIgnored, event.Action)
+	}
+
+	if _, ok := c.getTrackedProject(ctx, event.Project.NodeId); !ok {
+		return fmt.Errorf("%w: project %s is not tracked", models.ErrWebhookIgnored, event.Project.NodeId)
+	}
+
+	_, err = c.queries.UpsertProject(ctx, db.UpsertProjectParams{
+		GhID: event.Project.NodeId,
+		Name: event.Project.Title,
+	})
+
+	if err != nil {
+		slog.Error("Error on UpsertProject", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *DataPullJob) getTrackedProject(ctx context.Context, ghId string) (models.JobConfigItem, bool) {
+	name, ok := c.findTrackedProject(ctx, func() (string, bool) {
+		name, ok := c.projectGhIds[ghId]
+		return name, ok
+	})
+
+	if !ok {
+		return models.JobConfigItem{}, false
+	}
+
+	projects, _ := c.getProjects()
+	for _, project := range projects {
+		if project.GetUniqueName() == name {
+			return project, true
+		}
+	}
+
+	return models.JobConfigItem{}, false
+}
+
+// reserveDelivery claims a delivery id, returning false when it was claimed
+// already so that concurrent redeliveries are processed only once.
+func (c *DataPullJob) reserveDelivery(deliveryId string) bool {
+	if deliveryId == "" {
+		return true
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.deliveries[deliveryId] {
+		return false
+	}
+
+	c.deliveries[deliveryId] = true
+	c.deliveryOrder = append(c.deliveryOrder, deliveryId)
+
+	if len(c.deliveryOrder) > maxDeliveries {
+		delete(c.deliveries, c.deliveryOrder[0])
+		c.deliveryOrder = c.deliveryOrder[1:]
+	}
+
+	return true
+}
+
+func (c *DataPullJob) releaseDelivery(deliveryId string) {
+	if deliveryId == "" {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.deliveries, deliveryId)
+	if index := slices.Index(c.deliveryOrder, deliveryId); index >= 0 {
+		c.deliveryOrder = slices.Delete(c.deliveryOrder, index, index+1)
+	}
+}
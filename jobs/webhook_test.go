@@ -0,0 +1,224 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jlucaspains/github-charts/db"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphqlItemClient struct {
+	mockGraphqlOrgClient
+	item     getProjectItemNode
+	requests int
+}
+
+func (m *mockGraphqlItemClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if itemResponse, ok := resp.Data.(*getProjectItemResponse); ok {
+		m.requests++
+		itemResponse.Node = m.item
+		return m.err
+	}
+
+	return m.mockGraphqlOrgClient.MakeRequest(ctx, req, resp)
+}
+
+func newWebhookTestJob(t *testing.T, querier *MockQuerier) (*DataPullJob, *mockGraphqlItemClient) {
+	dataPullJob, client := newProjectTestJob(t, querier, nil, func(projectClient mockGraphqlOrgClient) *mockGraphqlItemClient {
+		return &mockGraphqlItemClient{
+			mockGraphqlOrgClient: projectClient,
+			item: &getProjectItemNodeProjectV2Item{
+				Typename: "ProjectV2Item",
+				ProjectItem: ProjectItem{
+					Id: "item",
+					Content: &ProjectItemContentIssue{
+						Typename: "Issue",
+						Title:    "Issue 1",
+					},
+					FieldValues: ProjectItemFieldValuesProjectV2ItemFieldValueConnection{
+						Nodes: []ProjectItemFieldValue{
+							&ProjectItemFieldValueProjectV2ItemFieldSingleSelectValue{
+								Name:  "Done",
+								Field: &ProjectItemFieldReferenceProjectV2SingleSelectField{Id: "status"},
+							},
+						},
+					},
+				},
+				Project: getProjectItemNodeProjectV2ItemProjectProjectV2{
+					ProjectFieldDefinitions: projectClient.result.Organization.ProjectV2.ProjectFieldDefinitions,
+				},
+			},
+		}
+	})
+
+	// the pull tracks the project
+	executeAndWait(t, dataPullJob)
+	querier.UpsertWorkItemsValue = nil
+
+	return dataPullJob, client
+}
+
+func getItemEvent(action string, projectNodeId string) *models.WebhookProjectItemEvent {
+	return &models.WebhookProjectItemEvent{
+		Action: action,
+		Item: models.WebhookProjectItem{
+			NodeId:        "item",
+			ProjectNodeId: projectNodeId,
+			ContentType:   "Issue",
+		},
+	}
+}
+
+func TestProcessProjectItemEvent(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.requests)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+	assert.Equal(t, "item", querier.UpsertWorkItemsValue[0].GhID)
+	assert.Equal(t, "Issue 1", querier.UpsertWorkItemsValue[0].Name)
+	assert.Equal(t, "Done", querier.UpsertWorkItemsValue[0].Status.String)
+
+	run := querier.InsertSyncRunValue[len(querier.InsertSyncRunValue)-1]
+	assert.Equal(t, "webhook", run.Trigger)
+	assert.Equal(t, "succeeded", run.Status)
+	assert.False(t, run.SyncID.Valid)
+	assert.Equal(t, int32(1), run.ItemCount)
+	assert.Equal(t, int32(1), run.GraphqlCalls)
+}
+
+func TestProcessProjectItemEventDuplicateDelivery(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+	assert.Nil(t, err)
+
+	err = dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, 1, client.requests)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+}
+
+func TestProcessProjectItemEventDeliveryInProgress(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+	dataPullJob.reserveDelivery("delivery")
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, 0, client.requests)
+}
+
+func TestProcessProjectItemEventReleasesIgnoredDelivery(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("deleted", "1"))
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+
+	err = dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.requests)
+	assert.Equal(t, []string{"delivery"}, dataPullJob.deliveryOrder)
+}
+
+func TestProcessProjectItemEventProjectPulledBeforeRestart(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+	dataPullJob.projectGhIds = make(map[string]string)
+	dataPullJob.projectNames = make(map[int32]string)
+	querier.GetTrackedProjectsResult = []db.GetTrackedProjectsRow{{ID: 1, GhID: "1", ProjectName: "org/1"}}
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.requests)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+}
+
+func TestProcessProjectItemEventUnknownProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "other"))
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, 0, client.requests)
+}
+
+func TestProcessProjectItemEventDeleted(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("deleted", "1"))
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, 0, client.requests)
+}
+
+func TestProcessProjectItemEventRollupProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+	dataPullJob.projects[0].Rollup = models.RollupDerived
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, 0, client.requests)
+}
+
+func TestProcessProjectItemEventRetriesFailedDelivery(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, client := newWebhookTestJob(t, querier)
+	client.err = fmt.Errorf("unavailable")
+
+	err := dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+	assert.EqualError(t, err, "unavailable")
+
+	client.err = nil
+	err = dataPullJob.ProcessProjectItemEvent(context.Background(), "delivery", getItemEvent("edited", "1"))
+
+	assert.Nil(t, err)
+	assert.Len(t, querier.UpsertWorkItemsValue, 1)
+}
+
+func TestProcessProjectEvent(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectEvent(context.Background(), "delivery", &models.WebhookProjectEvent{
+		Action:  "edited",
+		Project: models.WebhookProject{NodeId: "1", Title: "Renamed"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed", querier.UpsertProjectValue.Name)
+}
+
+func TestProcessProjectEventUnknownProject(t *testing.T) {
+	querier := &MockQuerier{}
+	dataPullJob, _ := newWebhookTestJob(t, querier)
+
+	err := dataPullJob.ProcessProjectEvent(context.Background(), "delivery", &models.WebhookProjectEvent{
+		Action:  "edited",
+		Project: models.WebhookProject{NodeId: "other", Title: "Renamed"},
+	})
+
+	assert.ErrorIs(t, err, models.ErrWebhookIgnored)
+	assert.Equal(t, "Project 1", querier.UpsertProjectValue.Name)
+}
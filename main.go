@@ -2,14 +2,19 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
+	// project time zones are resolved in images without a zone database
+	_ "time/tzdata"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jlucaspains/github-charts/db"
@@ -39,10 +44,26 @@ func main() {
 	queries, dispose := initDB(ctx)
 	defer dispose()
 
-	dataPullJobDispose := startDataPullJob(queries)
-	defer dataPullJobDispose()
+	configFile := os.Getenv("CONFIG_FILE")
+	var config *fileConfig
+	if configFile != "" {
+		var err error
+		config, err = loadConfigFile(configFile)
+		if err != nil {
+			log.Fatalf("Unable to load %s: %s", configFile, err)
+		}
+
+		fileSettings = config.settings
+	}
+
+	dataPullJob := startDataPullJob(queries, config)
+	defer dataPullJob.Stop()
 
-	webDispose := startWebServer(queries)
+	if config != nil {
+		go newConfigWatcher(configFile, dataPullJob).watch()
+	}
+
+	webDispose := startWebServer(queries, dataPullJob)
 	defer webDispose(ctx)
 
 	<-done
@@ -51,12 +72,60 @@ func main() {
 	slog.Info("Stopping web server...")
 }
 
-func startDataPullJob(queries *db.Queries) func() {
-	jobCron := os.Getenv("DATA_PULL_JOB_CRON")
+func startDataPullJob(queries *db.Store, config *fileConfig) *jobs.DataPullJob {
+	jobCron := fileSettings.get("DATA_PULL_JOB_CRON")
 	if jobCron == "" {
 		log.Fatalf("must set DATA_PULL_JOB_CRON=<CRON>")
 	}
 
+	var projectConfigs []models.JobConfigItem
+	if config != nil {
+		projectConfigs = config.getProjects()
+	} else {
+		projectConfigs = getEnvProjectConfigs()
+	}
+
+	concurrency, timeout, err := getPullLimits()
+	if err != nil {
+		log.Fatalf("Invalid data pull limits: %s", err)
+	}
+
+	fullSyncInterval, err := getFullSyncInterval()
+	if err != nil {
+		log.Fatalf("Invalid data pull full sync interval: %s", err)
+	}
+
+	dataPullJob, err := jobs.NewDataPullJob(jobCron, queries, projectConfigs)
+	if err != nil {
+		log.Fatalf("Unable to start data pull job: %s", err)
+	}
+
+	dataPullJob.SetPullLimits(concurrency, timeout)
+	dataPullJob.SetFullSyncInterval(fullSyncInterval)
+
+	tokenKey, err := getProjectTokenKey()
+	if err != nil {
+		log.Fatalf("Invalid project token key: %s", err)
+	}
+
+	if tokenKey != nil {
+		if err := dataPullJob.EnableRegistry(tokenKey, getDefaultConnection()); err != nil {
+			log.Fatalf("Invalid project token key: %s", err)
+		}
+	} else if fileSettings.get("ADMIN_TOKEN") != "" {
+		log.Fatalf("must set PROJECT_TOKEN_KEY or PROJECT_TOKEN_KEY_FILE to register projects")
+	}
+
+	dataPullJob.Start()
+	go dataPullJob.FillHistoryGaps()
+
+	return dataPullJob
+}
+
+// getEnvProjectConfigs reads the projects configured by the GH_PROJECT_n
+// environment variables.
+func getEnvProjectConfigs() []models.JobConfigItem {
+	defaultConnection := getDefaultConnection()
 	projectConfigs := []models.JobConfigItem{}
 	for i := 1; true; i++ {
 		rawUrl, ok := os.LookupEnv(fmt.Sprintf("GH_PROJECT_%d", i))
@@ -70,43 +139,107 @@ func startDataPullJob(queries *db.Queries) func() {
 			continue
 		}
 
+		config.Connection = config.Connection.WithDefaults(defaultConnection)
 		projectConfigs = append(projectConfigs, config)
 	}
 
-	dataPullJob, _ := jobs.NewDataPullJob(jobCron, queries, projectConfigs)
-	dataPullJob.Start()
+	return projectConfigs
+}
+
+// getDefaultConnection reads the connection settings shared by every project
+// that does not configure its own.
+func getDefaultConnection() models.ConnectionConfig {
+	return fileSettings.defaultConnection()
+}
+
+// getPullLimits reads how many projects are pulled in parallel and how long
+// each pull may take.
+func getPullLimits() (int, time.Duration, error) {
+	concurrency := jobs.DefaultPullConcurrency
+	timeout := jobs.DefaultPullTimeout
+
+	if value := fileSettings.get("DATA_PULL_CONCURRENCY"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return 0, 0, fmt.Errorf("DATA_PULL_CONCURRENCY should be a number greater than 0")
+		}
+
+		concurrency = parsed
+	}
+
+	if value := fileSettings.get("DATA_PULL_TIMEOUT"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return 0, 0, fmt.Errorf("DATA_PULL_TIMEOUT should be a positive duration, e.g. 10m")
+		}
 
-	return dataPullJob.Stop
+		timeout = parsed
+	}
+
+	return concurrency, timeout, nil
+}
+
+// getFullSyncInterval reads how often every item of a project is pulled
+// again instead of only the items updated since the previous pull.
+func getFullSyncInterval() (time.Duration, error) {
+	value := fileSettings.get("DATA_PULL_FULL_SYNC_INTERVAL")
+	if value == "" {
+		return jobs.DefaultFullSyncInterval, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		return 0, fmt.Errorf("DATA_PULL_FULL_SYNC_INTERVAL should be a duration, e.g. 24h, or 0 to always pull every item")
+	}
+
+	return interval, nil
+}
+
+// getProjectTokenKey reads the base64 encoded key that encrypts the tokens
+// of registered projects from PROJECT_TOKEN_KEY or PROJECT_TOKEN_KEY_FILE.
+// It returns nil when neither is set.
+func getProjectTokenKey() ([]byte, error) {
+	value := fileSettings.get("PROJECT_TOKEN_KEY")
+
+	if file := fileSettings.get("PROJECT_TOKEN_KEY_FILE"); value == "" && file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		value = string(content)
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil || len(key) != jobs.ProjectTokenKeySize {
+		return nil, fmt.Errorf("project token key should be %d base64 encoded bytes", jobs.ProjectTokenKeySize)
+	}
+
+	return key, nil
 }
 
 func parseProjectConfig(rawUrl string) (models.JobConfigItem, error) {
 	result := models.JobConfigItem{}
 
 	// break string using format key=value separated by spaces
-	parts := strings.Split(rawUrl, " ")
+	// values containing spaces must be wrapped in double quotes
+	parts := splitConfigParts(rawUrl)
 
 	for _, part := range parts {
-		keyValue := strings.Split(part, "=")
+		keyValue := strings.SplitN(part, "=", 2)
 
 		if len(keyValue) != 2 {
 			continue
 		}
 
 		key := keyValue[0]
-		value := keyValue[1]
-
-		switch key {
-		case "project":
-			result.Project = value
-		case "org_name":
-			result.OrgName = value
-		case "repo_owner":
-			result.RepoOwner = value
-		case "repo_name":
-			result.RepoName = value
-		case "token":
-			result.Token = value
-		}
+		value := strings.Trim(keyValue[1], "\"")
+
+		setProjectConfigValue(&result, key, value)
 	}
 
 	err := result.Validate()
@@ -114,8 +247,100 @@ func parseProjectConfig(rawUrl string) (models.JobConfigItem, error) {
 	return result, err
 }
 
+// setProjectConfigValue sets a project setting by its configuration key,
+// returning false when the key is unknown.
+func setProjectConfigValue(result *models.JobConfigItem, key string, value string) bool {
+	switch key {
+	case "project":
+		result.Project = value
+	case "org_name":
+		result.OrgName = value
+	case "repo_owner":
+		result.RepoOwner = value
+	case "repo_name":
+		result.RepoName = value
+	case "token":
+		result.Token = value
+	case "app_id":
+		result.AppId = value
+	case "app_installation_id":
+		result.AppInstallationId = value
+	case "app_private_key_file":
+		result.AppPrivateKeyFile = value
+	case "api_url":
+		result.Connection.ApiUrl = value
+	case "graphql_url":
+		result.Connection.GraphqlUrl = value
+	case "ca_bundle_file":
+		result.Connection.CaBundleFile = value
+	case "proxy_url":
+		result.Connection.ProxyUrl = value
+	case "backfill_days":
+		days, err := strconv.Atoi(value)
+		if err != nil {
+			days = -1
+		}
+		result.BackfillDays = days
+	case "status_field":
+		result.Fields.Status = value
+	case "effort_field":
+		result.Fields.Effort = value
+	case "remaining_field":
+		result.Fields.Remaining = value
+	case "iteration_field":
+		result.Fields.Iteration = value
+	case "priority_field":
+		result.Fields.Priority = value
+	case "rollup":
+		result.Rollup = models.RollupMode(value)
+	case "discover":
+		result.Discover = value == "true"
+	case "include":
+		result.IncludePatterns = value
+	case "exclude":
+		result.ExcludePatterns = value
+	case "include_closed":
+		result.IncludeClosed = value == "true"
+	case "timezone":
+		result.TimeZone = value
+	case "intraday":
+		result.Intraday = value == "true"
+	default:
+		return false
+	}
+
+	return true
+}
+
+func splitConfigParts(raw string) []string {
+	parts := []string{}
+	current := strings.Builder{}
+	inQuotes := false
+
+	for _, r := range raw {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case r == ' ' && !inQuotes:
+			if current.Len() > 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if current.Len() > 0 {
+		parts = append(parts, current.String())
+	}
+
+	return parts
+}
+
 func getAllowedOrigins() string {
-	allowedOrigin, ok := os.LookupEnv("ALLOWED_ORIGIN")
+	allowedOrigin, ok := fileSettings.lookup("ALLOWED_ORIGIN")
 	if !ok {
 		allowedOrigin = "http://localhost:5173"
 	}
@@ -123,17 +348,47 @@ func getAllowedOrigins() string {
 	return allowedOrigin
 }
 
-func startWebServer(queries *db.Queries) func(ctx context.Context) error {
-	handlers := &handlers.Handlers{Queries: queries, CORSOrigins: getAllowedOrigins()}
+func startWebServer(queries *db.Store, dataPullJob *jobs.DataPullJob) func(ctx context.Context) error {
+	handlers := &handlers.Handlers{
+		Queries:       queries,
+		Syncer:        dataPullJob,
+		Webhooks:      dataPullJob,
+		WebhookSecret: fileSettings.get("GH_WEBHOOK_SECRET"),
+		CORSOrigins:   getAllowedOrigins(),
+		Registry:      dataPullJob,
+		AdminToken:    fileSettings.get("ADMIN_TOKEN"),
+	}
 
 	router := http.NewServeMux()
 
 	router.HandleFunc("GET /api/projects", handlers.GetProjects)
 	router.HandleFunc("GET /api/projects/{projectId}/burnup", handlers.GetBurnup)
+	router.HandleFunc("GET /api/projects/{projectId}/cycle-time", handlers.GetCycleTime)
+	router.HandleFunc("GET /api/projects/{projectId}/epics", handlers.GetEpicTree)
 	router.HandleFunc("GET /api/projects/{projectId}/iterations", handlers.GetIterations)
 	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/burndown", handlers.GetBurndown)
+	router.HandleFunc("GET /api/projects/{projectId}/iterations/{iterationId}/workload", handlers.GetWorkload)
+	router.HandleFunc("GET /api/projects/{projectId}/milestones", handlers.GetMilestones)
+	router.HandleFunc("GET /api/projects/{projectId}/milestones/{milestoneId}/burndown", handlers.GetMilestoneBurndown)
+	router.HandleFunc("GET /api/projects/{projectId}/priority-breakdown", handlers.GetPriorityBreakdown)
+	router.HandleFunc("GET /api/projects/{projectId}/syncs", handlers.GetProjectSyncRuns)
+	router.HandleFunc("POST /api/projects/{projectId}/sync", handlers.SyncProject)
+	router.HandleFunc("POST /api/sync", handlers.SyncAll)
+	router.HandleFunc("GET /api/syncs", handlers.GetSyncRuns)
+	router.HandleFunc("GET /api/syncs/{syncId}", handlers.GetSync)
 	router.HandleFunc("GET /health", handlers.HealthCheck)
 
+	if handlers.WebhookSecret != "" {
+		router.HandleFunc("POST /webhooks/github", handlers.GitHubWebhook)
+	}
+
+	if handlers.AdminToken != "" {
+		router.HandleFunc("GET /api/admin/projects", handlers.RequireAdmin(handlers.GetRegisteredProjects))
+		router.HandleFunc("POST /api/admin/projects", handlers.RequireAdmin(handlers.RegisterProject))
+		router.HandleFunc("PUT /api/admin/projects/{registrationId}", handlers.RequireAdmin(handlers.UpdateRegisteredProject))
+		router.HandleFunc("DELETE /api/admin/projects/{registrationId}", handlers.RequireAdmin(handlers.DeleteRegisteredProject))
+	}
+
 	if handlers.CORSOrigins != "" {
 		router.HandleFunc("OPTIONS /api/", handlers.CORS)
 	}
@@ -142,14 +397,14 @@ func startWebServer(queries *db.Queries) func(ctx context.Context) error {
 
 	logRouter := midlewares.NewLogger(router)
 
-	hostPort, ok := os.LookupEnv("WEB_HOST_PORT")
+	hostPort, ok := fileSettings.lookup("WEB_HOST_PORT")
 	if !ok {
 		hostPort = ":8000"
 	}
 
-	certFile, useTls := os.LookupEnv("TLS_CERT_FILE")
+	certFile, useTls := fileSettings.lookup("TLS_CERT_FILE")
 
-	certKeyFile, ok := os.LookupEnv("TLS_CERT_KEY_FILE")
+	certKeyFile, ok := fileSettings.lookup("TLS_CERT_KEY_FILE")
 	useTls = useTls && ok
 
 	slog.Info("Starting TLS server", "port", hostPort, "usetls", useTls)
@@ -176,7 +431,7 @@ func startWebServer(queries *db.Queries) func(ctx context.Context) error {
 	return srv.Shutdown
 }
 
-func initDB(ctx context.Context) (*db.Queries, func()) {
+func initDB(ctx context.Context) (*db.Store, func()) {
 	dbConnection := os.Getenv("DB_CONNECTION")
 
 	if dbConnection == "" {
@@ -190,7 +445,7 @@ func initDB(ctx context.Context) (*db.Queries, func()) {
 		log.Fatal(err)
 	}
 
-	queries := db.New(conn)
+	queries := db.NewStore(conn)
 
 	return queries, conn.Close
 }
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jlucaspains/github-charts/jobs"
+	"github.com/jlucaspains/github-charts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProjectConfig(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "org", config.OrgName)
+	assert.Equal(t, "1", config.Project)
+	assert.Equal(t, "abc", config.Token)
+	assert.Empty(t, config.Fields.Status)
+}
+
+func TestParseProjectConfigFieldMapping(t *testing.T) {
+	config, err := parseProjectConfig(`repo_owner=me repo_name=repo project=1 token=abc status_field=Stage effort_field="Story Points" remaining_field=PVTF_123 iteration_field=Sprint`)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Stage", config.Fields.Status)
+	assert.Equal(t, "Story Points", config.Fields.Effort)
+	assert.Equal(t, "PVTF_123", config.Fields.Remaining)
+	assert.Equal(t, "Sprint", config.Fields.Iteration)
+}
+
+func TestParseProjectConfigInvalid(t *testing.T) {
+	_, err := parseProjectConfig("project=1")
+
+	assert.EqualError(t, err, "invalid configuration: org or repo information is required, token or GitHub App credentials are required")
+}
+
+func TestParseProjectConfigGitHubApp(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 app_id=12 app_installation_id=99 app_private_key_file=/keys/app.pem")
+
+	assert.Nil(t, err)
+	assert.Empty(t, config.Token)
+	assert.Equal(t, "12", config.AppId)
+	assert.Equal(t, "99", config.AppInstallationId)
+	assert.Equal(t, "/keys/app.pem", config.AppPrivateKeyFile)
+}
+
+func TestParseProjectConfigIncompleteGitHubApp(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 app_id=12")
+
+	assert.EqualError(t, err, "invalid configuration: app id, app installation id and app private key file are required together")
+}
+
+func TestParseProjectConfigConnection(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc api_url=https://github.example.com/api/v3 ca_bundle_file=/certs/ca.pem proxy_url=http://proxy:3128")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "https://github.example.com/api/v3", config.Connection.ApiUrl)
+	assert.Equal(t, "/certs/ca.pem", config.Connection.CaBundleFile)
+	assert.Equal(t, "http://proxy:3128", config.Connection.ProxyUrl)
+	assert.Equal(t, "github.example.com/org/1", config.GetUniqueName())
+}
+
+func TestParseProjectConfigInvalidConnection(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 token=abc api_url=github.example.com proxy_url=:3128")
+
+	assert.EqualError(t, err, "invalid configuration: api url should be an absolute url, proxy url should be an absolute url")
+}
+
+func TestGetDefaultConnection(t *testing.T) {
+	t.Setenv("GH_API_URL", "https://github.example.com/api/v3")
+	t.Setenv("GH_PROXY_URL", "http://proxy:3128")
+
+	config, _ := parseProjectConfig("org_name=org project=1 token=abc proxy_url=http://other:8080")
+	connection := config.Connection.WithDefaults(getDefaultConnection())
+
+	assert.Equal(t, "https://github.example.com/api/v3", connection.ApiUrl)
+	assert.Equal(t, "http://other:8080", connection.ProxyUrl)
+}
+
+func TestParseProjectConfigBackfill(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc backfill_days=30")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 30, config.BackfillDays)
+}
+
+func TestParseProjectConfigInvalidBackfill(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 token=abc backfill_days=month")
+
+	assert.EqualError(t, err, "invalid configuration: backfill days should be a number greater than or equal to 0")
+}
+
+func TestParseProjectConfigRollup(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc rollup=derived")
+
+	assert.Nil(t, err)
+	assert.Equal(t, models.RollupDerived, config.Rollup)
+}
+
+func TestParseProjectConfigInvalidRollup(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 token=abc rollup=epics")
+
+	assert.EqualError(t, err, "invalid configuration: rollup should be one of leaf, parent, derived")
+}
+
+func TestGetPullLimits(t *testing.T) {
+	t.Setenv("DATA_PULL_CONCURRENCY", "8")
+	t.Setenv("DATA_PULL_TIMEOUT", "90s")
+
+	concurrency, timeout, err := getPullLimits()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 8, concurrency)
+	assert.Equal(t, 90*time.Second, timeout)
+}
+
+func TestGetPullLimitsDefaults(t *testing.T) {
+	concurrency, timeout, err := getPullLimits()
+
+	assert.Nil(t, err)
+	assert.Equal(t, jobs.DefaultPullConcurrency, concurrency)
+	assert.Equal(t, jobs.DefaultPullTimeout, timeout)
+}
+
+func TestGetPullLimitsInvalid(t *testing.T) {
+	t.Setenv("DATA_PULL_CONCURRENCY", "0")
+
+	_, _, err := getPullLimits()
+
+	assert.EqualError(t, err, "DATA_PULL_CONCURRENCY should be a number greater than 0")
+
+	t.Setenv("DATA_PULL_CONCURRENCY", "2")
+	t.Setenv("DATA_PULL_TIMEOUT", "soon")
+
+	_, _, err = getPullLimits()
+
+	assert.EqualError(t, err, "DATA_PULL_TIMEOUT should be a positive duration, e.g. 10m")
+}
+
+func TestGetFullSyncInterval(t *testing.T) {
+	interval, err := getFullSyncInterval()
+
+	assert.Nil(t, err)
+	assert.Equal(t, jobs.DefaultFullSyncInterval, interval)
+
+	t.Setenv("DATA_PULL_FULL_SYNC_INTERVAL", "6h")
+
+	interval, err = getFullSyncInterval()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 6*time.Hour, interval)
+}
+
+func TestGetFullSyncIntervalInvalid(t *testing.T) {
+	t.Setenv("DATA_PULL_FULL_SYNC_INTERVAL", "daily")
+
+	_, err := getFullSyncInterval()
+
+	assert.EqualError(t, err, "DATA_PULL_FULL_SYNC_INTERVAL should be a duration, e.g. 24h, or 0 to always pull every item")
+}
+
+func TestGetProjectTokenKey(t *testing.T) {
+	t.Setenv("PROJECT_TOKEN_KEY", "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+
+	key, err := getProjectTokenKey()
+
+	assert.Nil(t, err)
+	assert.Len(t, key, 32)
+	assert.Equal(t, byte(31), key[31])
+}
+
+func TestGetProjectTokenKeyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.key")
+	assert.Nil(t, os.WriteFile(path, []byte("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=\n"), 0o600))
+	t.Setenv("PROJECT_TOKEN_KEY_FILE", path)
+
+	key, err := getProjectTokenKey()
+
+	assert.Nil(t, err)
+	assert.Len(t, key, 32)
+}
+
+func TestGetProjectTokenKeyNotSet(t *testing.T) {
+	key, err := getProjectTokenKey()
+
+	assert.Nil(t, err)
+	assert.Nil(t, key)
+}
+
+func TestGetProjectTokenKeyInvalid(t *testing.T) {
+	t.Setenv("PROJECT_TOKEN_KEY", "c2hvcnQ=")
+
+	_, err := getProjectTokenKey()
+
+	assert.EqualError(t, err, "project token key should be 32 base64 encoded bytes")
+}
+
+func TestParseProjectConfigTimeZone(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc timezone=Asia/Tokyo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Asia/Tokyo", config.TimeZone)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), config.Today(time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC)))
+}
+
+func TestParseProjectConfigInvalidTimeZone(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 token=abc timezone=Mars/Olympus")
+
+	assert.EqualError(t, err, "invalid configuration: timezone should be an IANA time zone, e.g. America/New_York")
+}
+
+func TestParseProjectConfigIntraday(t *testing.T) {
+	config, err := parseProjectConfig("org_name=org project=1 token=abc intraday=true")
+
+	assert.Nil(t, err)
+	assert.True(t, config.Intraday)
+}
+
+func TestParseProjectConfigDiscovery(t *testing.T) {
+	config, err := parseProjectConfig(`org_name=org token=abc discover=true include="Team *,Roadmap" exclude=Sandbox include_closed=true`)
+
+	assert.Nil(t, err)
+	assert.True(t, config.Discover)
+	assert.Equal(t, "Team *,Roadmap", config.IncludePatterns)
+	assert.Equal(t, "Sandbox", config.ExcludePatterns)
+	assert.True(t, config.IncludeClosed)
+	assert.Equal(t, "org/*", config.GetUniqueName())
+}
+
+func TestParseProjectConfigDiscoveryInvalid(t *testing.T) {
+	_, err := parseProjectConfig("repo_owner=me repo_name=repo project=1 token=abc discover=true include=[")
+
+	assert.EqualError(t, err, "invalid configuration: discover requires org_name and no project, include and exclude should be comma separated title patterns, e.g. Team *")
+}
+
+func TestParseProjectConfigPatternsWithoutDiscovery(t *testing.T) {
+	_, err := parseProjectConfig("org_name=org project=1 token=abc include=Team*")
+
+	assert.EqualError(t, err, "invalid configuration: include, exclude and include_closed require discover")
+}
@@ -1,7 +1,12 @@
 package models
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -13,8 +18,17 @@ type Iteration struct {
 	EndDate   time.Time `json:"endDate"`
 }
 
+type Milestone struct {
+	Id        string     `json:"id"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	StartDate time.Time  `json:"startDate"`
+	DueDate   *time.Time `json:"dueDate"`
+}
+
 type Issue struct {
 	Id             string    `json:"id"`
+	Type           string    `json:"type"`
 	Title          string    `json:"title"`
 	CreatedAt      time.Time `json:"createdAt"`
 	ClosedAt       time.Time `json:"closedAt"`
@@ -23,6 +37,11 @@ type Issue struct {
 	RemainingHours float64   `json:"remainingHours"`
 	Labels         []string  `json:"labels"`
 	IterationId    string    `json:"iterationId"`
+	MilestoneId    string    `json:"milestoneId"`
+	Priority       *int      `json:"priority"`
+	Assignees      []string  `json:"assignees"`
+	ParentId       string    `json:"parentId"`
+	RootId         string    `json:"rootId"`
 }
 
 type Project struct {
@@ -31,6 +50,9 @@ type Project struct {
 	Issues     []Issue     `json:"issues"`
 	Statuses   []string    `json:"statuses"`
 	Iterations []Iteration `json:"iterations"`
+	Milestones []Milestone `json:"milestones"`
+	TimeZone   string      `json:"timeZone"`
+	Rollup     RollupMode  `json:"rollup"`
 }
 
 type ErrorResult struct {
@@ -52,28 +74,338 @@ type BurndownItem struct {
 	IterationDay time.Time `json:"iterationDay"`
 	Remaining    float64   `json:"remaining"`
 	Ideal        float64   `json:"ideal"`
+	ScopeRemoved float64   `json:"scopeRemoved"`
+	Inferred     bool      `json:"inferred"`
+}
+
+// WorkloadItem is the effort of an iteration assigned to a person. Items
+// without assignees are reported in a single item with Unassigned set.
+type WorkloadItem struct {
+	Assignee       string  `json:"assignee"`
+	Unassigned     bool    `json:"unassigned"`
+	Committed      float64 `json:"committed"`
+	Completed      float64 `json:"completed"`
+	RemainingHours float64 `json:"remainingHours"`
+	Inferred       bool    `json:"inferred"`
+}
+
+// EpicTreeItem is an item of the project with its sub-issues. Totals include
+// the item and every item below it.
+type EpicTreeItem struct {
+	Id              string          `json:"id"`
+	Title           string          `json:"title"`
+	Status          string          `json:"status"`
+	Effort          float64         `json:"effort"`
+	TotalEffort     float64         `json:"totalEffort"`
+	CompletedEffort float64         `json:"completedEffort"`
+	RemainingHours  float64         `json:"remainingHours"`
+	Progress        float64         `json:"progress"`
+	Inferred        bool            `json:"inferred"`
+	Children        []*EpicTreeItem `json:"children"`
+}
+
+type MilestoneBurndownItem struct {
+	MilestoneDay time.Time `json:"milestoneDay"`
+	Remaining    float64   `json:"remaining"`
+	Ideal        float64   `json:"ideal"`
+	Inferred     bool      `json:"inferred"`
+}
+
+type PriorityBreakdownItem struct {
+	ProjectDay time.Time `json:"projectDay"`
+	Priority   *int32    `json:"priority"`
+	Remaining  float64   `json:"remaining"`
+	Done       float64   `json:"done"`
+	Inferred   bool      `json:"inferred"`
 }
 
+// ScopeRemovedSeries is the burnup series with the effort of the items
+// removed from the project or archived.
+const ScopeRemovedSeries = "Scope removed"
+
 type BurnupItem struct {
 	Status     string    `json:"status"`
 	ProjectDay time.Time `json:"projectDay"`
 	Qty        float64   `json:"qty"`
+	Inferred   bool      `json:"inferred"`
 }
 
+type CycleTimeItem struct {
+	Id        string    `json:"id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"createdAt"`
+	ClosedAt  time.Time `json:"closedAt"`
+	LeadTime  float64   `json:"leadTime"`
+	CycleTime *float64  `json:"cycleTime"`
+}
+
+type CycleTimePercentile struct {
+	Day time.Time `json:"day"`
+	P50 float64   `json:"p50"`
+	P85 float64   `json:"p85"`
+	P95 float64   `json:"p95"`
+}
+
+type CycleTimeResult struct {
+	Items       []CycleTimeItem       `json:"items"`
+	Percentiles []CycleTimePercentile `json:"percentiles"`
+}
+
+var (
+	ErrProjectNotFound          = errors.New("project not found")
+	ErrSyncInProgress           = errors.New("a sync is already running for this project")
+	ErrWebhookIgnored           = errors.New("webhook ignored")
+	ErrRegistrationRejected     = errors.New("project registration rejected")
+	ErrProjectAlreadyRegistered = errors.New("project is already configured")
+)
+
+type SyncStatus string
+
+const (
+	SyncStatusQueued    SyncStatus = "queued"
+	SyncStatusRunning   SyncStatus = "running"
+	SyncStatusSucceeded SyncStatus = "succeeded"
+	SyncStatusFailed    SyncStatus = "failed"
+)
+
+type SyncTrigger string
+
+const (
+	SyncTriggerSchedule SyncTrigger = "schedule"
+	SyncTriggerManual   SyncTrigger = "manual"
+	SyncTriggerWebhook  SyncTrigger = "webhook"
+)
+
+type SyncProject struct {
+	Name       string     `json:"name"`
+	ProjectId  int32      `json:"projectId,omitempty"`
+	Status     SyncStatus `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+type Sync struct {
+	Id         string        `json:"id"`
+	Trigger    SyncTrigger   `json:"trigger"`
+	Status     SyncStatus    `json:"status"`
+	Projects   []SyncProject `json:"projects"`
+	QueuedAt   time.Time     `json:"queuedAt"`
+	StartedAt  *time.Time    `json:"startedAt"`
+	FinishedAt *time.Time    `json:"finishedAt"`
+}
+
+type SyncRun struct {
+	Id           int32       `json:"id"`
+	SyncId       string      `json:"syncId,omitempty"`
+	ProjectName  string      `json:"projectName"`
+	ProjectId    int32       `json:"projectId,omitempty"`
+	Trigger      SyncTrigger `json:"trigger"`
+	Status       SyncStatus  `json:"status"`
+	StartedAt    time.Time   `json:"startedAt"`
+	FinishedAt   time.Time   `json:"finishedAt"`
+	ItemCount    int32       `json:"itemCount"`
+	GraphqlCalls int32       `json:"graphqlCalls"`
+	Error        string      `json:"error,omitempty"`
+}
+
+type WebhookResult struct {
+	Status string `json:"status"`
+}
+
+type WebhookProjectItem struct {
+	NodeId        string `json:"node_id"`
+	ProjectNodeId string `json:"project_node_id"`
+	ContentType   string `json:"content_type"`
+}
+
+type WebhookProjectItemEvent struct {
+	Action string             `json:"action"`
+	Item   WebhookProjectItem `json:"projects_v2_item"`
+}
+
+type WebhookProject struct {
+	NodeId string `json:"node_id"`
+	Title  string `json:"title"`
+}
+
+type WebhookProjectEvent struct {
+	Action  string         `json:"action"`
+	Project WebhookProject `json:"projects_v2"`
+}
+
+type FieldMapping struct {
+	Status    string
+	Effort    string
+	Remaining string
+	Iteration string
+	Priority  string
+}
+
+type ConnectionConfig struct {
+	ApiUrl       string
+	GraphqlUrl   string
+	CaBundleFile string
+	ProxyUrl     string
+}
+
+// WithDefaults fills the settings not configured for a project with the
+// global defaults.
+func (c ConnectionConfig) WithDefaults(defaults ConnectionConfig) ConnectionConfig {
+	return ConnectionConfig{
+		ApiUrl:       cmp.Or(c.ApiUrl, defaults.ApiUrl),
+		GraphqlUrl:   cmp.Or(c.GraphqlUrl, defaults.GraphqlUrl),
+		CaBundleFile: cmp.Or(c.CaBundleFile, defaults.CaBundleFile),
+		ProxyUrl:     cmp.Or(c.ProxyUrl, defaults.ProxyUrl),
+	}
+}
+
+// RollupMode decides which items of an issue hierarchy count towards the
+// charts so that parents and their sub-issues are not counted twice.
+type RollupMode string
+
+const (
+	// RollupNone counts every item, the default.
+	RollupNone RollupMode = ""
+	// RollupLeaf counts only the items without sub-issues.
+	RollupLeaf RollupMode = "leaf"
+	// RollupParent counts only the top level items with their own effort.
+	RollupParent RollupMode = "parent"
+	// RollupDerived counts only the top level items with the effort of
+	// their sub-issues.
+	RollupDerived RollupMode = "derived"
+)
+
 type JobConfigItem struct {
-	OrgName   string
-	RepoOwner string
-	RepoName  string
-	Project   string
-	Token     string
+	OrgName           string
+	RepoOwner         string
+	RepoName          string
+	Project           string
+	Token             string
+	AppId             string
+	AppInstallationId string
+	AppPrivateKeyFile string
+	Fields            FieldMapping
+	Connection        ConnectionConfig
+	BackfillDays      int
+	Rollup            RollupMode
+	Discover          bool
+	IncludePatterns   string
+	ExcludePatterns   string
+	IncludeClosed     bool
+	TimeZone          string
+	Intraday          bool
+}
+
+// ProjectRegistration is a project registered through the admin API. The
+// token is only accepted and never returned, HasToken tells whether one is
+// stored.
+type ProjectRegistration struct {
+	Id                int32      `json:"id"`
+	Name              string     `json:"name"`
+	OrgName           string     `json:"orgName"`
+	RepoOwner         string     `json:"repoOwner"`
+	RepoName          string     `json:"repoName"`
+	Project           string     `json:"project"`
+	Token             string     `json:"token,omitempty"`
+	HasToken          bool       `json:"hasToken"`
+	AppId             string     `json:"appId"`
+	AppInstallationId string     `json:"appInstallationId"`
+	AppPrivateKeyFile string     `json:"appPrivateKeyFile"`
+	ApiUrl            string     `json:"apiUrl"`
+	GraphqlUrl        string     `json:"graphqlUrl"`
+	CaBundleFile      string     `json:"caBundleFile"`
+	ProxyUrl          string     `json:"proxyUrl"`
+	StatusField       string     `json:"statusField"`
+	EffortField       string     `json:"effortField"`
+	RemainingField    string     `json:"remainingField"`
+	IterationField    string     `json:"iterationField"`
+	PriorityField     string     `json:"priorityField"`
+	BackfillDays      int        `json:"backfillDays"`
+	Rollup            RollupMode `json:"rollup"`
+	TimeZone          string     `json:"timeZone"`
+	Intraday          bool       `json:"intraday"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+func (r *ProjectRegistration) ToConfig() JobConfigItem {
+	return JobConfigItem{
+		OrgName:           r.OrgName,
+		RepoOwner:         r.RepoOwner,
+		RepoName:          r.RepoName,
+		Project:           r.Project,
+		Token:             r.Token,
+		AppId:             r.AppId,
+		AppInstallationId: r.AppInstallationId,
+		AppPrivateKeyFile: r.AppPrivateKeyFile,
+		Fields: FieldMapping{
+			Status:    r.StatusField,
+			Effort:    r.EffortField,
+			Remaining: r.RemainingField,
+			Iteration: r.IterationField,
+			Priority:  r.PriorityField,
+		},
+		Connection: ConnectionConfig{
+			ApiUrl:       r.ApiUrl,
+			GraphqlUrl:   r.GraphqlUrl,
+			CaBundleFile: r.CaBundleFile,
+			ProxyUrl:     r.ProxyUrl,
+		},
+		BackfillDays: r.BackfillDays,
+		Rollup:       r.Rollup,
+		TimeZone:     r.TimeZone,
+		Intraday:     r.Intraday,
+	}
+}
+
+// NewProjectRegistration describes a registered project without its token.
+func NewProjectRegistration(config JobConfigItem) ProjectRegistration {
+	return ProjectRegistration{
+		OrgName:           config.OrgName,
+		RepoOwner:         config.RepoOwner,
+		RepoName:          config.RepoName,
+		Project:           config.Project,
+		HasToken:          config.Token != "",
+		AppId:             config.AppId,
+		AppInstallationId: config.AppInstallationId,
+		AppPrivateKeyFile: config.AppPrivateKeyFile,
+		ApiUrl:            config.Connection.ApiUrl,
+		GraphqlUrl:        config.Connection.GraphqlUrl,
+		CaBundleFile:      config.Connection.CaBundleFile,
+		ProxyUrl:          config.Connection.ProxyUrl,
+		StatusField:       config.Fields.Status,
+		EffortField:       config.Fields.Effort,
+		RemainingField:    config.Fields.Remaining,
+		IterationField:    config.Fields.Iteration,
+		PriorityField:     config.Fields.Priority,
+		BackfillDays:      config.BackfillDays,
+		Rollup:            config.Rollup,
+		TimeZone:          config.TimeZone,
+		Intraday:          config.Intraday,
+	}
 }
 
 func (j *JobConfigItem) GetUniqueName() string {
-	if j.OrgName != "" {
-		return fmt.Sprintf("%s/%s", j.OrgName, j.Project)
+	name := ""
+	if j.Discover {
+		name = fmt.Sprintf("%s/*", j.OrgName)
+	} else if j.OrgName != "" {
+		name = fmt.Sprintf("%s/%s", j.OrgName, j.Project)
 	} else {
-		return fmt.Sprintf("%s/%s/%s", j.RepoOwner, j.RepoName, j.Project)
+		name = fmt.Sprintf("%s/%s/%s", j.RepoOwner, j.RepoName, j.Project)
+	}
+
+	// the same owner and project number may exist on different servers
+	if apiUrl, err := url.Parse(j.Connection.ApiUrl); err == nil && apiUrl.Host != "" {
+		name = fmt.Sprintf("%s/%s", apiUrl.Host, name)
 	}
+
+	return name
+}
+
+func (j *JobConfigItem) UsesGitHubApp() bool {
+	return j.AppId != "" || j.AppInstallationId != "" || j.AppPrivateKeyFile != ""
 }
 
 func (j *JobConfigItem) Validate() error {
@@ -83,12 +415,52 @@ func (j *JobConfigItem) Validate() error {
 		errors = append(errors, "org or repo information is required")
 	}
 
-	if j.Project == "" {
+	if j.Project == "" && !j.Discover {
 		errors = append(errors, "project is required")
 	}
 
-	if j.Token == "" {
-		errors = append(errors, "token is required")
+	if j.Discover && (j.OrgName == "" || j.Project != "") {
+		errors = append(errors, "discover requires org_name and no project")
+	}
+
+	if !j.Discover && (j.IncludePatterns != "" || j.ExcludePatterns != "" || j.IncludeClosed) {
+		errors = append(errors, "include, exclude and include_closed require discover")
+	}
+
+	if !isValidPatternList(j.IncludePatterns) || !isValidPatternList(j.ExcludePatterns) {
+		errors = append(errors, "include and exclude should be comma separated title patterns, e.g. Team *")
+	}
+
+	if j.Token == "" && !j.UsesGitHubApp() {
+		errors = append(errors, "token or GitHub App credentials are required")
+	}
+
+	if j.UsesGitHubApp() && (j.AppId == "" || j.AppInstallationId == "" || j.AppPrivateKeyFile == "") {
+		errors = append(errors, "app id, app installation id and app private key file are required together")
+	}
+
+	if j.BackfillDays < 0 {
+		errors = append(errors, "backfill days should be a number greater than or equal to 0")
+	}
+
+	if j.Rollup != RollupNone && j.Rollup != RollupLeaf && j.Rollup != RollupParent && j.Rollup != RollupDerived {
+		errors = append(errors, "rollup should be one of leaf, parent, derived")
+	}
+
+	if _, err := time.LoadLocation(j.TimeZone); err != nil || j.TimeZone == "Local" {
+		errors = append(errors, "timezone should be an IANA time zone, e.g. America/New_York")
+	}
+
+	if !isEmptyOrAbsoluteUrl(j.Connection.ApiUrl) {
+		errors = append(errors, "api url should be an absolute url")
+	}
+
+	if !isEmptyOrAbsoluteUrl(j.Connection.GraphqlUrl) {
+		errors = append(errors, "graphql url should be an absolute url")
+	}
+
+	if !isEmptyOrAbsoluteUrl(j.Connection.ProxyUrl) {
+		errors = append(errors, "proxy url should be an absolute url")
 	}
 
 	if len(errors) == 0 {
@@ -97,3 +469,90 @@ func (j *JobConfigItem) Validate() error {
 
 	return fmt.Errorf("invalid configuration: %v", strings.Join(errors, ", "))
 }
+
+// IncludesProject tells whether a project found by discovery should be
+// pulled. Patterns are matched against the title ignoring case.
+func (j *JobConfigItem) IncludesProject(title string, closed bool) bool {
+	if closed && !j.IncludeClosed {
+		return false
+	}
+
+	include := splitPatterns(j.IncludePatterns)
+	if len(include) > 0 && !matchesAnyPattern(include, title) {
+		return false
+	}
+
+	return !matchesAnyPattern(splitPatterns(j.ExcludePatterns), title)
+}
+
+// Location returns the time zone that dates the snapshots of the project,
+// UTC when none is configured.
+func (j *JobConfigItem) Location() *time.Location {
+	location, err := time.LoadLocation(j.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// Today returns the day of the project at the given time as midnight UTC, the
+// way snapshot dates are saved.
+func (j *JobConfigItem) Today(now time.Time) time.Time {
+	year, month, day := now.In(j.Location()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ForProject returns the configuration of a project found by discovery.
+func (j *JobConfigItem) ForProject(number int) JobConfigItem {
+	result := *j
+	result.Project = strconv.Itoa(number)
+	result.Discover = false
+	result.IncludePatterns = ""
+	result.ExcludePatterns = ""
+	result.IncludeClosed = false
+
+	return result
+}
+
+func splitPatterns(value string) []string {
+	result := []string{}
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			result = append(result, strings.ToLower(pattern))
+		}
+	}
+
+	return result
+}
+
+func matchesAnyPattern(patterns []string, title string) bool {
+	title = strings.ToLower(title)
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, title); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isValidPatternList(value string) bool {
+	for _, pattern := range splitPatterns(value) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isEmptyOrAbsoluteUrl(value string) bool {
+	if value == "" {
+		return true
+	}
+
+	parsed, err := url.Parse(value)
+
+	return err == nil && parsed.Scheme != "" && parsed.Host != ""
+}